@@ -0,0 +1,480 @@
+package four_souls
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Hosts games over HTTP, as JSON, for web clients and scripts:
+//
+//	POST   /games                  create a game: {"options": {...}, "bots": 1}. Returns each seat's token
+//	GET    /games/{id}             the game, and the board as the token's player sees it
+//	GET    /games/{id}/actions     the decision the game waits on, with its legal answers if it is the token's
+//	POST   /games/{id}/decisions   answer it: {"seq": 12, "answer": 3}
+//	GET    /games/{id}/events      what was shown since a cursor: ?since=40
+//	GET    /games/{id}/replay      the saved game, once it is over
+//	DELETE /games/{id}             stop the game, for one of its players
+//
+// A player authenticates with their seat's token: "Authorization: Bearer <token>".
+// Without one, the game is seen as a spectator sees it.
+// A game that is over is kept for a while, so that its players can see how it ended and save it.
+type API struct {
+	mu           sync.Mutex
+	games        map[string]*apiGame
+	next         int
+	maxDecisions int           // A game that takes more decisions than this is stopped
+	keep         time.Duration // How long a game is kept once it is over
+}
+
+func NewAPI() *API {
+	return &API{games: make(map[string]*apiGame), maxDecisions: 20000, keep: 10 * time.Minute}
+}
+
+// How a game is created.
+type NewGameRequest struct {
+	Options GameOptions `json:"options"`
+	Bots    int         `json:"bots"` // The number of seats played by bots: the last ones
+}
+
+// A game that was created, and the tokens of the seats played over the API.
+type GameCreated struct {
+	Id      string      `json:"id"`
+	Options GameOptions `json:"options"`
+	Seats   []SeatInfo  `json:"seats"`
+}
+
+type SeatInfo struct {
+	Player string `json:"player"`
+	Token  string `json:"token,omitempty"` // Empty for a bot
+	Bot    bool   `json:"bot"`
+}
+
+// A game as one player, or a spectator, sees it.
+type GameState struct {
+	Id        string    `json:"id"`
+	Viewer    string    `json:"viewer"` // The player of the token. Empty for a spectator
+	Players   []string  `json:"players"`
+	Decisions int       `json:"decisions"` // The number of decisions asked so far
+	Finished  bool      `json:"finished"`
+	Winners   []string  `json:"winners,omitempty"`
+	Error     string    `json:"error,omitempty"` // Why the game stopped early
+	Board     BoardView `json:"board"`
+}
+
+// The decision the game waits on. Only the player who makes it is given its text and answers.
+type Prompt struct {
+	Seq      int        `json:"seq"` // Which decision of the game it is: an answer names it
+	Player   string     `json:"player"`
+	Kind     string     `json:"kind"`
+	Text     string     `json:"text,omitempty"`    // Everything shown to them since their last decision
+	Options  []Option   `json:"options,omitempty"` // The legal answers: actions, targets, cards...
+	Default  int        `json:"default"`
+	Deadline *time.Time `json:"deadline,omitempty"` // When the default is answered for them
+}
+
+// An answer to the decision the game waits on.
+type Answer struct {
+	Seq    int `json:"seq"` // The decision answered. 0 answers whichever the game waits on
+	Answer int `json:"answer"`
+}
+
+// Something the game showed, and its place in the game's event log.
+type ShownEvent struct {
+	Seq     int    `json:"seq"`
+	Text    string `json:"text"`
+	Private bool   `json:"private"` // Shown to the viewer only
+}
+
+// What was shown since a cursor, and the cursor to ask from next.
+type EventLog struct {
+	Events []ShownEvent `json:"events"`
+	Next   int          `json:"next"`
+}
+
+// A game hosted by the API. It plays on its own goroutine: what the API serves of the board
+// is a copy taken by that goroutine before each decision, while the board doesn't change.
+type apiGame struct {
+	id      string
+	players []string
+	tokens  map[string]string // The player of each token
+	stop    chan struct{}     // Closed to stop the game
+	mu      sync.Mutex
+	views   map[string]BoardView // By viewer. The spectator's is under ""
+	shown   []apiShown
+	pending *apiPrompt
+	asked   int
+	log     GameLog // Saved once the game is over
+	over    bool
+	winners []string
+	err     error
+}
+
+// Something shown, and the player it was for. Empty if everyone may see it.
+type apiShown struct {
+	to   string
+	text string
+}
+
+type apiPrompt struct {
+	Prompt
+	d       Decision
+	answers chan int
+}
+
+func (a *API) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if parts[0] != "games" || len(parts) > 3 {
+		writeAPIError(w, http.StatusNotFound, fmt.Errorf("no such endpoint: %s", r.URL.Path))
+		return
+	}
+	if len(parts) == 1 {
+		if r.Method != http.MethodPost {
+			writeAPIError(w, http.StatusMethodNotAllowed, fmt.Errorf("games are created with POST"))
+			return
+		}
+		a.create(w, r)
+		return
+	}
+	a.mu.Lock()
+	g, ok := a.games[parts[1]]
+	a.mu.Unlock()
+	if !ok {
+		writeAPIError(w, http.StatusNotFound, fmt.Errorf("there is no game %q", parts[1]))
+		return
+	}
+	viewer, err := g.viewer(r)
+	if err != nil {
+		writeAPIError(w, http.StatusForbidden, err)
+		return
+	}
+	endpoint := ""
+	if len(parts) == 3 {
+		endpoint = parts[2]
+	}
+	switch {
+	case endpoint == "" && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, g.state(viewer))
+	case endpoint == "" && r.Method == http.MethodDelete:
+		if viewer == "" {
+			writeAPIError(w, http.StatusForbidden, fmt.Errorf("spectators don't stop the game"))
+			return
+		}
+		a.forget(g.id)
+		g.close()
+		w.WriteHeader(http.StatusNoContent)
+	case endpoint == "actions" && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, g.prompt(viewer))
+	case endpoint == "decisions" && r.Method == http.MethodPost:
+		var ans Answer
+		if err := json.NewDecoder(r.Body).Decode(&ans); err != nil {
+			writeAPIError(w, http.StatusBadRequest, fmt.Errorf("an answer looks like {\"seq\": 12, \"answer\": 3}: %v", err))
+		} else if status, err := g.answer(viewer, ans); err != nil {
+			writeAPIError(w, status, err)
+		} else {
+			writeJSON(w, http.StatusOK, ans)
+		}
+	case endpoint == "events" && r.Method == http.MethodGet:
+		since, err := strconv.Atoi(r.URL.Query().Get("since"))
+		if err != nil && r.URL.Query().Get("since") != "" {
+			writeAPIError(w, http.StatusBadRequest, fmt.Errorf("since is a cursor returned as next: %v", err))
+			return
+		}
+		writeJSON(w, http.StatusOK, g.events(viewer, since))
+	case endpoint == "replay" && r.Method == http.MethodGet:
+		gl, err := g.replay()
+		if err != nil {
+			writeAPIError(w, http.StatusConflict, err)
+			return
+		}
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"game-%s.json\"", g.id))
+		w.Header().Set("Content-Type", "application/json")
+		_ = gl.Save(w)
+	default:
+		writeAPIError(w, http.StatusNotFound, fmt.Errorf("no such endpoint: %s %s", r.Method, r.URL.Path))
+	}
+}
+
+// Deal a game and start playing it.
+func (a *API) create(w http.ResponseWriter, r *http.Request) {
+	var req NewGameRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeAPIError(w, http.StatusBadRequest, fmt.Errorf("a game is created with {\"options\": {\"players\": 2}, \"bots\": 1}: %v", err))
+		return
+	}
+	b, err := NewGameWithOptions(req.Options)
+	if errors.Is(err, ErrInvalidInput) {
+		writeAPIError(w, http.StatusBadRequest, err)
+		return
+	} else if err != nil { // The deal needs a card that isn't implemented
+		writeAPIError(w, http.StatusInternalServerError, err)
+		return
+	}
+	players := b.Players()
+	if req.Bots < 0 || req.Bots > len(players) {
+		writeAPIError(w, http.StatusBadRequest, fmt.Errorf("%d bots can't sit at a game of %d players", req.Bots, len(players)))
+		return
+	}
+	g := &apiGame{players: players, tokens: make(map[string]string), stop: make(chan struct{})}
+	created := GameCreated{Options: b.Options(), Seats: make([]SeatInfo, len(players))}
+	seats := NewSeats()
+	for i, name := range players {
+		created.Seats[i] = SeatInfo{Player: name}
+		if i >= len(players)-req.Bots {
+			seats.Sit(name, NewBot(b.Options().Seed+int64(i)))
+			created.Seats[i].Bot = true
+			continue
+		}
+		token, err := newSessionToken()
+		if err != nil {
+			writeAPIError(w, http.StatusInternalServerError, err)
+			return
+		}
+		g.tokens[token] = name
+		seats.Sit(name, &apiSeat{g: g, player: name})
+		created.Seats[i].Token = token
+	}
+	a.mu.Lock()
+	a.next += 1
+	g.id, created.Id = strconv.Itoa(a.next), strconv.Itoa(a.next)
+	a.games[g.id] = g
+	a.mu.Unlock()
+	g.play(b, seats, a.maxDecisions, func() {
+		time.AfterFunc(a.keep, func() { a.forget(g.id) })
+	})
+	writeJSON(w, http.StatusCreated, created)
+}
+
+// The game isn't served anymore.
+func (a *API) forget(id string) {
+	a.mu.Lock()
+	delete(a.games, id)
+	a.mu.Unlock()
+}
+
+// Play the game on its own goroutine, until it is over, stopped, or has taken too many decisions.
+// over is called once it stops.
+func (g *apiGame) play(b *Board, seats *Seats, maxDecisions int, over func()) {
+	recorder := NewRecorder(seats)
+	b.SetDecider(&apiDecider{g: g, d: recorder, left: maxDecisions})
+	g.snapshot(b)
+	go func() {
+		winners, err := b.Play()
+		g.snapshot(b)
+		log := recorder.Log(b) // Copied once, as the replay is only given once the game is over
+		g.mu.Lock()
+		g.over, g.winners, g.err, g.log = true, winners, err, log
+		g.mu.Unlock()
+		over()
+	}()
+}
+
+// Copy the board as each viewer sees it. Only called by the game, so the board doesn't change while it is read.
+func (g *apiGame) snapshot(b *Board) {
+	views := make(map[string]BoardView, len(g.players)+1)
+	views[""] = b.ViewFor("")
+	for _, name := range g.players {
+		views[name] = b.ViewFor(name)
+	}
+	g.mu.Lock()
+	g.views = views
+	g.mu.Unlock()
+}
+
+// The player of the request's token, or no one for a spectator.
+func (g *apiGame) viewer(r *http.Request) (string, error) {
+	auth := r.Header.Get("Authorization")
+	if auth == "" {
+		return "", nil
+	}
+	if player, ok := g.tokens[strings.TrimPrefix(auth, "Bearer ")]; ok {
+		return player, nil
+	}
+	return "", fmt.Errorf("this isn't the token of a seat of game %s", g.id)
+}
+
+func (g *apiGame) state(viewer string) GameState {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	gs := GameState{Id: g.id, Viewer: viewer, Players: g.players, Decisions: g.asked, Finished: g.over,
+		Winners: g.winners, Board: g.views[viewer]}
+	if g.err != nil {
+		gs.Error = g.err.Error()
+	}
+	return gs
+}
+
+// The decision the game waits on, if any. Bots decide at once, so it is one of a player's.
+func (g *apiGame) prompt(viewer string) *Prompt {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.pending == nil {
+		return nil
+	}
+	p := g.pending.Prompt
+	if p.Player != viewer {
+		p.Text, p.Options = "", nil
+	}
+	return &p
+}
+
+// Answer the decision the game waits on for the viewer.
+// return: the HTTP status of the error, if the answer isn't taken.
+func (g *apiGame) answer(viewer string, ans Answer) (int, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	p := g.pending
+	switch {
+	case viewer == "":
+		return http.StatusForbidden, fmt.Errorf("spectators don't decide anything")
+	case p == nil || p.Player != viewer:
+		return http.StatusConflict, fmt.Errorf("the game isn't waiting on %s", viewer)
+	case ans.Seq != 0 && ans.Seq != p.Seq:
+		return http.StatusConflict, fmt.Errorf("the game waits on decision %d, not %d", p.Seq, ans.Seq)
+	case ans.Answer < p.d.Min || ans.Answer > p.d.Max:
+		return http.StatusBadRequest, fmt.Errorf("answer between %d and %d", p.d.Min, p.d.Max)
+	}
+	g.pending = nil
+	p.answers <- ans.Answer
+	return http.StatusOK, nil
+}
+
+// What the viewer was shown from the cursor on.
+func (g *apiGame) events(viewer string, since int) EventLog {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	el := EventLog{Events: make([]ShownEvent, 0), Next: len(g.shown)}
+	if since < 0 {
+		since = 0
+	}
+	for i := since; i < len(g.shown); i++ {
+		if to := g.shown[i].to; to == "" || to == viewer {
+			el.Events = append(el.Events, ShownEvent{Seq: i, Text: g.shown[i].text, Private: to != ""})
+		}
+	}
+	return el
+}
+
+// The saved game. It tells every hand and every deck, so it is only given once the game is over.
+func (g *apiGame) replay() (GameLog, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if !g.over {
+		return GameLog{}, fmt.Errorf("the replay of game %s is given once it is over", g.id)
+	}
+	return g.log, nil
+}
+
+// Stop the game: the decision it waits on, if any, is never answered.
+func (g *apiGame) close() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	select {
+	case <-g.stop:
+	default:
+		close(g.stop)
+	}
+}
+
+// Wait for the player to answer the decision over the API, until it times out.
+func (g *apiGame) await(player string, d Decision) (int, error) {
+	p := &apiPrompt{Prompt: Prompt{Player: player, Kind: d.Kind.String(), Text: d.Text, Options: d.Options,
+		Default: d.Default}, d: d, answers: make(chan int, 1)}
+	var expired <-chan time.Time
+	if d.Timeout > 0 {
+		deadline := time.Now().Add(d.Timeout)
+		p.Deadline = &deadline
+		timer := time.NewTimer(d.Timeout)
+		defer timer.Stop()
+		expired = timer.C
+	}
+	g.mu.Lock()
+	g.asked += 1
+	p.Seq, g.pending = g.asked, p
+	g.mu.Unlock()
+	select {
+	case n := <-p.answers:
+		return n, nil
+	case <-expired:
+	case <-g.stop:
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.pending == p {
+		g.pending = nil
+	} else { // Answered as it timed out
+		return <-p.answers, nil
+	}
+	select {
+	case <-g.stop:
+		return 0, wrapError(ErrUnfinished, "the game was stopped")
+	default:
+		return d.Default, nil
+	}
+}
+
+// Keeps what the game shows for the API, and a copy of the board before each decision.
+// It stops the game once it is deleted, or once it has taken too many decisions.
+type apiDecider struct {
+	g    *apiGame
+	d    *Recorder
+	left int // The decisions the game may still take
+}
+
+func (ad *apiDecider) Show(b *Board, s string) {
+	ad.g.mu.Lock()
+	ad.g.shown = append(ad.g.shown, apiShown{text: s})
+	ad.g.mu.Unlock()
+	ad.d.Show(b, s)
+}
+
+func (ad *apiDecider) ShowTo(b *Board, player string, s string) {
+	ad.g.mu.Lock()
+	ad.g.shown = append(ad.g.shown, apiShown{to: player, text: s})
+	ad.g.mu.Unlock()
+	ad.d.ShowTo(b, player, s)
+}
+
+func (ad *apiDecider) Decide(b *Board, d Decision) (int, error) {
+	select {
+	case <-ad.g.stop: // Bots would play a deleted game on forever
+		return 0, wrapError(ErrUnfinished, "the game was stopped")
+	default:
+	}
+	if ad.left <= 0 {
+		return 0, wrapError(ErrUnfinished, "the game went on for too many decisions")
+	}
+	ad.left -= 1
+	ad.g.snapshot(b)
+	return ad.d.Decide(b, d)
+}
+
+// The seat of a player who plays over the API.
+type apiSeat struct {
+	g      *apiGame
+	player string
+}
+
+// What is shown is kept by the game, for every seat.
+func (as *apiSeat) Show(b *Board, s string) {}
+
+func (as *apiSeat) Decide(b *Board, d Decision) (int, error) {
+	return as.g.await(as.player, d)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeAPIError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, struct {
+		Error string `json:"error"`
+	}{err.Error()})
+}
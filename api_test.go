@@ -0,0 +1,307 @@
+package four_souls
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// Send a request to the API, decode the response into out if it isn't nil, and return the status.
+func call(t *testing.T, srv *httptest.Server, method, path, token string, body interface{}, out interface{}) int {
+	t.Helper()
+	var in bytes.Buffer
+	if body != nil {
+		if err := json.NewEncoder(&in).Encode(body); err != nil {
+			t.Fatal(err)
+		}
+	}
+	req, err := http.NewRequest(method, srv.URL+path, &in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	resp, err := srv.Client().Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if out != nil {
+		if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+			t.Fatalf("%s %s: %v", method, path, err)
+		}
+	}
+	return resp.StatusCode
+}
+
+// Create a game of two players, the last ones played by bots.
+func createGame(t *testing.T, srv *httptest.Server, bots int) GameCreated {
+	t.Helper()
+	var created GameCreated
+	req := NewGameRequest{Options: GameOptions{Players: 2, Seed: 18}, Bots: bots}
+	if status := call(t, srv, http.MethodPost, "/games", "", req, &created); status != http.StatusCreated {
+		t.Fatalf("creating a game: status %d", status)
+	}
+	return created
+}
+
+// Wait for the game to ask the player something.
+func waitForPrompt(t *testing.T, srv *httptest.Server, id, token string) Prompt {
+	t.Helper()
+	for start := time.Now(); time.Since(start) < 5*time.Second; time.Sleep(5 * time.Millisecond) {
+		var p *Prompt
+		if status := call(t, srv, http.MethodGet, "/games/"+id+"/actions", token, nil, &p); status != http.StatusOK {
+			t.Fatalf("listing actions: status %d", status)
+		}
+		if p != nil && len(p.Options) > 0 {
+			return *p
+		}
+	}
+	t.Fatal("the game never asked the player anything")
+	return Prompt{}
+}
+
+func TestAPICreateGame(t *testing.T) {
+	srv := httptest.NewServer(NewAPI())
+	defer srv.Close()
+	created := createGame(t, srv, 1)
+	if len(created.Seats) != 2 || created.Seats[0].Token == "" || created.Seats[0].Bot {
+		t.Fatalf("the first seat should be played over the API: %+v", created.Seats)
+	}
+	if created.Seats[1].Token != "" || !created.Seats[1].Bot {
+		t.Fatalf("the second seat should be a bot's: %+v", created.Seats)
+	}
+	if created.Options.Seed != 18 {
+		t.Errorf("the game should keep its seed, got %d", created.Options.Seed)
+	}
+	var apiErr struct{ Error string }
+	if status := call(t, srv, http.MethodPost, "/games", "", NewGameRequest{Options: GameOptions{Players: 9}}, &apiErr); status != http.StatusBadRequest || apiErr.Error == "" {
+		t.Errorf("a game of 9 players should be refused, got status %d", status)
+	}
+	if status := call(t, srv, http.MethodPost, "/games", "", NewGameRequest{Options: GameOptions{Players: 2, Seed: 18}, Bots: 3}, nil); status != http.StatusBadRequest {
+		t.Errorf("3 bots can't sit at 2 seats, got status %d", status)
+	}
+	if status := call(t, srv, http.MethodGet, "/games/42", "", nil, nil); status != http.StatusNotFound {
+		t.Errorf("there is no game 42, got status %d", status)
+	}
+	if status := call(t, srv, http.MethodGet, "/games/"+created.Id, "not a token", nil, nil); status != http.StatusForbidden {
+		t.Errorf("a wrong token should be refused, got status %d", status)
+	}
+	if status := call(t, srv, http.MethodDelete, "/games/"+created.Id, created.Seats[0].Token, nil, nil); status != http.StatusNoContent {
+		t.Errorf("stopping the game: status %d", status)
+	}
+	if status := call(t, srv, http.MethodGet, "/games/"+created.Id, "", nil, nil); status != http.StatusNotFound {
+		t.Errorf("a stopped game should be gone, got status %d", status)
+	}
+}
+
+func TestAPIStateIsRedacted(t *testing.T) {
+	srv := httptest.NewServer(NewAPI())
+	defer srv.Close()
+	created := createGame(t, srv, 1)
+	defer call(t, srv, http.MethodDelete, "/games/"+created.Id, "", nil, nil)
+	me, token := created.Seats[0].Player, created.Seats[0].Token
+	waitForPrompt(t, srv, created.Id, token)
+
+	var mine, public GameState
+	call(t, srv, http.MethodGet, "/games/"+created.Id, token, nil, &mine)
+	call(t, srv, http.MethodGet, "/games/"+created.Id, "", nil, &public)
+	if mine.Viewer != me || public.Viewer != "" {
+		t.Fatalf("the viewers should be %q and a spectator, got %q and %q", me, mine.Viewer, public.Viewer)
+	}
+	for _, pv := range mine.Board.Players {
+		if pv.Name == me && len(pv.Hand) != pv.HandSize {
+			t.Errorf("%s should see their %d cards, saw %d", me, pv.HandSize, len(pv.Hand))
+		}
+	}
+	for _, pv := range public.Board.Players {
+		if pv.HandSize == 0 {
+			t.Errorf("%s should hold cards at the start of the game", pv.Name)
+		}
+		if len(pv.Hand) != 0 {
+			t.Errorf("a spectator shouldn't see the hand of %s", pv.Name)
+		}
+	}
+	if len(public.Board.Monsters) == 0 || len(public.Board.Decks) != 3 {
+		t.Errorf("the public board should show the monsters and decks: %+v", public.Board)
+	}
+}
+
+func TestAPIDecisions(t *testing.T) {
+	srv := httptest.NewServer(NewAPI())
+	defer srv.Close()
+	created := createGame(t, srv, 1)
+	defer call(t, srv, http.MethodDelete, "/games/"+created.Id, "", nil, nil)
+	me, token := created.Seats[0].Player, created.Seats[0].Token
+	path := "/games/" + created.Id
+
+	p := waitForPrompt(t, srv, created.Id, token)
+	if p.Player != me || p.Text == "" || p.Kind == "" {
+		t.Fatalf("the prompt should be %s's, with its text: %+v", me, p)
+	}
+	if p.Kind == Priority.String() {
+		if label := p.Options[p.Default].Label; label != "Do nothing" && label != "End your turn" {
+			t.Errorf("passing priority should be the default, not %q", label)
+		}
+	}
+	var public *Prompt
+	call(t, srv, http.MethodGet, path+"/actions", "", nil, &public)
+	if public == nil || public.Player != me || public.Text != "" || len(public.Options) != 0 {
+		t.Errorf("a spectator should only see who the game waits on: %+v", public)
+	}
+
+	last := p.Options[len(p.Options)-1].Value
+	if status := call(t, srv, http.MethodPost, path+"/decisions", "", Answer{Seq: p.Seq, Answer: last}, nil); status != http.StatusForbidden {
+		t.Errorf("a spectator can't answer, got status %d", status)
+	}
+	if status := call(t, srv, http.MethodPost, path+"/decisions", token, Answer{Seq: p.Seq + 1, Answer: last}, nil); status != http.StatusConflict {
+		t.Errorf("an answer to another decision should be refused, got status %d", status)
+	}
+	if status := call(t, srv, http.MethodPost, path+"/decisions", token, Answer{Seq: p.Seq, Answer: last + 1}, nil); status != http.StatusBadRequest {
+		t.Errorf("an answer out of range should be refused, got status %d", status)
+	}
+	if status := call(t, srv, http.MethodPost, path+"/decisions", token, Answer{Seq: p.Seq, Answer: last}, nil); status != http.StatusOK {
+		t.Fatalf("answering: status %d", status)
+	}
+	if status := call(t, srv, http.MethodPost, path+"/decisions", token, Answer{Seq: p.Seq, Answer: last}, nil); status != http.StatusConflict {
+		t.Errorf("a decision is answered once, got status %d", status)
+	}
+	if next := waitForPrompt(t, srv, created.Id, token); next.Seq <= p.Seq {
+		t.Errorf("the next decision should come after %d, got %d", p.Seq, next.Seq)
+	}
+	if status := call(t, srv, http.MethodGet, path+"/replay", token, nil, nil); status != http.StatusConflict {
+		t.Errorf("the replay should wait for the game to be over, got status %d", status)
+	}
+}
+
+func TestAPIEvents(t *testing.T) {
+	srv := httptest.NewServer(NewAPI())
+	defer srv.Close()
+	created := createGame(t, srv, 1)
+	defer call(t, srv, http.MethodDelete, "/games/"+created.Id, "", nil, nil)
+	token := created.Seats[0].Token
+	path := "/games/" + created.Id + "/events"
+	waitForPrompt(t, srv, created.Id, token)
+
+	var mine, public EventLog
+	call(t, srv, http.MethodGet, path, token, nil, &mine)
+	call(t, srv, http.MethodGet, path, "", nil, &public)
+	if len(mine.Events) == 0 || mine.Next < len(mine.Events) {
+		t.Fatalf("the player should have been shown the start of the game: %+v", mine)
+	}
+	var shared int
+	for _, e := range mine.Events {
+		if !e.Private {
+			shared += 1
+		}
+	}
+	if shared != len(public.Events) {
+		t.Errorf("a spectator should be shown what every player is: %d events, not %d", len(public.Events), shared)
+	}
+	for _, e := range public.Events {
+		if e.Private {
+			t.Errorf("a spectator was shown something private: %q", e.Text)
+		}
+	}
+	var later EventLog
+	call(t, srv, http.MethodGet, path+"?since="+strconv.Itoa(mine.Next), token, nil, &later)
+	if len(later.Events) != 0 || later.Next != mine.Next {
+		t.Errorf("nothing should have been shown since the game waits on the player: %+v", later)
+	}
+	if status := call(t, srv, http.MethodGet, path+"?since=first", token, nil, nil); status != http.StatusBadRequest {
+		t.Errorf("a cursor is a number, got status %d", status)
+	}
+}
+
+func TestAPIReplay(t *testing.T) {
+	srv := httptest.NewServer(NewAPI())
+	defer srv.Close()
+	var created GameCreated
+	req := NewGameRequest{Options: GameOptions{Players: 2, Seed: 19}, Bots: 2} // Bots that finish well within the decision cap
+	if status := call(t, srv, http.MethodPost, "/games", "", req, &created); status != http.StatusCreated {
+		t.Fatalf("creating a game: status %d", status)
+	}
+	path := "/games/" + created.Id
+	var gs GameState
+	for start := time.Now(); !gs.Finished; time.Sleep(10 * time.Millisecond) {
+		if time.Since(start) > 30*time.Second {
+			t.Fatal("the bots never finished their game")
+		}
+		call(t, srv, http.MethodGet, path, "", nil, &gs)
+	}
+	if gs.Error != "" || len(gs.Winners) == 0 {
+		t.Fatalf("the game should have a winner: %+v", gs)
+	}
+	resp, err := srv.Client().Get(srv.URL + path + "/replay")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	gl, err := LoadGameLog(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := NewGameWithOptions(gl.Options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.SetDecider(NewReplayer(gl, NewSeats()))
+	winners, err := b.Play()
+	if err != nil || !reflect.DeepEqual(winners, gs.Winners) {
+		t.Errorf("the replay should be won by %v, got %v (%v)", gs.Winners, winners, err)
+	}
+}
+
+func TestAPIStopsGames(t *testing.T) {
+	api := NewAPI()
+	api.maxDecisions, api.keep = 20, 50*time.Millisecond
+	srv := httptest.NewServer(api)
+	defer srv.Close()
+	created := createGame(t, srv, 2)
+	path := "/games/" + created.Id
+	var gs GameState
+	for start := time.Now(); !gs.Finished; time.Sleep(5 * time.Millisecond) {
+		if time.Since(start) > 5*time.Second {
+			t.Fatal("a game past its decisions should stop")
+		}
+		call(t, srv, http.MethodGet, path, "", nil, &gs)
+	}
+	if gs.Error == "" || gs.Decisions > 20 {
+		t.Errorf("the game should stop after 20 decisions, with why: %+v", gs)
+	}
+	for start := time.Now(); call(t, srv, http.MethodGet, path, "", nil, nil) != http.StatusNotFound; time.Sleep(5 * time.Millisecond) {
+		if time.Since(start) > 5*time.Second {
+			t.Fatal("a game that is over should be forgotten")
+		}
+	}
+
+	api.maxDecisions, api.keep = 20000, time.Minute
+	created = createGame(t, srv, 1)
+	api.mu.Lock()
+	g := api.games[created.Id]
+	api.mu.Unlock()
+	for _, token := range []string{"", "not-a-token"} {
+		if status := call(t, srv, http.MethodDelete, "/games/"+created.Id, token, nil, nil); status != http.StatusForbidden {
+			t.Errorf("only a player may stop the game: status %d", status)
+		}
+	}
+	if status := call(t, srv, http.MethodDelete, "/games/"+created.Id, created.Seats[0].Token, nil, nil); status != http.StatusNoContent {
+		t.Fatalf("stopping the game: status %d", status)
+	}
+	for start := time.Now(); ; time.Sleep(5 * time.Millisecond) {
+		g.mu.Lock()
+		over := g.over
+		g.mu.Unlock()
+		if over {
+			break
+		} else if time.Since(start) > 5*time.Second {
+			t.Fatal("a deleted game should stop")
+		}
+	}
+}
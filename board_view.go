@@ -0,0 +1,206 @@
+package four_souls
+
+import (
+	"fmt"
+	"strings"
+)
+
+// What one player may see of the game. The server and the clients render this.
+// Cards the player can't see are left out: only the number of cards in their zone is given.
+type BoardView struct {
+	Viewer   string        `json:"viewer"` // The character name of the player seeing the board. Empty for a spectator
+	Turn     string        `json:"turn"`   // The character name of the active player
+	Players  []PlayerView  `json:"players"`
+	Monsters []MonsterView `json:"monsters"`
+	Shop     []CardView    `json:"shop"`  // The items in the shop, by shop zone. Empty zones are left out
+	Decks    []DeckView    `json:"decks"` // The Loot, Monster and Treasure decks
+	Stack    StackView     `json:"stack"`
+	Effects  []EffectView  `json:"effects"`
+}
+
+// A card as a player sees it.
+type CardView struct {
+	Position int    `json:"position"` // Where the card is in its zone. Decks and discard piles count from the top
+	Id       uint16 `json:"id"`
+	Name     string `json:"name"`
+	Kind     string `json:"kind"`
+	Tapped   bool   `json:"tapped"`   // For items and characters
+	Counters int8   `json:"counters"` // For items
+}
+
+// A player's area as another player sees it.
+type PlayerView struct {
+	Name       string     `json:"name"`
+	Active     bool       `json:"active"` // True if it is their turn
+	Health     uint8      `json:"health"`
+	MaxHealth  uint8      `json:"max_health"`
+	Attack     uint8      `json:"attack"`
+	Pennies    int8       `json:"pennies"`
+	SoulValue  uint8      `json:"soul_value"` // The number of souls they have toward victory
+	SoulsToWin uint8      `json:"souls_to_win"`
+	Character  CardView   `json:"character"`
+	HandSize   int        `json:"hand_size"`
+	Hand       []CardView `json:"hand"` // The cards of their hand the viewer may see
+	Items      []CardView `json:"items"`
+	Souls      []CardView `json:"souls"`
+	Curses     []CardView `json:"curses"`
+}
+
+// The monster on top of a monster zone.
+type MonsterView struct {
+	Zone      int      `json:"zone"`
+	Card      CardView `json:"card"`
+	Health    uint8    `json:"health"`
+	MaxHealth uint8    `json:"max_health"`
+	Roll      uint8    `json:"roll"` // The roll needed to hit it
+	Attack    uint8    `json:"attack"`
+	Beneath   int      `json:"beneath"` // The number of monsters covered by this one
+}
+
+// A deck and its discard pile.
+type DeckView struct {
+	Name    string     `json:"name"`
+	Size    int        `json:"size"`
+	Known   []CardView `json:"known"`   // The cards of the deck the viewer was shown, from the top
+	Discard []CardView `json:"discard"` // The discard pile, from the top
+}
+
+// Project the board for the player with the character name. Any other name, like an empty one,
+// sees the board as a spectator: only what is public.
+func (b *Board) ViewFor(name string) BoardView {
+	var viewer *player
+	for i := range b.players {
+		if b.players[i].Character.name == name {
+			viewer = &b.players[i]
+		}
+	}
+	view := BoardView{Stack: b.StackView(), Effects: b.Effects()}
+	if viewer != nil {
+		view.Viewer = name
+	}
+	if len(b.players) > 0 {
+		view.Turn = b.players[b.api].Character.name
+	}
+	b.pruneSightings()
+	for i := range b.players {
+		view.Players = append(view.Players, b.playerView(&b.players[i], viewer))
+	}
+	for i, slot := range b.monster.zones {
+		z := zone{kind: monsterZone, idx: uint8(i)}
+		if cards := b.visibleCards(z, viewer); len(cards) > 0 && len(slot) > 0 {
+			m := slot.peek()
+			view.Monsters = append(view.Monsters, MonsterView{Zone: i, Card: cards[len(cards)-1],
+				Health: m.hp, MaxHealth: m.baseHealth, Roll: m.roll, Attack: m.ap, Beneath: len(slot) - 1})
+		}
+	}
+	view.Shop = b.visibleCards(zone{kind: shopZone}, viewer)
+	view.Decks = []DeckView{
+		b.deckView("Loot", zone{kind: lootDeckZone}, zone{kind: lootDiscardZone}, viewer),
+		b.deckView("Monster", zone{kind: monsterDeckZone}, zone{kind: monsterDiscardZone}, viewer),
+		b.deckView("Treasure", zone{kind: treasureDeckZone}, zone{kind: treasureDiscardZone}, viewer),
+	}
+	return view
+}
+
+func (b *Board) playerView(p *player, viewer *player) PlayerView {
+	pv := PlayerView{Name: p.Character.name, Active: p.isActivePlayer(b), Health: p.Character.hp,
+		MaxHealth: p.getMaxHealth(), Attack: p.getAttack(), Pennies: p.Pennies, SoulValue: p.soulValue(),
+		SoulsToWin: p.soulsToWin(), Character: newCardView(p.Character, 0), HandSize: len(p.Hand)}
+	pv.Hand = b.visibleCards(zone{kind: handZone, owner: p}, viewer)
+	pv.Items = b.visibleCards(zone{kind: itemZone, owner: p}, viewer)
+	pv.Souls = b.visibleCards(zone{kind: soulZone, owner: p}, viewer)
+	pv.Curses = b.visibleCards(zone{kind: curseZone, owner: p}, viewer)
+	return pv
+}
+
+func (b *Board) deckView(name string, deck zone, discard zone, viewer *player) DeckView {
+	return DeckView{Name: name, Size: len(b.zoneCards(deck)), Known: b.visibleCards(deck, viewer),
+		Discard: b.visibleCards(discard, viewer)}
+}
+
+// The cards of the zone the viewer may see. Piles are listed from the top.
+func (b *Board) visibleCards(z zone, viewer *player) []CardView {
+	cards := b.zoneCards(z)
+	views := make([]CardView, 0, len(cards))
+	for i := range cards {
+		if z.isPile() {
+			i = len(cards) - 1 - i
+		}
+		if cards[i] == nil || cards[i].getId() == 0 || !b.cardObservers(z, cards, i).includes(viewer) {
+			continue
+		}
+		pos := i
+		if z.isPile() {
+			pos = len(cards) - 1 - i
+		}
+		views = append(views, newCardView(cards[i], pos))
+	}
+	return views
+}
+
+func newCardView(c card, pos int) CardView {
+	ci := cardInfoFor(c)
+	cv := CardView{Position: pos, Id: ci.Id, Name: ci.Name, Kind: ci.Kind}
+	switch c.(type) {
+	case characterCard:
+		cv.Tapped = c.(characterCard).tapped
+	case treasureCard:
+		cv.Tapped, cv.Counters = c.(treasureCard).tapped, c.(treasureCard).counters
+	case *treasureCard:
+		cv.Tapped, cv.Counters = c.(*treasureCard).tapped, c.(*treasureCard).counters
+	case itemCard:
+		cv.Counters = c.(itemCard).getCounters()
+	}
+	return cv
+}
+
+// The view as plain text, for the clients that play line by line.
+func (v BoardView) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Turn: %s\n", v.Turn)
+	for _, p := range v.Players {
+		name := p.Name
+		if name == v.Viewer {
+			name += " (you)"
+		}
+		fmt.Fprintf(&sb, "%s  HP %d/%d  AP %d  %d¢  Souls %d/%d  Hand %d\n", name, p.Health, p.MaxHealth,
+			p.Attack, p.Pennies, p.SoulValue, p.SoulsToWin, p.HandSize)
+		for _, zone := range []struct {
+			name  string
+			cards []CardView
+		}{{"Hand", p.Hand}, {"Items", p.Items}, {"Souls", p.Souls}, {"Curses", p.Curses}} {
+			if len(zone.cards) > 0 {
+				fmt.Fprintf(&sb, "  %s: %s\n", zone.name, cardNames(zone.cards))
+			}
+		}
+	}
+	for _, m := range v.Monsters {
+		fmt.Fprintf(&sb, "Monster %d: %s  HP %d/%d  Roll %d+  AP %d\n", m.Zone, m.Card.Name, m.Health, m.MaxHealth,
+			m.Roll, m.Attack)
+	}
+	if len(v.Shop) > 0 {
+		fmt.Fprintf(&sb, "Shop: %s\n", cardNames(v.Shop))
+	}
+	for _, d := range v.Decks {
+		fmt.Fprintf(&sb, "%s deck: %d cards", d.Name, d.Size)
+		if len(d.Known) > 0 {
+			fmt.Fprintf(&sb, ", you know %s", cardNames(d.Known))
+		}
+		if len(d.Discard) > 0 {
+			fmt.Fprintf(&sb, ", %s on the discard pile", d.Discard[0].Name)
+		}
+		sb.WriteString("\n")
+	}
+	for _, n := range v.Stack {
+		fmt.Fprintf(&sb, "Stack: %s %s by %s\n", n.Kind, n.Source, n.Controller)
+	}
+	return sb.String()
+}
+
+func cardNames(cards []CardView) string {
+	names := make([]string, len(cards))
+	for i, c := range cards {
+		names[i] = c.Name
+	}
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,30 @@
+package four_souls
+
+import "math/rand"
+
+// A bot that picks any valid answer at random. It plays the seats no one else takes,
+// and the simulations. It has its own random source, so it doesn't disturb the game's.
+type Bot struct {
+	rng *rand.Rand
+}
+
+func NewBot(seed int64) *Bot {
+	return &Bot{rng: rand.New(rand.NewSource(seed))}
+}
+
+// A bot doesn't look at anything.
+func (bot *Bot) Show(b *Board, s string) {}
+
+// Since it doesn't look, it never picks an answer that only shows it something, like reading a card.
+func (bot *Bot) Decide(b *Board, d Decision) (int, error) {
+	answers := make([]int, 0, len(d.Options))
+	for _, o := range d.Options {
+		if !o.Inert {
+			answers = append(answers, o.Value)
+		}
+	}
+	if len(answers) == 0 {
+		return d.Min, nil
+	}
+	return answers[bot.rng.Intn(len(answers))], nil
+}
@@ -0,0 +1,182 @@
+package four_souls
+
+import (
+	"fmt"
+	"sync"
+)
+
+// The kinds of happenings on the board that can be subscribed to.
+type Topic uint8
+
+const (
+	CardDrawn Topic = iota
+	CardDiscarded
+	DamageDealt
+	PlayerDied
+	MonsterKilled
+	SoulGained
+	CentsChanged
+	ItemBought
+	TurnStarted
+	DeckReshuffled
+	CurseGiven
+	CurseRemoved
+)
+
+func (t Topic) String() string {
+	return [...]string{"Card Drawn", "Card Discarded", "Damage Dealt", "Player Died", "Monster Killed",
+		"Soul Gained", "Cents Changed", "Item Bought", "Turn Started", "Deck Reshuffled", "Curse Given", "Curse Removed"}[t]
+}
+
+// A message published to subscribers when something happens on the board.
+// Only the fields that make sense for the topic are filled in.
+type Notification struct {
+	Topic  Topic
+	Player string // The character name of the player involved, if any
+	Card   string // The name of the card involved, if any
+	CardId uint16 // The id of the card involved, if any
+	Target string // The name of the damaged character or monster
+	Deck   string // The deck involved: Loot, Monster or Treasure
+	Amount int    // Damage dealt, cents gained (positive) or lost (negative)
+}
+
+// Describe the notification in a sentence, for logs.
+func (n Notification) String() string {
+	switch n.Topic {
+	case CardDrawn:
+		return fmt.Sprintf("%s drew %s", n.Player, n.Card)
+	case CardDiscarded:
+		return fmt.Sprintf("%s was discarded", n.Card)
+	case DamageDealt:
+		return fmt.Sprintf("%s dealt %d damage to %s", n.Player, n.Amount, n.Target)
+	case PlayerDied:
+		return fmt.Sprintf("%s died", n.Player)
+	case MonsterKilled:
+		return fmt.Sprintf("%s killed %s", n.Player, n.Card)
+	case SoulGained:
+		return fmt.Sprintf("%s gained %s as a soul worth %d", n.Player, n.Card, n.Amount)
+	case CentsChanged:
+		if n.Amount < 0 {
+			return fmt.Sprintf("%s lost %d¢", n.Player, -n.Amount)
+		}
+		return fmt.Sprintf("%s gained %d¢", n.Player, n.Amount)
+	case ItemBought:
+		return fmt.Sprintf("%s bought %s for %d¢", n.Player, n.Card, n.Amount)
+	case TurnStarted:
+		return fmt.Sprintf("%s's turn started", n.Player)
+	case DeckReshuffled:
+		return fmt.Sprintf("The %s discard pile was shuffled into a new deck of %d cards", n.Deck, n.Amount)
+	case CurseGiven:
+		return fmt.Sprintf("%s received %s", n.Player, n.Card)
+	case CurseRemoved:
+		return fmt.Sprintf("%s lost %s", n.Player, n.Card)
+	default:
+		return n.Topic.String()
+	}
+}
+
+// A function that receives notifications from the board.
+// Subscribers run synchronously inside the engine, so they must not block or prompt a player.
+type Subscriber func(n Notification)
+
+type subscription struct {
+	id uint
+	f  Subscriber
+}
+
+// Holds the subscribers of a single board.
+// Shared between the board and its players so player methods (loot, gainCents...) can publish.
+type eventBus struct {
+	mu          sync.Mutex
+	subscribers map[Topic][]subscription
+	nextId      uint
+}
+
+func newEventBus() *eventBus {
+	return &eventBus{subscribers: make(map[Topic][]subscription)}
+}
+
+// Receive every notification published for the topics.
+// If no topics are given, receive notifications for all topics.
+// Return: a function that removes the subscription.
+func (b *Board) Subscribe(f Subscriber, topics ...Topic) (unsubscribe func()) {
+	if b.bus == nil {
+		b.setEventBus(newEventBus())
+	}
+	if len(topics) == 0 {
+		topics = []Topic{CardDrawn, CardDiscarded, DamageDealt, PlayerDied, MonsterKilled, SoulGained,
+			CentsChanged, ItemBought, TurnStarted, DeckReshuffled, CurseGiven, CurseRemoved}
+	}
+	return b.bus.subscribe(f, topics)
+}
+
+// Share the bus with everything on the board that publishes notifications.
+func (b *Board) setEventBus(eb *eventBus) {
+	b.bus = eb
+	for i := range b.players {
+		b.players[i].bus = eb
+	}
+	if b.loot != nil {
+		b.loot.bus = eb
+	}
+	if b.monster != nil {
+		b.monster.bus = eb
+	}
+	if b.treasure != nil {
+		b.treasure.bus = eb
+	}
+}
+
+func (eb *eventBus) subscribe(f Subscriber, topics []Topic) func() {
+	eb.mu.Lock()
+	defer eb.mu.Unlock()
+	id := eb.nextId
+	eb.nextId += 1
+	for _, t := range topics {
+		eb.subscribers[t] = append(eb.subscribers[t], subscription{id: id, f: f})
+	}
+	return func() {
+		eb.mu.Lock()
+		defer eb.mu.Unlock()
+		for _, t := range topics {
+			subs := eb.subscribers[t]
+			for i := range subs {
+				if subs[i].id == id {
+					eb.subscribers[t] = append(subs[:i:i], subs[i+1:]...)
+					break
+				}
+			}
+		}
+	}
+}
+
+// Send a notification to every subscriber of its topic, in the order they subscribed.
+// Safe to call on a nil bus (a board nobody subscribed to).
+func (eb *eventBus) publish(n Notification) {
+	if eb == nil {
+		return
+	}
+	eb.mu.Lock()
+	subs := make([]subscription, len(eb.subscribers[n.Topic]))
+	copy(subs, eb.subscribers[n.Topic])
+	eb.mu.Unlock()
+	for _, s := range subs {
+		s.f(n)
+	}
+}
+
+// Publish a notification about a card that a player was involved with.
+// p and c may be nil.
+func (eb *eventBus) publishCard(t Topic, p *player, c card, amount int) {
+	if eb == nil {
+		return
+	}
+	n := Notification{Topic: t, Amount: amount}
+	if p != nil {
+		n.Player = p.Character.name
+	}
+	if c != nil {
+		n.Card, n.CardId = c.getName(), c.getId()
+	}
+	eb.publish(n)
+}
@@ -11,9 +11,7 @@ package four_souls
 
 import (
 	"errors"
-	"fmt"
 	"math/rand"
-	"time"
 )
 
 // The base structure for all cards.
@@ -178,14 +176,18 @@ type passiveItem interface {
 func (cc *characterCard) activate(p *player, b *Board) error {
 	var err = errors.New("character card already tapped")
 	e := activateEvent{c: cc}
+	if p.hasCurse(curseOfFatigue) {
+		return wrapError(ErrIllegalAction, "%s can't activate their character card while cursed with fatigue", cc.name)
+	}
 	if !cc.tapped {
 		err = nil
+		cc.tapped = true
 		e.f = func(roll uint8) {
 			l := len(p.Hand)
 			if l > 0 {
-				showLootCards(p.Hand, p.Character.name, 0)
-				fmt.Print("Play which card?")
-				ans := readInput(0, l-1)
+				b.ui.showHand(p, 0)
+				b.ui.Print("Play which card?")
+				ans := b.ui.readInput(0, l-1)
 				err = p.Hand[ans].activate(p, b)
 			}
 		}
@@ -194,21 +196,33 @@ func (cc *characterCard) activate(p *player, b *Board) error {
 	return err
 }
 
-// Activate a monster's "on death" effect, a bonus card's
-// on draw effect, or the "give curse" helper for drawn curse cards.
+// Resolve a bonus card or curse revealed from the monster deck, whether it was
+// drawn to refill a monster zone or by attacking the monster deck.
+// Its effect goes on the stack, followed by a dice roll if it needs one.
+// Once the effect resolves, a bonus card goes into the monster discard pile,
+// while a curse stays with the player it was given to.
+// A card without an effect it can use is discarded right away.
+// The refill or attack that revealed the card carries on once the stack is empty again.
 func (mc monsterCard) activate(p *player, b *Board) error {
-	var err = errors.New("not an on death monster effect, bonus card, or curse")
+	var f cardEffect
+	var rollRequired bool
+	err := wrapError(ErrInvalidCard, "%s has no effect to resolve", mc.name)
 	if mc.f != nil {
-		e := triggeredEffectEvent{c: mc}
-		var f cardEffect
-		var rollRequired bool
-		if f, rollRequired, err = mc.f(p, b, mc); err == nil {
-			e.f = f
-			b.eventStack.push(event{p: p, e: e})
-			if rollRequired {
-				b.rollDiceAndPush()
-			}
+		f, rollRequired, err = mc.f(p, b, mc)
+	}
+	if err != nil || f == nil {
+		b.discard(mc)
+		return err
+	}
+	e := triggeredEffectEvent{c: mc, f: func(roll uint8) {
+		f(roll)
+		if !mc.isCurse {
+			b.discard(mc)
 		}
+	}}
+	b.eventStack.push(event{p: p, e: e})
+	if rollRequired {
+		err = b.rollDiceAndPush()
 	}
 	return err
 }
@@ -218,12 +232,16 @@ func (mc monsterCard) activate(p *player, b *Board) error {
 // Paid item effects can be used as the player can pay the cost (cents, damage, etc).
 func (tc *treasureCard) activate(p *player, b *Board) error {
 	var err = errors.New("not an active or paid item")
-	if (tc.active || tc.paid) && !tc.tapped {
+	if (tc.active || tc.paid) && !tc.tapped && tc.f == nil {
+		err = wrapError(ErrInvalidCard, "%s has no effect to use", tc.name)
+	} else if (tc.active || tc.paid) && !tc.tapped {
 		e := activateEvent{c: tc}
 		var f cardEffect
 		var specialCondition bool
-		if f, specialCondition, err = tc.f(p, b, tc); err == nil {
-			tc.tapped = tc.active // If solely a paid item will default to false
+		tc.tapped = tc.active // If solely a paid item will default to false. Before the effect, which may destroy the item
+		if f, specialCondition, err = tc.f(p, b, tc); err != nil {
+			tc.tapped = false
+		} else {
 			if specialCondition && tc.id == guppysPaw {
 				defer b.eventStack.push(event{p: p, e: damageEvent{target: p, n: 1}})
 			} else if specialCondition && (tc.id == theBone || tc.id == techX) { // specialCondition = paid event used
@@ -246,9 +264,12 @@ func (lc lootCard) activate(p *player, b *Board) error {
 	var err error
 	if i, err = p.getHandCardIndexById(lc.id); err == nil {
 		e := lootCardEvent{l: lc}
-		if lc.trinket {
-			e.f = func(roll uint8, blankCard bool) {}
-			p.addCardToBoard(lc)
+		if lc.trinket { // Played like any loot card, it becomes an item once it resolves
+			p.popHandCard(i)
+			e.f = func(roll uint8, blankCard bool) { _ = p.addCardToBoard(lc) }
+			b.eventStack.push(event{p: p, e: e})
+		} else if lc.f == nil {
+			err = wrapError(ErrInvalidCard, "%s has no effect to play", lc.name)
 		} else {
 			var f lootCardEffect
 			var specialCondition bool
@@ -467,10 +488,10 @@ func (mc *monsterCard) heal(n uint8) {
 }
 
 func (p *player) heal(n uint8) {
-	if p.Character.hp < p.Character.baseHealth {
+	if max := p.getMaxHealth(); p.Character.hp < max {
 		toHeal := p.Character.hp + n
-		if toHeal > p.Character.baseHealth {
-			p.Character.hp = p.Character.baseHealth
+		if toHeal > max {
+			p.Character.hp = max
 		} else {
 			p.Character.hp = toHeal
 		}
@@ -529,20 +550,20 @@ func executeEventFunction(p *player, b *Board, c card, en *eventNode, ef eventAc
 	if f, rollRequired, err := ef(p, b, c, en); err == nil && f != nil {
 		events[0] = event{p: p, e: triggeredEffectEvent{c: c, f: f}}
 		if rollRequired {
-			e, _ := b.rollDice()
-			events = append(events, event{p: p, e: e})
+			if e, _, err := b.rollDice(); err == nil {
+				events = append(events, event{p: p, e: e})
+			}
 		}
 	}
 	return events
 }
 
 // Initialize the characters and return only the number of characters that is required to play the game.
-func getCharacters(numPlayers uint8, useExpansionOne bool, useExpansionTwo bool) []characterCard {
+func getCharacters(rng *rand.Rand, numPlayers uint8, useExpansionOne bool, useExpansionTwo bool) []characterCard {
 	charDeck := getCharacterCards(useExpansionOne, useExpansionTwo)
 	var deck = make([]characterCard, 0, numPlayers)
-	rand.Seed(time.Now().UnixNano())
 	for uint8(len(deck)) < numPlayers {
-		index := rand.Intn(len(charDeck))
+		index := rng.Intn(len(charDeck))
 		c := charDeck[index]
 		deck = append(deck, c)
 		charDeck = append(charDeck[:index], charDeck[index+1:]...)
@@ -560,9 +581,9 @@ func getStartingItems() map[string]treasureCard {
 // param useExpansionOne bool: Include cards in the first expansion pass.
 // param useExpansionTwo bool: Include cards in the second expansion pass.
 // return deck: a linked list representing the deck.
-func getLootDeck(useExpansionOne bool, useExpansionTwo bool) deck {
+func getLootDeck(rng *rand.Rand, useExpansionOne bool, useExpansionTwo bool) deck {
 	var lootDeck deck = getLootCards(useExpansionOne, useExpansionTwo)
-	lootDeck.shuffle()
+	lootDeck.shuffle(rng)
 	return lootDeck
 }
 
@@ -570,9 +591,9 @@ func getLootDeck(useExpansionOne bool, useExpansionTwo bool) deck {
 // param useExpansionOne bool: Include cards in the first expansion pass.
 // param useExpansionTwo bool: Include cards in the second expansion pass.
 // return deck: a linked list representing the deck.
-func getMonsterDeck(useExpansionOne bool, useExpansionTwo bool) deck {
+func getMonsterDeck(rng *rand.Rand, useExpansionOne bool, useExpansionTwo bool) deck {
 	monsterDeck := getMonsterCards(useExpansionOne, useExpansionTwo)
-	monsterDeck.shuffle()
+	monsterDeck.shuffle(rng)
 	return monsterDeck
 }
 
@@ -580,9 +601,9 @@ func getMonsterDeck(useExpansionOne bool, useExpansionTwo bool) deck {
 // param useExpansionOne bool: Include cards in the first expansion pass.
 // param useExpansionTwo bool: Include cards in the second expansion pass.
 // return deck: a linked list representing the deck.
-func getTreasureDeck(useExpansionOne bool, useExpansionTwo bool) deck {
+func getTreasureDeck(rng *rand.Rand, useExpansionOne bool, useExpansionTwo bool) deck {
 	treasureDeck := getTreasureCards(useExpansionOne, useExpansionTwo)
-	treasureDeck.shuffle()
+	treasureDeck.shuffle(rng)
 	return treasureDeck
 }
 
@@ -634,170 +655,170 @@ func getLootCards(useExpansionOne bool, useExpansionTwo bool) deck {
 	}
 	d := make(deck, 0, n)
 	d.append([]card{
-		lootCard{baseCard: baseCard{name: "A Penny!", effect: "", id: aPenny}, f: aPennyFunc},
-		lootCard{baseCard: baseCard{name: "A Penny!", effect: "", id: aPenny}, f: aPennyFunc},
-		lootCard{baseCard: baseCard{name: "A Penny!", effect: "", id: aPenny}, f: aPennyFunc},
-		lootCard{baseCard: baseCard{name: "A Penny!", effect: "", id: aPenny}, f: aPennyFunc},
-		lootCard{baseCard: baseCard{name: "A Penny!", effect: "", id: aPenny}, f: aPennyFunc},
-		lootCard{baseCard: baseCard{name: "A Penny!", effect: "", id: aPenny}, f: aPennyFunc},
-		lootCard{baseCard: baseCard{name: "2 Cents!", effect: "", id: twoCents}, f: twoCentsFunc},
-		lootCard{baseCard: baseCard{name: "2 Cents!", effect: "", id: twoCents}, f: twoCentsFunc},
-		lootCard{baseCard: baseCard{name: "2 Cents!", effect: "", id: twoCents}, f: twoCentsFunc},
-		lootCard{baseCard: baseCard{name: "2 Cents!", effect: "", id: twoCents}, f: twoCentsFunc},
-		lootCard{baseCard: baseCard{name: "2 Cents!", effect: "", id: twoCents}, f: twoCentsFunc},
-		lootCard{baseCard: baseCard{name: "2 Cents!", effect: "", id: twoCents}, f: twoCentsFunc},
-		lootCard{baseCard: baseCard{name: "2 Cents!", effect: "", id: twoCents}, f: twoCentsFunc},
-		lootCard{baseCard: baseCard{name: "2 Cents!", effect: "", id: twoCents}, f: twoCentsFunc},
-		lootCard{baseCard: baseCard{name: "2 Cents!", effect: "", id: twoCents}, f: twoCentsFunc},
-		lootCard{baseCard: baseCard{name: "2 Cents!", effect: "", id: twoCents}, f: twoCentsFunc},
-		lootCard{baseCard: baseCard{name: "2 Cents!", effect: "", id: twoCents}, f: twoCentsFunc},
-		lootCard{baseCard: baseCard{name: "2 Cents!", effect: "", id: twoCents}, f: twoCentsFunc},
-		lootCard{baseCard: baseCard{name: "3 Cents!", effect: "", id: threeCents}, f: threeCentsFunc},
-		lootCard{baseCard: baseCard{name: "3 Cents!", effect: "", id: threeCents}, f: threeCentsFunc},
-		lootCard{baseCard: baseCard{name: "3 Cents!", effect: "", id: threeCents}, f: threeCentsFunc},
-		lootCard{baseCard: baseCard{name: "3 Cents!", effect: "", id: threeCents}, f: threeCentsFunc},
-		lootCard{baseCard: baseCard{name: "3 Cents!", effect: "", id: threeCents}, f: threeCentsFunc},
-		lootCard{baseCard: baseCard{name: "3 Cents!", effect: "", id: threeCents}, f: threeCentsFunc},
-		lootCard{baseCard: baseCard{name: "3 Cents!", effect: "", id: threeCents}, f: threeCentsFunc},
-		lootCard{baseCard: baseCard{name: "3 Cents!", effect: "", id: threeCents}, f: threeCentsFunc},
-		lootCard{baseCard: baseCard{name: "3 Cents!", effect: "", id: threeCents}, f: threeCentsFunc},
-		lootCard{baseCard: baseCard{name: "3 Cents!", effect: "", id: threeCents}, f: threeCentsFunc},
-		lootCard{baseCard: baseCard{name: "3 Cents!", effect: "", id: threeCents}, f: threeCentsFunc},
-		lootCard{baseCard: baseCard{name: "3 Cents!", effect: "", id: threeCents}, f: threeCentsFunc},
-		lootCard{baseCard: baseCard{name: "3 Cents!", effect: "", id: threeCents}, f: threeCentsFunc},
-		lootCard{baseCard: baseCard{name: "3 Cents!", effect: "", id: threeCents}, f: threeCentsFunc},
-		lootCard{baseCard: baseCard{name: "3 Cents!", effect: "", id: threeCents}, f: threeCentsFunc},
-		lootCard{baseCard: baseCard{name: "4 Cents!", effect: "", id: fourCents}, f: fourCentsFunc},
-		lootCard{baseCard: baseCard{name: "4 Cents!", effect: "", id: fourCents}, f: fourCentsFunc},
-		lootCard{baseCard: baseCard{name: "4 Cents!", effect: "", id: fourCents}, f: fourCentsFunc},
-		lootCard{baseCard: baseCard{name: "4 Cents!", effect: "", id: fourCents}, f: fourCentsFunc},
-		lootCard{baseCard: baseCard{name: "4 Cents!", effect: "", id: fourCents}, f: fourCentsFunc},
-		lootCard{baseCard: baseCard{name: "4 Cents!", effect: "", id: fourCents}, f: fourCentsFunc},
-		lootCard{baseCard: baseCard{name: "4 Cents!", effect: "", id: fourCents}, f: fourCentsFunc},
-		lootCard{baseCard: baseCard{name: "4 Cents!", effect: "", id: fourCents}, f: fourCentsFunc},
-		lootCard{baseCard: baseCard{name: "4 Cents!", effect: "", id: fourCents}, f: fourCentsFunc},
-		lootCard{baseCard: baseCard{name: "4 Cents!", effect: "", id: fourCents}, f: fourCentsFunc},
-		lootCard{baseCard: baseCard{name: "A Nickel!", effect: "", id: aNickel}, f: aNickelFunc},
-		lootCard{baseCard: baseCard{name: "A Nickel!", effect: "", id: aNickel}, f: aNickelFunc},
-		lootCard{baseCard: baseCard{name: "A Nickel!", effect: "", id: aNickel}, f: aNickelFunc},
-		lootCard{baseCard: baseCard{name: "A Nickel!", effect: "", id: aNickel}, f: aNickelFunc},
-		lootCard{baseCard: baseCard{name: "A Nickel!", effect: "", id: aNickel}, f: aNickelFunc},
-		lootCard{baseCard: baseCard{name: "A Dime!!", effect: "", id: aDime}, f: aDimeFunc},
-		lootCard{baseCard: baseCard{name: "Blank Rune", effect: "", id: blankRune}, f: blankRuneFunc},
-		lootCard{baseCard: baseCard{name: "Bomb!", effect: "", id: bomb}, f: bombFunc},
-		lootCard{baseCard: baseCard{name: "Bomb!", effect: "", id: bomb}, f: bombFunc},
-		lootCard{baseCard: baseCard{name: "Bomb!", effect: "", id: bomb}, f: bombFunc},
-		lootCard{baseCard: baseCard{name: "Bomb!", effect: "", id: bomb}, f: bombFunc},
-		lootCard{baseCard: baseCard{name: "Butter Bean!", effect: "", id: butterBean}, f: butterBeanFunc},
-		lootCard{baseCard: baseCard{name: "Butter Bean!", effect: "", id: butterBean}, f: butterBeanFunc},
-		lootCard{baseCard: baseCard{name: "Butter Bean!", effect: "", id: butterBean}, f: butterBeanFunc},
-		lootCard{baseCard: baseCard{name: "Dagaz", effect: "", id: dagaz}, f: dagazFunc},
-		lootCard{baseCard: baseCard{name: "Dice Shard", effect: "", id: diceShard}, f: diceShardFunc},
-		lootCard{baseCard: baseCard{name: "Dice Shard", effect: "", id: diceShard}, f: diceShardFunc},
-		lootCard{baseCard: baseCard{name: "Dice Shard", effect: "", id: diceShard}, f: diceShardFunc},
-		lootCard{baseCard: baseCard{name: "Ehwaz", effect: "", id: ehwaz}, f: ehwazFunc},
-		lootCard{baseCard: baseCard{name: "Gold Bomb!!", effect: "", id: goldBomb}, f: goldBombFunc},
-		lootCard{baseCard: baseCard{name: "Lil Battery", effect: "", id: lilBattery}, f: lilBatteryFunc},
-		lootCard{baseCard: baseCard{name: "Lil Battery", effect: "", id: lilBattery}, f: lilBatteryFunc},
-		lootCard{baseCard: baseCard{name: "Lil Battery", effect: "", id: lilBattery}, f: lilBatteryFunc},
-		lootCard{baseCard: baseCard{name: "Lil Battery", effect: "", id: lilBattery}, f: lilBatteryFunc},
-		lootCard{baseCard: baseCard{name: "Lost Soul", effect: "", id: lostSoul}, f: lostSoulFunc},
-		lootCard{baseCard: baseCard{name: "Mega Battery", effect: "", id: megaBattery}, f: megaBatteryFunc},
-		lootCard{baseCard: baseCard{name: "Pills! (Blue)", effect: "", id: pillsBlue}, f: pillsBlueFunc},
-		lootCard{baseCard: baseCard{name: "Pills! (Red)", effect: "", id: pillsRed}, f: pillsRedFunc},
-		lootCard{baseCard: baseCard{name: "Pills! (Yellow)", effect: "", id: pillsYellow}, f: pillsYellowFunc},
-		lootCard{baseCard: baseCard{name: "Soul Heart", effect: "", id: soulHeart}, f: soulHeartFunc},
-		lootCard{baseCard: baseCard{name: "Soul Heart", effect: "", id: soulHeart}, f: soulHeartFunc},
-		lootCard{baseCard: baseCard{name: "0. The Fool", effect: "", id: theFool}, f: theFoolFunc},
-		lootCard{baseCard: baseCard{name: "I. The Magician", effect: "", id: theMagician}, f: theMagicianFunc},
-		lootCard{baseCard: baseCard{name: "II. The High Priestess", effect: "", id: theHighPriestess}, f: theHighPriestessFunc},
-		lootCard{baseCard: baseCard{name: "III. The Empress", effect: "", id: theEmpress}, f: theEmpressFunc},
-		lootCard{baseCard: baseCard{name: "IV. The Emperor", effect: "", id: theEmperor}, f: theEmperorFunc},
-		lootCard{baseCard: baseCard{name: "V. The Hierophant", effect: "", id: theHierophant}, f: theHierophantFunc},
-		lootCard{baseCard: baseCard{name: "VI. The Lovers", effect: "", id: theLovers}, f: theLoversFunc},
-		lootCard{baseCard: baseCard{name: "VII. The Chariot", effect: "", id: theChariot}, f: theChariotFunc},
-		lootCard{baseCard: baseCard{name: "VIII. Justice", effect: "", id: justice}, f: justiceFunc},
-		lootCard{baseCard: baseCard{name: "IX. The Hermit", effect: "", id: theHermit}, f: theHermitFunc},
-		lootCard{baseCard: baseCard{name: "X. Wheel of Fortune", effect: "", id: wheelOfFortune}, f: wheelOfFortuneFunc},
-		lootCard{baseCard: baseCard{name: "XI. Strength", effect: "", id: strength}, f: strengthFunc},
-		lootCard{baseCard: baseCard{name: "XII. The Hanged Man", effect: "", id: theHangedMan}, f: theHangedManFunc},
-		lootCard{baseCard: baseCard{name: "XIII. Death", effect: "", id: deathLoot}, f: deathTarotCardFunc},
-		lootCard{baseCard: baseCard{name: "XIV. The Tower", effect: "", id: theTower}, f: theTowerFunc},
-		lootCard{baseCard: baseCard{name: "XV. The Devil", effect: "", id: theDevil}, f: theDevilFunc},
-		lootCard{baseCard: baseCard{name: "XVI. Temperance", effect: "", id: temperance}, f: temperanceFunc},
-		lootCard{baseCard: baseCard{name: "XVII. The Stars", effect: "", id: theStars}, f: theStarsFunc},
-		lootCard{baseCard: baseCard{name: "XVIII. The Moon", effect: "", id: theMoon}, f: theMoonFunc},
-		lootCard{baseCard: baseCard{name: "XIX. The Sun", effect: "", id: theSun}, f: theSunFunc},
-		lootCard{baseCard: baseCard{name: "XX. Judgement", effect: "", id: judgement}, f: judgementFunc},
-		lootCard{baseCard: baseCard{name: "XXI. The World", effect: "", id: theWorld}, f: theWorldFunc},
-		lootCard{baseCard: baseCard{name: "Bloody Penny", effect: "", id: bloodyPenny}, trinket: true},
-		lootCard{baseCard: baseCard{name: "Broken Ankh", effect: "", id: brokenAnkh}, trinket: true},
-		lootCard{baseCard: baseCard{name: "Cain's Eye", effect: "", id: cainsEye}, trinket: true},
-		lootCard{baseCard: baseCard{name: "Counterfeit Penny", effect: "", id: counterfeitPenny}, trinket: true},
-		lootCard{baseCard: baseCard{name: "Curved Horn", effect: "", id: curvedHorn}, trinket: true},
-		lootCard{baseCard: baseCard{name: "Golden Horseshoe", effect: "", id: goldenHorseShoe}, trinket: true},
-		lootCard{baseCard: baseCard{name: "Guppy's Hairball", effect: "", id: guppysHairball}, trinket: true},
-		lootCard{baseCard: baseCard{name: "Purple Heart", effect: "", id: purpleHeart}, trinket: true},
-		lootCard{baseCard: baseCard{name: "Swallowed Penny", effect: "", id: swallowedPenny}, trinket: true},
+		lootCard{baseCard: baseCard{name: "A Penny!", effect: aPennyDesc, id: aPenny}, f: aPennyFunc},
+		lootCard{baseCard: baseCard{name: "A Penny!", effect: aPennyDesc, id: aPenny}, f: aPennyFunc},
+		lootCard{baseCard: baseCard{name: "A Penny!", effect: aPennyDesc, id: aPenny}, f: aPennyFunc},
+		lootCard{baseCard: baseCard{name: "A Penny!", effect: aPennyDesc, id: aPenny}, f: aPennyFunc},
+		lootCard{baseCard: baseCard{name: "A Penny!", effect: aPennyDesc, id: aPenny}, f: aPennyFunc},
+		lootCard{baseCard: baseCard{name: "A Penny!", effect: aPennyDesc, id: aPenny}, f: aPennyFunc},
+		lootCard{baseCard: baseCard{name: "2 Cents!", effect: twoCentsDesc, id: twoCents}, f: twoCentsFunc},
+		lootCard{baseCard: baseCard{name: "2 Cents!", effect: twoCentsDesc, id: twoCents}, f: twoCentsFunc},
+		lootCard{baseCard: baseCard{name: "2 Cents!", effect: twoCentsDesc, id: twoCents}, f: twoCentsFunc},
+		lootCard{baseCard: baseCard{name: "2 Cents!", effect: twoCentsDesc, id: twoCents}, f: twoCentsFunc},
+		lootCard{baseCard: baseCard{name: "2 Cents!", effect: twoCentsDesc, id: twoCents}, f: twoCentsFunc},
+		lootCard{baseCard: baseCard{name: "2 Cents!", effect: twoCentsDesc, id: twoCents}, f: twoCentsFunc},
+		lootCard{baseCard: baseCard{name: "2 Cents!", effect: twoCentsDesc, id: twoCents}, f: twoCentsFunc},
+		lootCard{baseCard: baseCard{name: "2 Cents!", effect: twoCentsDesc, id: twoCents}, f: twoCentsFunc},
+		lootCard{baseCard: baseCard{name: "2 Cents!", effect: twoCentsDesc, id: twoCents}, f: twoCentsFunc},
+		lootCard{baseCard: baseCard{name: "2 Cents!", effect: twoCentsDesc, id: twoCents}, f: twoCentsFunc},
+		lootCard{baseCard: baseCard{name: "2 Cents!", effect: twoCentsDesc, id: twoCents}, f: twoCentsFunc},
+		lootCard{baseCard: baseCard{name: "2 Cents!", effect: twoCentsDesc, id: twoCents}, f: twoCentsFunc},
+		lootCard{baseCard: baseCard{name: "3 Cents!", effect: threeCentsDesc, id: threeCents}, f: threeCentsFunc},
+		lootCard{baseCard: baseCard{name: "3 Cents!", effect: threeCentsDesc, id: threeCents}, f: threeCentsFunc},
+		lootCard{baseCard: baseCard{name: "3 Cents!", effect: threeCentsDesc, id: threeCents}, f: threeCentsFunc},
+		lootCard{baseCard: baseCard{name: "3 Cents!", effect: threeCentsDesc, id: threeCents}, f: threeCentsFunc},
+		lootCard{baseCard: baseCard{name: "3 Cents!", effect: threeCentsDesc, id: threeCents}, f: threeCentsFunc},
+		lootCard{baseCard: baseCard{name: "3 Cents!", effect: threeCentsDesc, id: threeCents}, f: threeCentsFunc},
+		lootCard{baseCard: baseCard{name: "3 Cents!", effect: threeCentsDesc, id: threeCents}, f: threeCentsFunc},
+		lootCard{baseCard: baseCard{name: "3 Cents!", effect: threeCentsDesc, id: threeCents}, f: threeCentsFunc},
+		lootCard{baseCard: baseCard{name: "3 Cents!", effect: threeCentsDesc, id: threeCents}, f: threeCentsFunc},
+		lootCard{baseCard: baseCard{name: "3 Cents!", effect: threeCentsDesc, id: threeCents}, f: threeCentsFunc},
+		lootCard{baseCard: baseCard{name: "3 Cents!", effect: threeCentsDesc, id: threeCents}, f: threeCentsFunc},
+		lootCard{baseCard: baseCard{name: "3 Cents!", effect: threeCentsDesc, id: threeCents}, f: threeCentsFunc},
+		lootCard{baseCard: baseCard{name: "3 Cents!", effect: threeCentsDesc, id: threeCents}, f: threeCentsFunc},
+		lootCard{baseCard: baseCard{name: "3 Cents!", effect: threeCentsDesc, id: threeCents}, f: threeCentsFunc},
+		lootCard{baseCard: baseCard{name: "3 Cents!", effect: threeCentsDesc, id: threeCents}, f: threeCentsFunc},
+		lootCard{baseCard: baseCard{name: "4 Cents!", effect: fourCentsDesc, id: fourCents}, f: fourCentsFunc},
+		lootCard{baseCard: baseCard{name: "4 Cents!", effect: fourCentsDesc, id: fourCents}, f: fourCentsFunc},
+		lootCard{baseCard: baseCard{name: "4 Cents!", effect: fourCentsDesc, id: fourCents}, f: fourCentsFunc},
+		lootCard{baseCard: baseCard{name: "4 Cents!", effect: fourCentsDesc, id: fourCents}, f: fourCentsFunc},
+		lootCard{baseCard: baseCard{name: "4 Cents!", effect: fourCentsDesc, id: fourCents}, f: fourCentsFunc},
+		lootCard{baseCard: baseCard{name: "4 Cents!", effect: fourCentsDesc, id: fourCents}, f: fourCentsFunc},
+		lootCard{baseCard: baseCard{name: "4 Cents!", effect: fourCentsDesc, id: fourCents}, f: fourCentsFunc},
+		lootCard{baseCard: baseCard{name: "4 Cents!", effect: fourCentsDesc, id: fourCents}, f: fourCentsFunc},
+		lootCard{baseCard: baseCard{name: "4 Cents!", effect: fourCentsDesc, id: fourCents}, f: fourCentsFunc},
+		lootCard{baseCard: baseCard{name: "4 Cents!", effect: fourCentsDesc, id: fourCents}, f: fourCentsFunc},
+		lootCard{baseCard: baseCard{name: "A Nickel!", effect: aNickelDesc, id: aNickel}, f: aNickelFunc},
+		lootCard{baseCard: baseCard{name: "A Nickel!", effect: aNickelDesc, id: aNickel}, f: aNickelFunc},
+		lootCard{baseCard: baseCard{name: "A Nickel!", effect: aNickelDesc, id: aNickel}, f: aNickelFunc},
+		lootCard{baseCard: baseCard{name: "A Nickel!", effect: aNickelDesc, id: aNickel}, f: aNickelFunc},
+		lootCard{baseCard: baseCard{name: "A Nickel!", effect: aNickelDesc, id: aNickel}, f: aNickelFunc},
+		lootCard{baseCard: baseCard{name: "A Dime!!", effect: aDimeDesc, id: aDime}, f: aDimeFunc},
+		lootCard{baseCard: baseCard{name: "Blank Rune", effect: blankRuneDesc, id: blankRune}, f: blankRuneFunc},
+		lootCard{baseCard: baseCard{name: "Bomb!", effect: bombDesc, id: bomb}, f: bombFunc},
+		lootCard{baseCard: baseCard{name: "Bomb!", effect: bombDesc, id: bomb}, f: bombFunc},
+		lootCard{baseCard: baseCard{name: "Bomb!", effect: bombDesc, id: bomb}, f: bombFunc},
+		lootCard{baseCard: baseCard{name: "Bomb!", effect: bombDesc, id: bomb}, f: bombFunc},
+		lootCard{baseCard: baseCard{name: "Butter Bean!", effect: butterBeanDesc, id: butterBean}, f: butterBeanFunc},
+		lootCard{baseCard: baseCard{name: "Butter Bean!", effect: butterBeanDesc, id: butterBean}, f: butterBeanFunc},
+		lootCard{baseCard: baseCard{name: "Butter Bean!", effect: butterBeanDesc, id: butterBean}, f: butterBeanFunc},
+		lootCard{baseCard: baseCard{name: "Dagaz", effect: dagazDesc, id: dagaz}, f: dagazFunc},
+		lootCard{baseCard: baseCard{name: "Dice Shard", effect: diceShardDesc, id: diceShard}, f: diceShardFunc},
+		lootCard{baseCard: baseCard{name: "Dice Shard", effect: diceShardDesc, id: diceShard}, f: diceShardFunc},
+		lootCard{baseCard: baseCard{name: "Dice Shard", effect: diceShardDesc, id: diceShard}, f: diceShardFunc},
+		lootCard{baseCard: baseCard{name: "Ehwaz", effect: ehwazDesc, id: ehwaz}, f: ehwazFunc},
+		lootCard{baseCard: baseCard{name: "Gold Bomb!!", effect: goldBombDesc, id: goldBomb}, f: goldBombFunc},
+		lootCard{baseCard: baseCard{name: "Lil Battery", effect: lilBatteryDesc, id: lilBattery}, f: lilBatteryFunc},
+		lootCard{baseCard: baseCard{name: "Lil Battery", effect: lilBatteryDesc, id: lilBattery}, f: lilBatteryFunc},
+		lootCard{baseCard: baseCard{name: "Lil Battery", effect: lilBatteryDesc, id: lilBattery}, f: lilBatteryFunc},
+		lootCard{baseCard: baseCard{name: "Lil Battery", effect: lilBatteryDesc, id: lilBattery}, f: lilBatteryFunc},
+		lootCard{baseCard: baseCard{name: "Lost Soul", effect: lostSoulDesc, id: lostSoul}, f: lostSoulFunc},
+		lootCard{baseCard: baseCard{name: "Mega Battery", effect: megaBatteryDesc, id: megaBattery}, f: megaBatteryFunc},
+		lootCard{baseCard: baseCard{name: "Pills! (Blue)", effect: pillsBlueDesc, id: pillsBlue}, f: pillsBlueFunc},
+		lootCard{baseCard: baseCard{name: "Pills! (Red)", effect: pillsRedDesc, id: pillsRed}, f: pillsRedFunc},
+		lootCard{baseCard: baseCard{name: "Pills! (Yellow)", effect: pillsYellowDesc, id: pillsYellow}, f: pillsYellowFunc},
+		lootCard{baseCard: baseCard{name: "Soul Heart", effect: soulHeartDesc, id: soulHeart}, f: soulHeartFunc},
+		lootCard{baseCard: baseCard{name: "Soul Heart", effect: soulHeartDesc, id: soulHeart}, f: soulHeartFunc},
+		lootCard{baseCard: baseCard{name: "0. The Fool", effect: theFoolDesc, id: theFool}, f: theFoolFunc},
+		lootCard{baseCard: baseCard{name: "I. The Magician", effect: theMagicianDesc, id: theMagician}, f: theMagicianFunc},
+		lootCard{baseCard: baseCard{name: "II. The High Priestess", effect: theHighPriestessDesc, id: theHighPriestess}, f: theHighPriestessFunc},
+		lootCard{baseCard: baseCard{name: "III. The Empress", effect: theEmpressDesc, id: theEmpress}, f: theEmpressFunc},
+		lootCard{baseCard: baseCard{name: "IV. The Emperor", effect: theEmperorDesc, id: theEmperor}, f: theEmperorFunc},
+		lootCard{baseCard: baseCard{name: "V. The Hierophant", effect: theHierophantDesc, id: theHierophant}, f: theHierophantFunc},
+		lootCard{baseCard: baseCard{name: "VI. The Lovers", effect: theLoversDesc, id: theLovers}, f: theLoversFunc},
+		lootCard{baseCard: baseCard{name: "VII. The Chariot", effect: theChariotDesc, id: theChariot}, f: theChariotFunc},
+		lootCard{baseCard: baseCard{name: "VIII. Justice", effect: justiceDesc, id: justice}, f: justiceFunc},
+		lootCard{baseCard: baseCard{name: "IX. The Hermit", effect: theHermitDesc, id: theHermit}, f: theHermitFunc},
+		lootCard{baseCard: baseCard{name: "X. Wheel of Fortune", effect: wheelOfFortuneDesc, id: wheelOfFortune}, f: wheelOfFortuneFunc},
+		lootCard{baseCard: baseCard{name: "XI. Strength", effect: strengthDesc, id: strength}, f: strengthFunc},
+		lootCard{baseCard: baseCard{name: "XII. The Hanged Man", effect: theHangedManDesc, id: theHangedMan}, f: theHangedManFunc},
+		lootCard{baseCard: baseCard{name: "XIII. Death", effect: deathLootDesc, id: deathLoot}, f: deathTarotCardFunc},
+		lootCard{baseCard: baseCard{name: "XIV. The Tower", effect: theTowerDesc, id: theTower}, f: theTowerFunc},
+		lootCard{baseCard: baseCard{name: "XV. The Devil", effect: theDevilDesc, id: theDevil}, f: theDevilFunc},
+		lootCard{baseCard: baseCard{name: "XVI. Temperance", effect: temperanceDesc, id: temperance}, f: temperanceFunc},
+		lootCard{baseCard: baseCard{name: "XVII. The Stars", effect: theStarsDesc, id: theStars}, f: theStarsFunc},
+		lootCard{baseCard: baseCard{name: "XVIII. The Moon", effect: theMoonDesc, id: theMoon}, f: theMoonFunc},
+		lootCard{baseCard: baseCard{name: "XIX. The Sun", effect: theSunDesc, id: theSun}, f: theSunFunc},
+		lootCard{baseCard: baseCard{name: "XX. Judgement", effect: judgementDesc, id: judgement}, f: judgementFunc},
+		lootCard{baseCard: baseCard{name: "XXI. The World", effect: theWorldDesc, id: theWorld}, f: theWorldFunc},
+		lootCard{baseCard: baseCard{name: "Bloody Penny", effect: bloodyPennyDesc, id: bloodyPenny}, trinket: true},
+		lootCard{baseCard: baseCard{name: "Broken Ankh", effect: brokenAnkhDesc, id: brokenAnkh}, trinket: true},
+		lootCard{baseCard: baseCard{name: "Cain's Eye", effect: cainsEyeDesc, id: cainsEye}, trinket: true},
+		lootCard{baseCard: baseCard{name: "Counterfeit Penny", effect: counterfeitPennyDesc, id: counterfeitPenny}, trinket: true},
+		lootCard{baseCard: baseCard{name: "Curved Horn", effect: curvedHornDesc, id: curvedHorn}, trinket: true},
+		lootCard{baseCard: baseCard{name: "Golden Horseshoe", effect: goldenHorseShoeDesc, id: goldenHorseShoe}, trinket: true},
+		lootCard{baseCard: baseCard{name: "Guppy's Hairball", effect: guppysHairballDesc, id: guppysHairball}, trinket: true},
+		lootCard{baseCard: baseCard{name: "Purple Heart", effect: purpleHeartDesc, id: purpleHeart}, trinket: true},
+		lootCard{baseCard: baseCard{name: "Swallowed Penny", effect: swallowedPennyDesc, id: swallowedPenny}, trinket: true},
 	}...)
 	if useExpansionOne {
 		d.append([]card{
-			lootCard{baseCard: baseCard{name: "A Penny!", effect: "", id: aPenny}, f: nil},
-			lootCard{baseCard: baseCard{name: "A Penny!", effect: "", id: aPenny}, f: nil},
-			lootCard{baseCard: baseCard{name: "2 Cents!", effect: "", id: twoCents}, f: nil},
-			lootCard{baseCard: baseCard{name: "2 Cents!", effect: "", id: twoCents}, f: nil},
-			lootCard{baseCard: baseCard{name: "2 Cents!", effect: "", id: twoCents}, f: nil},
-			lootCard{baseCard: baseCard{name: "3 Cents!", effect: "", id: threeCents}, f: nil},
-			lootCard{baseCard: baseCard{name: "3 Cents!", effect: "", id: threeCents}, f: nil},
-			lootCard{baseCard: baseCard{name: "4 Cents!", effect: "", id: fourCents}, f: nil},
-			lootCard{baseCard: baseCard{name: "3 Cents!", effect: "", id: threeCents}, f: nil},
-			lootCard{baseCard: baseCard{name: "A Sack", effect: "", id: aSack}, f: aSackFunc},
-			lootCard{baseCard: baseCard{name: "Bomb", effect: "", id: bomb}, f: nil},
-			lootCard{baseCard: baseCard{name: "Charged Penny", effect: "", id: chargedPenny}, f: nil},
-			lootCard{baseCard: baseCard{name: "Credit Card", effect: "", id: creditCard}, f: nil},
-			lootCard{baseCard: baseCard{name: "Holy Card", effect: "", id: holyCard}, f: nil},
-			lootCard{baseCard: baseCard{name: "Jera", effect: "", id: jera}, f: nil},
-			lootCard{baseCard: baseCard{name: "Joker", effect: "", id: joker}, f: nil},
-			lootCard{baseCard: baseCard{name: "Pills! (Purple)", effect: "", id: pillsPurple}, f: nil},
-			lootCard{baseCard: baseCard{name: "Soul Heart", effect: "", id: soulHeart}, f: nil},
-			lootCard{baseCard: baseCard{name: "Two of Diamonds", effect: "", id: twoOfDiamonds}, f: nil},
-			lootCard{baseCard: baseCard{name: "Cancer", effect: "", id: cancer}, trinket: true, f: nil},
-			lootCard{baseCard: baseCard{name: "Pink Eye", effect: "", id: pinkEye}, trinket: true, f: nil},
+			lootCard{baseCard: baseCard{name: "A Penny!", effect: aPennyDesc, id: aPenny}, f: nil},
+			lootCard{baseCard: baseCard{name: "A Penny!", effect: aPennyDesc, id: aPenny}, f: nil},
+			lootCard{baseCard: baseCard{name: "2 Cents!", effect: twoCentsDesc, id: twoCents}, f: nil},
+			lootCard{baseCard: baseCard{name: "2 Cents!", effect: twoCentsDesc, id: twoCents}, f: nil},
+			lootCard{baseCard: baseCard{name: "2 Cents!", effect: twoCentsDesc, id: twoCents}, f: nil},
+			lootCard{baseCard: baseCard{name: "3 Cents!", effect: threeCentsDesc, id: threeCents}, f: nil},
+			lootCard{baseCard: baseCard{name: "3 Cents!", effect: threeCentsDesc, id: threeCents}, f: nil},
+			lootCard{baseCard: baseCard{name: "4 Cents!", effect: fourCentsDesc, id: fourCents}, f: nil},
+			lootCard{baseCard: baseCard{name: "3 Cents!", effect: threeCentsDesc, id: threeCents}, f: nil},
+			lootCard{baseCard: baseCard{name: "A Sack", effect: aSackDesc, id: aSack}, f: aSackFunc},
+			lootCard{baseCard: baseCard{name: "Bomb", effect: bombDesc, id: bomb}, f: nil},
+			lootCard{baseCard: baseCard{name: "Charged Penny", effect: chargedPennyDesc, id: chargedPenny}, f: nil},
+			lootCard{baseCard: baseCard{name: "Credit Card", effect: creditCardDesc, id: creditCard}, f: creditCardFunc},
+			lootCard{baseCard: baseCard{name: "Holy Card", effect: holyCardDesc, id: holyCard}, f: nil},
+			lootCard{baseCard: baseCard{name: "Jera", effect: jeraDesc, id: jera}, f: nil},
+			lootCard{baseCard: baseCard{name: "Joker", effect: jokerDesc, id: joker}, f: nil},
+			lootCard{baseCard: baseCard{name: "Pills! (Purple)", effect: pillsPurpleDesc, id: pillsPurple}, f: nil},
+			lootCard{baseCard: baseCard{name: "Soul Heart", effect: soulHeartDesc, id: soulHeart}, f: nil},
+			lootCard{baseCard: baseCard{name: "Two of Diamonds", effect: twoOfDiamondsDesc, id: twoOfDiamonds}, f: nil},
+			lootCard{baseCard: baseCard{name: "Cancer", effect: cancerDesc, id: cancer}, trinket: true, f: nil},
+			lootCard{baseCard: baseCard{name: "Pink Eye", effect: pinkEyeDesc, id: pinkEye}, trinket: true, f: nil},
 		}...)
 	}
 	if useExpansionTwo {
 		d.append([]card{
-			lootCard{baseCard: baseCard{name: "A Penny!", effect: "", id: aPenny}, f: nil},
-			lootCard{baseCard: baseCard{name: "A Penny!", effect: "", id: aPenny}, f: nil},
-			lootCard{baseCard: baseCard{name: "A Penny!", effect: "", id: aPenny}, f: nil},
-			lootCard{baseCard: baseCard{name: "2 Cents!", effect: "", id: twoCents}, f: nil},
-			lootCard{baseCard: baseCard{name: "2 Cents!", effect: "", id: twoCents}, f: nil},
-			lootCard{baseCard: baseCard{name: "2 Cents!", effect: "", id: twoCents}, f: nil},
-			lootCard{baseCard: baseCard{name: "3 Cents!", effect: "", id: threeCents}, f: nil},
-			lootCard{baseCard: baseCard{name: "3 Cents!", effect: "", id: threeCents}, f: nil},
-			lootCard{baseCard: baseCard{name: "3 Cents!", effect: "", id: threeCents}, f: nil},
-			lootCard{baseCard: baseCard{name: "3 Cents!", effect: "", id: threeCents}, f: nil},
-			lootCard{baseCard: baseCard{name: "4 Cents!", effect: "", id: fourCents}, f: nil},
-			lootCard{baseCard: baseCard{name: "4 Cents!", effect: "", id: fourCents}, f: nil},
-			lootCard{baseCard: baseCard{name: "A Nickel!", effect: "", id: aNickel}, f: nil},
-			lootCard{baseCard: baseCard{name: "A Nickel!", effect: "", id: aNickel}, f: nil},
-			lootCard{baseCard: baseCard{name: "Ansuz", effect: "", id: ansuz}, f: nil},
-			lootCard{baseCard: baseCard{name: "Black Rune", effect: "", id: blackRune}, f: nil},
-			lootCard{baseCard: baseCard{name: "Bomb!", effect: "", id: bomb}, f: nil},
-			lootCard{baseCard: baseCard{name: "Butter Bean!", effect: "", id: butterBean}, f: nil},
-			lootCard{baseCard: baseCard{name: "Dice Shard", effect: "", id: diceShard}, f: nil},
-			lootCard{baseCard: baseCard{name: "Get Out of Jail Card", effect: "", id: getOutOfJail}, f: nil},
-			lootCard{baseCard: baseCard{name: "Gold Key", effect: "", id: goldKey}, f: nil},
-			lootCard{baseCard: baseCard{name: "Lil Battery", effect: "", id: lilBattery}, f: nil},
-			lootCard{baseCard: baseCard{name: "Perthro", effect: "", id: perthro}, f: nil},
-			lootCard{baseCard: baseCard{name: "Pills! (Black)", effect: "", id: pillsBlack}, f: nil},
-			lootCard{baseCard: baseCard{name: "Pills! (Spots)", effect: "", id: pillsSpots}, f: nil},
-			lootCard{baseCard: baseCard{name: "Pills! (White)", effect: "", id: pillsWhite}, f: nil},
-			lootCard{baseCard: baseCard{name: "? Card", effect: "", id: questionMarkCard}, f: nil},
-			lootCard{baseCard: baseCard{name: "AAA Battery", effect: "", id: aaaBattery}, trinket: true, f: nil},
-			lootCard{baseCard: baseCard{name: "Poker Chip", effect: "", id: pokerChip}, trinket: true, f: nil},
-			lootCard{baseCard: baseCard{name: "Tape Worm", effect: "", id: tapeWorm}, trinket: true, f: nil},
-			lootCard{baseCard: baseCard{name: "The Left Hand", effect: "", id: theLeftHand}, trinket: true, f: nil},
+			lootCard{baseCard: baseCard{name: "A Penny!", effect: aPennyDesc, id: aPenny}, f: nil},
+			lootCard{baseCard: baseCard{name: "A Penny!", effect: aPennyDesc, id: aPenny}, f: nil},
+			lootCard{baseCard: baseCard{name: "A Penny!", effect: aPennyDesc, id: aPenny}, f: nil},
+			lootCard{baseCard: baseCard{name: "2 Cents!", effect: twoCentsDesc, id: twoCents}, f: nil},
+			lootCard{baseCard: baseCard{name: "2 Cents!", effect: twoCentsDesc, id: twoCents}, f: nil},
+			lootCard{baseCard: baseCard{name: "2 Cents!", effect: twoCentsDesc, id: twoCents}, f: nil},
+			lootCard{baseCard: baseCard{name: "3 Cents!", effect: threeCentsDesc, id: threeCents}, f: nil},
+			lootCard{baseCard: baseCard{name: "3 Cents!", effect: threeCentsDesc, id: threeCents}, f: nil},
+			lootCard{baseCard: baseCard{name: "3 Cents!", effect: threeCentsDesc, id: threeCents}, f: nil},
+			lootCard{baseCard: baseCard{name: "3 Cents!", effect: threeCentsDesc, id: threeCents}, f: nil},
+			lootCard{baseCard: baseCard{name: "4 Cents!", effect: fourCentsDesc, id: fourCents}, f: nil},
+			lootCard{baseCard: baseCard{name: "4 Cents!", effect: fourCentsDesc, id: fourCents}, f: nil},
+			lootCard{baseCard: baseCard{name: "A Nickel!", effect: aNickelDesc, id: aNickel}, f: nil},
+			lootCard{baseCard: baseCard{name: "A Nickel!", effect: aNickelDesc, id: aNickel}, f: nil},
+			lootCard{baseCard: baseCard{name: "Ansuz", effect: ansuzDesc, id: ansuz}, f: nil},
+			lootCard{baseCard: baseCard{name: "Black Rune", effect: blackRuneDesc, id: blackRune}, f: nil},
+			lootCard{baseCard: baseCard{name: "Bomb!", effect: bombDesc, id: bomb}, f: nil},
+			lootCard{baseCard: baseCard{name: "Butter Bean!", effect: butterBeanDesc, id: butterBean}, f: nil},
+			lootCard{baseCard: baseCard{name: "Dice Shard", effect: diceShardDesc, id: diceShard}, f: nil},
+			lootCard{baseCard: baseCard{name: "Get Out of Jail Card", effect: getOutOfJailDesc, id: getOutOfJail}, f: getOutOfJailFunc},
+			lootCard{baseCard: baseCard{name: "Gold Key", effect: goldKeyDesc, id: goldKey}, f: nil},
+			lootCard{baseCard: baseCard{name: "Lil Battery", effect: lilBatteryDesc, id: lilBattery}, f: nil},
+			lootCard{baseCard: baseCard{name: "Perthro", effect: perthroDesc, id: perthro}, f: nil},
+			lootCard{baseCard: baseCard{name: "Pills! (Black)", effect: pillsBlackDesc, id: pillsBlack}, f: nil},
+			lootCard{baseCard: baseCard{name: "Pills! (Spots)", effect: pillsSpotsDesc, id: pillsSpots}, f: nil},
+			lootCard{baseCard: baseCard{name: "Pills! (White)", effect: pillsWhiteDesc, id: pillsWhite}, f: nil},
+			lootCard{baseCard: baseCard{name: "? Card", effect: questionMarkCardDesc, id: questionMarkCard}, f: nil},
+			lootCard{baseCard: baseCard{name: "AAA Battery", effect: aaaBatteryDesc, id: aaaBattery}, trinket: true, f: nil},
+			lootCard{baseCard: baseCard{name: "Poker Chip", effect: pokerChipDesc, id: pokerChip}, trinket: true, f: nil},
+			lootCard{baseCard: baseCard{name: "Tape Worm", effect: tapeWormDesc, id: tapeWorm}, trinket: true, f: nil},
+			lootCard{baseCard: baseCard{name: "The Left Hand", effect: theLeftHandDesc, id: theLeftHand}, trinket: true, f: nil},
 		}...)
 	}
 	return d
@@ -814,169 +835,169 @@ func getMonsterCards(useExpansionOne bool, useExpansionTwo bool) deck {
 	}
 	deck := make(deck, 0, n)
 	deck.append([]card{
-		monsterCard{baseCard: baseCard{name: "Big Spider", id: bigSpider}, baseHealth: 3, baseRoll: 4, baseAttack: 1, f: bigSpiderDeath, rf: bigSpiderReward},
-		monsterCard{baseCard: baseCard{name: "Black Bony", id: blackBony}, baseHealth: 3, baseRoll: 4, baseAttack: 1, f: blackBonyDeath, rf: blackBonyReward},
-		monsterCard{baseCard: baseCard{name: "Boom Fly", id: boomFly}, baseHealth: 1, baseRoll: 4, baseAttack: 1, f: boomFlyDeath, rf: boomFlyReward},
-		monsterCard{baseCard: baseCard{name: "Clotty", id: clotty}, baseHealth: 2, baseRoll: 3, baseAttack: 1, rf: clottyReward},
-		monsterCard{baseCard: baseCard{name: "Cod Worm", id: codWorm}, baseHealth: 1, baseRoll: 5, rf: codWormReward},
-		monsterCard{baseCard: baseCard{name: "Conjoined Fatty", id: conjoinedFatty}, baseHealth: 4, baseRoll: 3, baseAttack: 2, rf: conjoinedFattyReward},
-		monsterCard{baseCard: baseCard{name: "Dank Globin", id: dankGlobin}, baseHealth: 2, baseRoll: 4, baseAttack: 2, f: dankGlobinDeath, rf: dankGlobinReward},
-		monsterCard{baseCard: baseCard{name: "Dinga", id: dinga}, baseHealth: 3, baseRoll: 3, baseAttack: 1, rf: dingaReward},
-		monsterCard{baseCard: baseCard{name: "Dip", id: dip}, baseHealth: 1, baseRoll: 4, baseAttack: 1, rf: dipReward},
-		monsterCard{baseCard: baseCard{name: "Dople", id: dople}, baseHealth: 2, baseRoll: 4, baseAttack: 2, ef: dopleEvent, rf: dopleReward},
-		monsterCard{baseCard: baseCard{name: "Evil Twin", id: evilTwin}, baseHealth: 3, baseRoll: 5, baseAttack: 2, ef: evilTwinEvent, rf: evilTwinReward},
-		monsterCard{baseCard: baseCard{name: "Fat Bat", id: fatBat}, baseHealth: 3, baseRoll: 5, baseAttack: 1, rf: fatBatReward},
-		monsterCard{baseCard: baseCard{name: "Fatty", id: fatty}, baseHealth: 4, baseRoll: 2, baseAttack: 1, rf: fattyReward},
-		monsterCard{baseCard: baseCard{name: "Fly", id: fly}, baseHealth: 1, baseRoll: 2, baseAttack: 1, rf: flyReward},
-		monsterCard{baseCard: baseCard{name: "Greedling", id: greedling}, baseHealth: 2, baseRoll: 5, baseAttack: 1, f: greedlingDeath, rf: greedlingReward},
-		monsterCard{baseCard: baseCard{name: "Hanger", id: hanger}, baseHealth: 2, baseRoll: 4, baseAttack: 2, f: hangerDeath, rf: hangerReward},
-		monsterCard{baseCard: baseCard{name: "Hopper", id: hopper}, baseHealth: 2, baseRoll: 3, baseAttack: 1, ef: hopperEvent, rf: hopperReward},
-		monsterCard{baseCard: baseCard{name: "Horf", id: horf}, baseHealth: 1, baseRoll: 4, baseAttack: 1, rf: horfReward},
-		monsterCard{baseCard: baseCard{name: "Keeper Head", id: keeperHead}, baseHealth: 2, baseRoll: 4, baseAttack: 1, ef: keeperHeadEvent, rf: keeperReward},
-		monsterCard{baseCard: baseCard{name: "Leaper", id: leaper}, baseHealth: 2, baseRoll: 4, baseAttack: 1, rf: leaperReward},
-		monsterCard{baseCard: baseCard{name: "Leech", id: leech}, baseHealth: 1, baseRoll: 4, baseAttack: 2, rf: leechReward},
-		monsterCard{baseCard: baseCard{name: "Mom's Dead Hand", id: momsDeadHand}, baseHealth: 2, baseRoll: 5, baseAttack: 1, f: momsDeadHandDeath, rf: momsDeadHandReward},
-		monsterCard{baseCard: baseCard{name: "Mom's Eye", id: momsEye}, baseHealth: 1, baseRoll: 4, baseAttack: 2, f: momsEyeDeath, rf: momsEyeReward},
-		monsterCard{baseCard: baseCard{name: "Mom's Hand", id: momsHand}, baseHealth: 2, baseRoll: 4, baseAttack: 1, ef: momsHandEvent, rf: momsHandReward},
-		monsterCard{baseCard: baseCard{name: "Mulliboom", id: mulliboom}, baseHealth: 1, baseRoll: 2, baseAttack: 4, f: mulliboomDeath, rf: mulliboomReward},
-		monsterCard{baseCard: baseCard{name: "Mulligan", id: mulligan}, baseHealth: 1, baseRoll: 3, baseAttack: 1, f: mulliganDeath, rf: mulliganReward},
-		monsterCard{baseCard: baseCard{name: "Pale Fatty", id: paleFatty}, baseHealth: 4, baseRoll: 3, baseAttack: 1, rf: paleFattyReward},
-		monsterCard{baseCard: baseCard{name: "Pooter", id: pooter}, baseHealth: 2, baseRoll: 3, baseAttack: 1, rf: pooterReward},
-		monsterCard{baseCard: baseCard{name: "Portal", id: portal}, baseHealth: 2, baseRoll: 4, baseAttack: 1, f: portalDeath, rf: portalReward},
-		monsterCard{baseCard: baseCard{name: "Psy Horf", id: psyHorf}, baseHealth: 1, baseRoll: 5, baseAttack: 1, f: psyHorfDeath, rf: psyHorfReward},
-		monsterCard{baseCard: baseCard{name: "Rage Creep", id: rageCreep}, baseHealth: 1, baseRoll: 5, baseAttack: 1, ef: rageCreepEvent, rf: rageCreepReward},
-		monsterCard{baseCard: baseCard{name: "Red Host", id: redHost}, baseHealth: 2, baseRoll: 3, baseAttack: 2, rf: redHostReward},
-		monsterCard{baseCard: baseCard{name: "Ring of Flies", id: ringOfFlies}, baseHealth: 3, baseRoll: 3, baseAttack: 1, ef: ringOfFliesEvent, rf: ringOfFliesReward},
-		monsterCard{baseCard: baseCard{name: "Spider", id: spider}, baseHealth: 1, baseRoll: 4, baseAttack: 1, rf: spiderReward},
-		monsterCard{baseCard: baseCard{name: "Squirt", id: squirt}, baseHealth: 2, baseRoll: 3, baseAttack: 1, rf: squirtReward},
-		monsterCard{baseCard: baseCard{name: "Stoney", id: stoney}, baseHealth: 3, ef: stoneyEvent, rf: stoneyReward},
-		monsterCard{baseCard: baseCard{name: "Swarm of Flies", id: swarmOfFlies}, baseHealth: 5, baseRoll: 2, baseAttack: 1, ef: swarmOfFliesEvent, rf: swarmOfFliesReward},
-		monsterCard{baseCard: baseCard{name: "Trite", id: trite}, baseHealth: 1, baseRoll: 5, baseAttack: 1, rf: triteReward},
-		monsterCard{baseCard: baseCard{name: "Wizoob", id: wizoob}, baseHealth: 3, baseRoll: 5, baseAttack: 1, f: wizoobDeath, rf: wizoobReward},
-		monsterCard{baseCard: baseCard{name: "Cursed Fatty", id: cursedFatty}, baseHealth: 4, baseRoll: 2, baseAttack: 1, ef: cursedFattyEvent, rf: cursedFattyReward},
-		monsterCard{baseCard: baseCard{name: "Cursed Gaper", id: cursedGaper}, baseHealth: 2, baseRoll: 4, baseAttack: 1, ef: cursedGaperEvent, rf: cursedGaperReward},
-		monsterCard{baseCard: baseCard{name: "Cursed Horf", id: cursedHorf}, baseHealth: 1, baseRoll: 4, baseAttack: 1, ef: cursedHorfEvent, rf: cursedHorfReward},
-		monsterCard{baseCard: baseCard{name: "Cursed Keeper Head", id: cursedKeeperHead}, baseHealth: 2, baseRoll: 4, baseAttack: 1, ef: cursedKeeperHeadEvent, rf: cursedKeeperHeadReward},
-		monsterCard{baseCard: baseCard{name: "Cursed Mom's Hand", id: cursedMomsHand}, baseHealth: 2, baseRoll: 4, baseAttack: 1, ef: cursedMomsHandEvent, rf: cursedMomsHandReward},
-		monsterCard{baseCard: baseCard{name: "Cursed Psy Horf", id: cursedPsyHorf}, baseHealth: 1, baseRoll: 5, baseAttack: 1, ef: cursedPsyHorfEvent, rf: cursedPsyHorfReward},
-		monsterCard{baseCard: baseCard{name: "Holy Dinga", id: holyDinga}, baseHealth: 3, baseRoll: 3, baseAttack: 1, ef: holyDingaEvent, rf: holyDingaReward},
-		monsterCard{baseCard: baseCard{name: "Holy Dip", id: holyDip}, baseHealth: 1, baseRoll: 4, baseAttack: 1, ef: holyDipEvent, rf: holyDipReward},
-		monsterCard{baseCard: baseCard{name: "Holy Keeper Head", id: holyKeeperHead}, baseHealth: 2, baseRoll: 4, baseAttack: 1, ef: holyKeeperHeadEvent, rf: holyKeeperHeadReward},
-		monsterCard{baseCard: baseCard{name: "Holy Mom's Eye", id: holyMomsEye}, baseHealth: 1, baseRoll: 4, baseAttack: 2, ef: holyMomsEyeEvent, rf: holyMomsEyeReward},
-		monsterCard{baseCard: baseCard{name: "Holy Squirt", id: holySquirt}, baseHealth: 2, baseRoll: 3, baseAttack: 1, ef: holySquirtEvent, rf: holySquirtReward},
-		monsterCard{baseCard: baseCard{name: "Carrion Queen", id: carrionQueen}, baseHealth: 3, baseRoll: 4, baseAttack: 1, isBoss: true, rf: carrionQueenReward},
-		monsterCard{baseCard: baseCard{name: "Chub", id: chub}, baseHealth: 4, baseRoll: 3, baseAttack: 1, isBoss: true, ef: chubEvent, rf: chubReward},
-		monsterCard{baseCard: baseCard{name: "Conquest", id: conquest}, baseHealth: 2, baseRoll: 3, baseAttack: 1, isBoss: true, f: conquestDeath, rf: conquestReward},
-		monsterCard{baseCard: baseCard{name: "Daddy Long Legs", id: daddyLongLegsMonster}, baseHealth: 4, baseRoll: 4, baseAttack: 1, isBoss: true, ef: daddyLongLegsEvent, rf: daddyLongLegsReward},
-		monsterCard{baseCard: baseCard{name: "Dark One", id: darkOne}, baseHealth: 3, baseRoll: 4, baseAttack: 1, isBoss: true, ef: darkOneEvent, rf: darkOneReward},
-		monsterCard{baseCard: baseCard{name: "Death", id: deathMonster}, baseHealth: 3, baseRoll: 4, baseAttack: 2, isBoss: true, f: deathMonsterDeath, rf: deathMonsterReward},
-		monsterCard{baseCard: baseCard{name: "Delirium", id: delirium}, baseHealth: 5, baseRoll: 4, baseAttack: 3, ef: deliriumEvent, rf: deliriumReward},
-		monsterCard{baseCard: baseCard{name: "Envy", id: envy}, baseHealth: 2, baseRoll: 5, baseAttack: 1, isBoss: true, f: envyDeath, rf: envyReward},
-		monsterCard{baseCard: baseCard{name: "Famine", id: famine}, baseHealth: 2, baseRoll: 3, baseAttack: 1, isBoss: true, f: famineDeath, rf: famineReward},
-		monsterCard{baseCard: baseCard{name: "Gemini", id: gemini}, baseHealth: 3, baseRoll: 4, baseAttack: 1, isBoss: true, ef: geminiEvent, rf: geminiReward},
-		monsterCard{baseCard: baseCard{name: "Gluttony", id: gluttony}, baseHealth: 4, baseRoll: 3, baseAttack: 1, isBoss: true, ef: gluttonyEvent, rf: gluttonyReward},
-		monsterCard{baseCard: baseCard{name: "Greed", id: greedMonster}, baseHealth: 3, baseRoll: 4, baseAttack: 1, isBoss: true, ef: greedMonsterEvent, rf: greedMonsterReward},
-		monsterCard{baseCard: baseCard{name: "Gurdy JR.", id: gurdyJr}, baseHealth: 2, baseRoll: 5, baseAttack: 1, isBoss: true, ef: gurdyJrEvent, rf: gurdyJrReward},
-		monsterCard{baseCard: baseCard{name: "Gurdy", id: gurdy}, baseHealth: 5, baseRoll: 4, baseAttack: 1, isBoss: true, rf: gurdyReward},
-		monsterCard{baseCard: baseCard{name: "Larry JR.", id: larryJr}, baseHealth: 4, baseRoll: 3, baseAttack: 1, isBoss: true, ef: larryJrEvent, rf: larryJrReward},
-		monsterCard{baseCard: baseCard{name: "Little Horn", id: littleHorn}, baseHealth: 2, baseRoll: 6, baseAttack: 1, isBoss: true, rf: littleHornReward},
-		monsterCard{baseCard: baseCard{name: "Lust", id: lust}, baseHealth: 2, baseRoll: 4, baseAttack: 1, isBoss: true, ef: lustEvent, rf: lustReward},
-		monsterCard{baseCard: baseCard{name: "Mask of Infamy", id: maskOfInfamy}, baseHealth: 4, baseRoll: 4, baseAttack: 1, isBoss: true, ef: maskOfInfamyEvent, rf: maskOfInfamyReward},
-		monsterCard{baseCard: baseCard{name: "Mega Fatty", id: megaFatty}, baseHealth: 3, baseRoll: 3, baseAttack: 1, isBoss: true, ef: megaFattyEvent, rf: megaFattyReward},
-		monsterCard{baseCard: baseCard{name: "Monstro", id: monstro}, baseHealth: 4, baseRoll: 4, baseAttack: 1, isBoss: true, rf: monstroReward},
-		monsterCard{baseCard: baseCard{name: "Peep", id: peep}, baseHealth: 3, baseRoll: 4, baseAttack: 1, isBoss: true, f: thePeepDeath, rf: thePeepReward},
-		monsterCard{baseCard: baseCard{name: "Pestilence", id: pestilence}, baseHealth: 4, baseRoll: 4, baseAttack: 1, isBoss: true, f: pestilenceDeath, rf: pestilenceReward},
-		monsterCard{baseCard: baseCard{name: "Pin", id: pin}, baseHealth: 2, baseRoll: 2, baseAttack: 1, isBoss: true, rf: pinReward},
-		monsterCard{baseCard: baseCard{name: "Pride", id: pride}, baseHealth: 2, baseRoll: 4, baseAttack: 1, isBoss: true, ef: prideEvent, rf: prideReward},
-		monsterCard{baseCard: baseCard{name: "Ragman", id: ragman}, baseHealth: 2, baseRoll: 3, baseAttack: 2, isBoss: true, f: ragmanDeath, rf: ragmanReward},
-		monsterCard{baseCard: baseCard{name: "Scolex", id: scolex}, baseHealth: 3, baseRoll: 5, baseAttack: 1, isBoss: true, ef: scolexEvent, rf: scolexReward},
-		monsterCard{baseCard: baseCard{name: "Sloth", id: sloth}, baseHealth: 3, baseRoll: 4, baseAttack: 1, isBoss: true, f: slothDeath, rf: slothReward},
-		monsterCard{baseCard: baseCard{name: "The Bloat", id: theBloat}, baseHealth: 4, baseRoll: 4, baseAttack: 2, isBoss: true, ef: theBloatEvent, rf: theBloatReward},
-		monsterCard{baseCard: baseCard{name: "The Duke Of Flies", id: theDukeOfFlies}, baseHealth: 4, baseRoll: 3, baseAttack: 1, isBoss: true, rf: theDukeOfFliesReward},
-		monsterCard{baseCard: baseCard{name: "The Haunt", id: theHaunt}, baseHealth: 3, baseRoll: 4, baseAttack: 1, isBoss: true, ef: theHauntEvent, rf: theHauntReward},
-		monsterCard{baseCard: baseCard{name: "War", id: war}, baseHealth: 3, baseRoll: 3, baseAttack: 1, isBoss: true, ef: warEvent, rf: warReward},
-		monsterCard{baseCard: baseCard{name: "Wrath", id: wrath}, baseHealth: 3, baseRoll: 3, baseAttack: 1, isBoss: true, f: wrathDeath, rf: wrathReward},
-		monsterCard{baseCard: baseCard{name: "Mom!", id: mom}, baseHealth: 5, baseRoll: 4, baseAttack: 2, isBoss: true, f: momDeath, rf: momReward},
-		monsterCard{baseCard: baseCard{name: "Satan!", id: satan}, baseHealth: 6, baseRoll: 4, baseAttack: 2, isBoss: true, ef: satanEvent, rf: satanReward},
-		monsterCard{baseCard: baseCard{name: "The Lamb", id: theLamb}, baseHealth: 6, baseRoll: 3, baseAttack: 6, f: theLambDeath, rf: theLambReward},
-		monsterCard{baseCard: baseCard{name: "Ambush!", id: ambush}, f: ambushFunc},
-		monsterCard{baseCard: baseCard{name: "Chest", id: chest}, f: chestFunc},
-		monsterCard{baseCard: baseCard{name: "Chest", id: chest}, f: chestFunc},
-		monsterCard{baseCard: baseCard{name: "Cursed Chest", id: cursedChest}, f: cursedChestFunc},
-		monsterCard{baseCard: baseCard{name: "Dark Chest", id: darkChest}, f: darkChestFunc},
-		monsterCard{baseCard: baseCard{name: "Dark Chest", id: darkChest}, f: darkChestFunc},
-		monsterCard{baseCard: baseCard{name: "Devil Deal", id: devilDeal}, f: devilDealFunc},
-		monsterCard{baseCard: baseCard{name: "Gold Chest", id: goldChest}, f: goldChestFunc},
-		monsterCard{baseCard: baseCard{name: "Greed!", id: greedHappening}, f: greedBonusFunc},
-		monsterCard{baseCard: baseCard{name: "I Can See Forever!", id: iCanSeeForever}, f: iCanSeeForeverFunc},
-		monsterCard{baseCard: baseCard{name: "Troll Bombs", id: trollBombs}, f: trollBombsFunc},
-		monsterCard{baseCard: baseCard{name: "Mega Troll Bomb!", id: megaTrollBomb}, f: megaTrollBombFunc},
-		monsterCard{baseCard: baseCard{name: "Secret Room!", id: secretRoom}, f: secretRoomFunc},
-		monsterCard{baseCard: baseCard{name: "Shop Upgrade!", id: shopUpgrade}, f: shopUpgradeFunc},
-		monsterCard{baseCard: baseCard{name: "We Need To Go Deeper!", id: weNeedToGoDeeper}, f: weNeedToGoDeeperFunc},
-		monsterCard{baseCard: baseCard{name: "XL Floor!", id: xlFloor}, f: xlFloorFunc},
-		monsterCard{baseCard: baseCard{name: "Curse of Amnesia", id: curseOfAmnesia}, f: giveCurseHelper, ef: curseOfAmnesiaEvent},
-		monsterCard{baseCard: baseCard{name: "Curse of Greed", id: curseOfGreed}, f: giveCurseHelper, ef: curseOfGreedEvent},
-		monsterCard{baseCard: baseCard{name: "Curse of Loss", id: curseOfLoss}, f: giveCurseHelper},
-		monsterCard{baseCard: baseCard{name: "Curse of Pain", id: curseOfPain}, f: giveCurseHelper, ef: curseOfPainEvent},
-		monsterCard{baseCard: baseCard{name: "Curse of the Blind", id: curseOfTheBlind}, f: giveCurseHelper, ef: curseOfTheBlindEvent},
+		monsterCard{baseCard: baseCard{name: "Big Spider", effect: bigSpiderDesc, id: bigSpider}, baseHealth: 3, baseRoll: 4, baseAttack: 1, f: bigSpiderDeath, rf: bigSpiderReward},
+		monsterCard{baseCard: baseCard{name: "Black Bony", effect: blackBonyDesc, id: blackBony}, baseHealth: 3, baseRoll: 4, baseAttack: 1, f: blackBonyDeath, rf: blackBonyReward},
+		monsterCard{baseCard: baseCard{name: "Boom Fly", effect: boomFlyDesc, id: boomFly}, baseHealth: 1, baseRoll: 4, baseAttack: 1, f: boomFlyDeath, rf: boomFlyReward},
+		monsterCard{baseCard: baseCard{name: "Clotty", effect: clottyDesc, id: clotty}, baseHealth: 2, baseRoll: 3, baseAttack: 1, rf: clottyReward},
+		monsterCard{baseCard: baseCard{name: "Cod Worm", effect: codWormDesc, id: codWorm}, baseHealth: 1, baseRoll: 5, rf: codWormReward},
+		monsterCard{baseCard: baseCard{name: "Conjoined Fatty", effect: conjoinedFattyDesc, id: conjoinedFatty}, baseHealth: 4, baseRoll: 3, baseAttack: 2, rf: conjoinedFattyReward},
+		monsterCard{baseCard: baseCard{name: "Dank Globin", effect: dankGlobinDesc, id: dankGlobin}, baseHealth: 2, baseRoll: 4, baseAttack: 2, f: dankGlobinDeath, rf: dankGlobinReward},
+		monsterCard{baseCard: baseCard{name: "Dinga", effect: dingaDesc, id: dinga}, baseHealth: 3, baseRoll: 3, baseAttack: 1, rf: dingaReward},
+		monsterCard{baseCard: baseCard{name: "Dip", effect: dipDesc, id: dip}, baseHealth: 1, baseRoll: 4, baseAttack: 1, rf: dipReward},
+		monsterCard{baseCard: baseCard{name: "Dople", effect: dopleDesc, id: dople}, baseHealth: 2, baseRoll: 4, baseAttack: 2, ef: dopleEvent, rf: dopleReward},
+		monsterCard{baseCard: baseCard{name: "Evil Twin", effect: evilTwinDesc, id: evilTwin}, baseHealth: 3, baseRoll: 5, baseAttack: 2, ef: evilTwinEvent, rf: evilTwinReward},
+		monsterCard{baseCard: baseCard{name: "Fat Bat", effect: fatBatDesc, id: fatBat}, baseHealth: 3, baseRoll: 5, baseAttack: 1, rf: fatBatReward},
+		monsterCard{baseCard: baseCard{name: "Fatty", effect: fattyDesc, id: fatty}, baseHealth: 4, baseRoll: 2, baseAttack: 1, rf: fattyReward},
+		monsterCard{baseCard: baseCard{name: "Fly", effect: flyDesc, id: fly}, baseHealth: 1, baseRoll: 2, baseAttack: 1, rf: flyReward},
+		monsterCard{baseCard: baseCard{name: "Greedling", effect: greedlingDesc, id: greedling}, baseHealth: 2, baseRoll: 5, baseAttack: 1, f: greedlingDeath, rf: greedlingReward},
+		monsterCard{baseCard: baseCard{name: "Hanger", effect: hangerDesc, id: hanger}, baseHealth: 2, baseRoll: 4, baseAttack: 2, f: hangerDeath, rf: hangerReward},
+		monsterCard{baseCard: baseCard{name: "Hopper", effect: hopperDesc, id: hopper}, baseHealth: 2, baseRoll: 3, baseAttack: 1, ef: hopperEvent, rf: hopperReward},
+		monsterCard{baseCard: baseCard{name: "Horf", effect: horfDesc, id: horf}, baseHealth: 1, baseRoll: 4, baseAttack: 1, rf: horfReward},
+		monsterCard{baseCard: baseCard{name: "Keeper Head", effect: keeperHeadDesc, id: keeperHead}, baseHealth: 2, baseRoll: 4, baseAttack: 1, ef: keeperHeadEvent, rf: keeperReward},
+		monsterCard{baseCard: baseCard{name: "Leaper", effect: leaperDesc, id: leaper}, baseHealth: 2, baseRoll: 4, baseAttack: 1, rf: leaperReward},
+		monsterCard{baseCard: baseCard{name: "Leech", effect: leechDesc, id: leech}, baseHealth: 1, baseRoll: 4, baseAttack: 2, rf: leechReward},
+		monsterCard{baseCard: baseCard{name: "Mom's Dead Hand", effect: momsDeadHandDesc, id: momsDeadHand}, baseHealth: 2, baseRoll: 5, baseAttack: 1, f: momsDeadHandDeath, rf: momsDeadHandReward},
+		monsterCard{baseCard: baseCard{name: "Mom's Eye", effect: momsEyeDesc, id: momsEye}, baseHealth: 1, baseRoll: 4, baseAttack: 2, f: momsEyeDeath, rf: momsEyeReward},
+		monsterCard{baseCard: baseCard{name: "Mom's Hand", effect: momsHandDesc, id: momsHand}, baseHealth: 2, baseRoll: 4, baseAttack: 1, ef: momsHandEvent, rf: momsHandReward},
+		monsterCard{baseCard: baseCard{name: "Mulliboom", effect: mulliboomDesc, id: mulliboom}, baseHealth: 1, baseRoll: 2, baseAttack: 4, f: mulliboomDeath, rf: mulliboomReward},
+		monsterCard{baseCard: baseCard{name: "Mulligan", effect: mulliganDesc, id: mulligan}, baseHealth: 1, baseRoll: 3, baseAttack: 1, f: mulliganDeath, rf: mulliganReward},
+		monsterCard{baseCard: baseCard{name: "Pale Fatty", effect: paleFattyDesc, id: paleFatty}, baseHealth: 4, baseRoll: 3, baseAttack: 1, rf: paleFattyReward},
+		monsterCard{baseCard: baseCard{name: "Pooter", effect: pooterDesc, id: pooter}, baseHealth: 2, baseRoll: 3, baseAttack: 1, rf: pooterReward},
+		monsterCard{baseCard: baseCard{name: "Portal", effect: portalDesc, id: portal}, baseHealth: 2, baseRoll: 4, baseAttack: 1, f: portalDeath, rf: portalReward},
+		monsterCard{baseCard: baseCard{name: "Psy Horf", effect: psyHorfDesc, id: psyHorf}, baseHealth: 1, baseRoll: 5, baseAttack: 1, f: psyHorfDeath, rf: psyHorfReward},
+		monsterCard{baseCard: baseCard{name: "Rage Creep", effect: rageCreepDesc, id: rageCreep}, baseHealth: 1, baseRoll: 5, baseAttack: 1, ef: rageCreepEvent, rf: rageCreepReward},
+		monsterCard{baseCard: baseCard{name: "Red Host", effect: redHostDesc, id: redHost}, baseHealth: 2, baseRoll: 3, baseAttack: 2, rf: redHostReward},
+		monsterCard{baseCard: baseCard{name: "Ring of Flies", effect: ringOfFliesDesc, id: ringOfFlies}, baseHealth: 3, baseRoll: 3, baseAttack: 1, ef: ringOfFliesEvent, rf: ringOfFliesReward},
+		monsterCard{baseCard: baseCard{name: "Spider", effect: spiderDesc, id: spider}, baseHealth: 1, baseRoll: 4, baseAttack: 1, rf: spiderReward},
+		monsterCard{baseCard: baseCard{name: "Squirt", effect: squirtDesc, id: squirt}, baseHealth: 2, baseRoll: 3, baseAttack: 1, rf: squirtReward},
+		monsterCard{baseCard: baseCard{name: "Stoney", effect: stoneyDesc, id: stoney}, baseHealth: 3, ef: stoneyEvent, rf: stoneyReward},
+		monsterCard{baseCard: baseCard{name: "Swarm of Flies", effect: swarmOfFliesDesc, id: swarmOfFlies}, baseHealth: 5, baseRoll: 2, baseAttack: 1, ef: swarmOfFliesEvent, rf: swarmOfFliesReward},
+		monsterCard{baseCard: baseCard{name: "Trite", effect: triteDesc, id: trite}, baseHealth: 1, baseRoll: 5, baseAttack: 1, rf: triteReward},
+		monsterCard{baseCard: baseCard{name: "Wizoob", effect: wizoobDesc, id: wizoob}, baseHealth: 3, baseRoll: 5, baseAttack: 1, f: wizoobDeath, rf: wizoobReward},
+		monsterCard{baseCard: baseCard{name: "Cursed Fatty", effect: cursedFattyDesc, id: cursedFatty}, baseHealth: 4, baseRoll: 2, baseAttack: 1, ef: cursedFattyEvent, rf: cursedFattyReward},
+		monsterCard{baseCard: baseCard{name: "Cursed Gaper", effect: cursedGaperDesc, id: cursedGaper}, baseHealth: 2, baseRoll: 4, baseAttack: 1, ef: cursedGaperEvent, rf: cursedGaperReward},
+		monsterCard{baseCard: baseCard{name: "Cursed Horf", effect: cursedHorfDesc, id: cursedHorf}, baseHealth: 1, baseRoll: 4, baseAttack: 1, ef: cursedHorfEvent, rf: cursedHorfReward},
+		monsterCard{baseCard: baseCard{name: "Cursed Keeper Head", effect: cursedKeeperHeadDesc, id: cursedKeeperHead}, baseHealth: 2, baseRoll: 4, baseAttack: 1, ef: cursedKeeperHeadEvent, rf: cursedKeeperHeadReward},
+		monsterCard{baseCard: baseCard{name: "Cursed Mom's Hand", effect: cursedMomsHandDesc, id: cursedMomsHand}, baseHealth: 2, baseRoll: 4, baseAttack: 1, ef: cursedMomsHandEvent, rf: cursedMomsHandReward},
+		monsterCard{baseCard: baseCard{name: "Cursed Psy Horf", effect: cursedPsyHorfDesc, id: cursedPsyHorf}, baseHealth: 1, baseRoll: 5, baseAttack: 1, ef: cursedPsyHorfEvent, rf: cursedPsyHorfReward},
+		monsterCard{baseCard: baseCard{name: "Holy Dinga", effect: holyDingaDesc, id: holyDinga}, baseHealth: 3, baseRoll: 3, baseAttack: 1, ef: holyDingaEvent, rf: holyDingaReward},
+		monsterCard{baseCard: baseCard{name: "Holy Dip", effect: holyDipDesc, id: holyDip}, baseHealth: 1, baseRoll: 4, baseAttack: 1, ef: holyDipEvent, rf: holyDipReward},
+		monsterCard{baseCard: baseCard{name: "Holy Keeper Head", effect: holyKeeperHeadDesc, id: holyKeeperHead}, baseHealth: 2, baseRoll: 4, baseAttack: 1, ef: holyKeeperHeadEvent, rf: holyKeeperHeadReward},
+		monsterCard{baseCard: baseCard{name: "Holy Mom's Eye", effect: holyMomsEyeDesc, id: holyMomsEye}, baseHealth: 1, baseRoll: 4, baseAttack: 2, ef: holyMomsEyeEvent, rf: holyMomsEyeReward},
+		monsterCard{baseCard: baseCard{name: "Holy Squirt", effect: holySquirtDesc, id: holySquirt}, baseHealth: 2, baseRoll: 3, baseAttack: 1, ef: holySquirtEvent, rf: holySquirtReward},
+		monsterCard{baseCard: baseCard{name: "Carrion Queen", effect: carrionQueenDesc, id: carrionQueen}, baseHealth: 3, baseRoll: 4, baseAttack: 1, isBoss: true, rf: carrionQueenReward},
+		monsterCard{baseCard: baseCard{name: "Chub", effect: chubDesc, id: chub}, baseHealth: 4, baseRoll: 3, baseAttack: 1, isBoss: true, ef: chubEvent, rf: chubReward},
+		monsterCard{baseCard: baseCard{name: "Conquest", effect: conquestDesc, id: conquest}, baseHealth: 2, baseRoll: 3, baseAttack: 1, isBoss: true, f: conquestDeath, rf: conquestReward},
+		monsterCard{baseCard: baseCard{name: "Daddy Long Legs", effect: daddyLongLegsMonsterDesc, id: daddyLongLegsMonster}, baseHealth: 4, baseRoll: 4, baseAttack: 1, isBoss: true, ef: daddyLongLegsEvent, rf: daddyLongLegsReward},
+		monsterCard{baseCard: baseCard{name: "Dark One", effect: darkOneDesc, id: darkOne}, baseHealth: 3, baseRoll: 4, baseAttack: 1, isBoss: true, ef: darkOneEvent, rf: darkOneReward},
+		monsterCard{baseCard: baseCard{name: "Death", effect: deathMonsterDesc, id: deathMonster}, baseHealth: 3, baseRoll: 4, baseAttack: 2, isBoss: true, f: deathMonsterDeath, rf: deathMonsterReward},
+		monsterCard{baseCard: baseCard{name: "Delirium", effect: deliriumDesc, id: delirium}, baseHealth: 5, baseRoll: 4, baseAttack: 3, ef: deliriumEvent, rf: deliriumReward},
+		monsterCard{baseCard: baseCard{name: "Envy", effect: envyDesc, id: envy}, baseHealth: 2, baseRoll: 5, baseAttack: 1, isBoss: true, f: envyDeath, rf: envyReward},
+		monsterCard{baseCard: baseCard{name: "Famine", effect: famineDesc, id: famine}, baseHealth: 2, baseRoll: 3, baseAttack: 1, isBoss: true, f: famineDeath, rf: famineReward},
+		monsterCard{baseCard: baseCard{name: "Gemini", effect: geminiDesc, id: gemini}, baseHealth: 3, baseRoll: 4, baseAttack: 1, isBoss: true, ef: geminiEvent, rf: geminiReward},
+		monsterCard{baseCard: baseCard{name: "Gluttony", effect: gluttonyDesc, id: gluttony}, baseHealth: 4, baseRoll: 3, baseAttack: 1, isBoss: true, ef: gluttonyEvent, rf: gluttonyReward},
+		monsterCard{baseCard: baseCard{name: "Greed", effect: greedMonsterDesc, id: greedMonster}, baseHealth: 3, baseRoll: 4, baseAttack: 1, isBoss: true, ef: greedMonsterEvent, rf: greedMonsterReward},
+		monsterCard{baseCard: baseCard{name: "Gurdy JR.", effect: gurdyJrDesc, id: gurdyJr}, baseHealth: 2, baseRoll: 5, baseAttack: 1, isBoss: true, ef: gurdyJrEvent, rf: gurdyJrReward},
+		monsterCard{baseCard: baseCard{name: "Gurdy", effect: gurdyDesc, id: gurdy}, baseHealth: 5, baseRoll: 4, baseAttack: 1, isBoss: true, rf: gurdyReward},
+		monsterCard{baseCard: baseCard{name: "Larry JR.", effect: larryJrDesc, id: larryJr}, baseHealth: 4, baseRoll: 3, baseAttack: 1, isBoss: true, ef: larryJrEvent, rf: larryJrReward},
+		monsterCard{baseCard: baseCard{name: "Little Horn", effect: littleHornDesc, id: littleHorn}, baseHealth: 2, baseRoll: 6, baseAttack: 1, isBoss: true, rf: littleHornReward},
+		monsterCard{baseCard: baseCard{name: "Lust", effect: lustDesc, id: lust}, baseHealth: 2, baseRoll: 4, baseAttack: 1, isBoss: true, ef: lustEvent, rf: lustReward},
+		monsterCard{baseCard: baseCard{name: "Mask of Infamy", effect: maskOfInfamyDesc, id: maskOfInfamy}, baseHealth: 4, baseRoll: 4, baseAttack: 1, isBoss: true, ef: maskOfInfamyEvent, rf: maskOfInfamyReward},
+		monsterCard{baseCard: baseCard{name: "Mega Fatty", effect: megaFattyDesc, id: megaFatty}, baseHealth: 3, baseRoll: 3, baseAttack: 1, isBoss: true, ef: megaFattyEvent, rf: megaFattyReward},
+		monsterCard{baseCard: baseCard{name: "Monstro", effect: monstroDesc, id: monstro}, baseHealth: 4, baseRoll: 4, baseAttack: 1, isBoss: true, rf: monstroReward},
+		monsterCard{baseCard: baseCard{name: "Peep", effect: peepDesc, id: peep}, baseHealth: 3, baseRoll: 4, baseAttack: 1, isBoss: true, f: thePeepDeath, rf: thePeepReward},
+		monsterCard{baseCard: baseCard{name: "Pestilence", effect: pestilenceDesc, id: pestilence}, baseHealth: 4, baseRoll: 4, baseAttack: 1, isBoss: true, f: pestilenceDeath, rf: pestilenceReward},
+		monsterCard{baseCard: baseCard{name: "Pin", effect: pinDesc, id: pin}, baseHealth: 2, baseRoll: 2, baseAttack: 1, isBoss: true, rf: pinReward},
+		monsterCard{baseCard: baseCard{name: "Pride", effect: prideDesc, id: pride}, baseHealth: 2, baseRoll: 4, baseAttack: 1, isBoss: true, ef: prideEvent, rf: prideReward},
+		monsterCard{baseCard: baseCard{name: "Ragman", effect: ragmanDesc, id: ragman}, baseHealth: 2, baseRoll: 3, baseAttack: 2, isBoss: true, f: ragmanDeath, rf: ragmanReward},
+		monsterCard{baseCard: baseCard{name: "Scolex", effect: scolexDesc, id: scolex}, baseHealth: 3, baseRoll: 5, baseAttack: 1, isBoss: true, ef: scolexEvent, rf: scolexReward},
+		monsterCard{baseCard: baseCard{name: "Sloth", effect: slothDesc, id: sloth}, baseHealth: 3, baseRoll: 4, baseAttack: 1, isBoss: true, f: slothDeath, rf: slothReward},
+		monsterCard{baseCard: baseCard{name: "The Bloat", effect: theBloatDesc, id: theBloat}, baseHealth: 4, baseRoll: 4, baseAttack: 2, isBoss: true, ef: theBloatEvent, rf: theBloatReward},
+		monsterCard{baseCard: baseCard{name: "The Duke Of Flies", effect: theDukeOfFliesDesc, id: theDukeOfFlies}, baseHealth: 4, baseRoll: 3, baseAttack: 1, isBoss: true, rf: theDukeOfFliesReward},
+		monsterCard{baseCard: baseCard{name: "The Haunt", effect: theHauntDesc, id: theHaunt}, baseHealth: 3, baseRoll: 4, baseAttack: 1, isBoss: true, ef: theHauntEvent, rf: theHauntReward},
+		monsterCard{baseCard: baseCard{name: "War", effect: warDesc, id: war}, baseHealth: 3, baseRoll: 3, baseAttack: 1, isBoss: true, ef: warEvent, rf: warReward},
+		monsterCard{baseCard: baseCard{name: "Wrath", effect: wrathDesc, id: wrath}, baseHealth: 3, baseRoll: 3, baseAttack: 1, isBoss: true, f: wrathDeath, rf: wrathReward},
+		monsterCard{baseCard: baseCard{name: "Mom!", effect: momDesc, id: mom}, baseHealth: 5, baseRoll: 4, baseAttack: 2, isBoss: true, f: momDeath, rf: momReward},
+		monsterCard{baseCard: baseCard{name: "Satan!", effect: satanDesc, id: satan}, baseHealth: 6, baseRoll: 4, baseAttack: 2, isBoss: true, ef: satanEvent, rf: satanReward},
+		monsterCard{baseCard: baseCard{name: "The Lamb", effect: theLambDesc, id: theLamb}, baseHealth: 6, baseRoll: 3, baseAttack: 6, f: theLambDeath, rf: theLambReward},
+		monsterCard{baseCard: baseCard{name: "Ambush!", effect: ambushDesc, id: ambush}, f: ambushFunc},
+		monsterCard{baseCard: baseCard{name: "Chest", effect: chestDesc, id: chest}, f: chestFunc},
+		monsterCard{baseCard: baseCard{name: "Chest", effect: chestDesc, id: chest}, f: chestFunc},
+		monsterCard{baseCard: baseCard{name: "Cursed Chest", effect: cursedChestDesc, id: cursedChest}, f: cursedChestFunc},
+		monsterCard{baseCard: baseCard{name: "Dark Chest", effect: darkChestDesc, id: darkChest}, f: darkChestFunc},
+		monsterCard{baseCard: baseCard{name: "Dark Chest", effect: darkChestDesc, id: darkChest}, f: darkChestFunc},
+		monsterCard{baseCard: baseCard{name: "Devil Deal", effect: devilDealDesc, id: devilDeal}, f: devilDealFunc},
+		monsterCard{baseCard: baseCard{name: "Gold Chest", effect: goldChestDesc, id: goldChest}, f: goldChestFunc},
+		monsterCard{baseCard: baseCard{name: "Greed!", effect: greedHappeningDesc, id: greedHappening}, f: greedBonusFunc},
+		monsterCard{baseCard: baseCard{name: "I Can See Forever!", effect: iCanSeeForeverDesc, id: iCanSeeForever}, f: iCanSeeForeverFunc},
+		monsterCard{baseCard: baseCard{name: "Troll Bombs", effect: trollBombsDesc, id: trollBombs}, f: trollBombsFunc},
+		monsterCard{baseCard: baseCard{name: "Mega Troll Bomb!", effect: megaTrollBombDesc, id: megaTrollBomb}, f: megaTrollBombFunc},
+		monsterCard{baseCard: baseCard{name: "Secret Room!", effect: secretRoomDesc, id: secretRoom}, f: secretRoomFunc},
+		monsterCard{baseCard: baseCard{name: "Shop Upgrade!", effect: shopUpgradeDesc, id: shopUpgrade}, f: shopUpgradeFunc},
+		monsterCard{baseCard: baseCard{name: "We Need To Go Deeper!", effect: weNeedToGoDeeperDesc, id: weNeedToGoDeeper}, f: weNeedToGoDeeperFunc},
+		monsterCard{baseCard: baseCard{name: "XL Floor!", effect: xlFloorDesc, id: xlFloor}, f: xlFloorFunc},
+		monsterCard{baseCard: baseCard{name: "Curse of Amnesia", effect: curseOfAmnesiaDesc, id: curseOfAmnesia}, isCurse: true, f: giveCurseHelper, ef: curseOfAmnesiaEvent},
+		monsterCard{baseCard: baseCard{name: "Curse of Greed", effect: curseOfGreedDesc, id: curseOfGreed}, isCurse: true, f: giveCurseHelper, ef: curseOfGreedEvent},
+		monsterCard{baseCard: baseCard{name: "Curse of Loss", effect: curseOfLossDesc, id: curseOfLoss}, isCurse: true, f: giveCurseHelper},
+		monsterCard{baseCard: baseCard{name: "Curse of Pain", effect: curseOfPainDesc, id: curseOfPain}, isCurse: true, f: giveCurseHelper, ef: curseOfPainEvent},
+		monsterCard{baseCard: baseCard{name: "Curse of the Blind", effect: curseOfTheBlindDesc, id: curseOfTheBlind}, isCurse: true, f: giveCurseHelper, ef: curseOfTheBlindEvent},
 	}...)
 	if useExpansionOne == true {
 		deck.append([]card{
-			monsterCard{baseCard: baseCard{name: "Begotten", id: begotten}, baseHealth: 3, baseRoll: 4, baseAttack: 1},
-			monsterCard{baseCard: baseCard{name: "Boil", id: boil}, baseHealth: 2, baseRoll: 4, baseAttack: 1},
-			monsterCard{baseCard: baseCard{name: "Charger", id: charger}, baseHealth: 1, baseRoll: 5, baseAttack: 1},
-			monsterCard{baseCard: baseCard{name: "Death's Head", id: deathsHead}, baseHealth: 2},
-			monsterCard{baseCard: baseCard{name: "Gaper", id: gaper}, baseHealth: 2, baseRoll: 4, baseAttack: 1},
-			monsterCard{baseCard: baseCard{name: "Imp", id: imp}, baseHealth: 3, baseRoll: 5, baseAttack: 1},
-			monsterCard{baseCard: baseCard{name: "Knight", id: knight}, baseHealth: 2, baseRoll: 6, baseAttack: 1},
-			monsterCard{baseCard: baseCard{name: "Parabite", id: parabite}, baseHealth: 2, baseRoll: 3, baseAttack: 1},
-			monsterCard{baseCard: baseCard{name: "Ragling", id: ragling}, baseHealth: 2, baseRoll: 3, baseAttack: 1},
-			monsterCard{baseCard: baseCard{name: "Round Worm", id: roundWorm}, baseHealth: 1, baseRoll: 5, baseAttack: 1},
-			monsterCard{baseCard: baseCard{name: "Fistula", id: fistula}, baseHealth: 4, baseRoll: 2, baseAttack: 1, isBoss: true},
-			monsterCard{baseCard: baseCard{name: "Gurglings", id: gurglings}, baseHealth: 4, baseRoll: 5, baseAttack: 1, isBoss: true},
-			monsterCard{baseCard: baseCard{name: "Polycephalus", id: polycephalus}, baseHealth: 3, baseRoll: 3, baseAttack: 1, isBoss: true},
-			monsterCard{baseCard: baseCard{name: "Steven", id: steven}, baseHealth: 4, baseRoll: 2, baseAttack: 1, isBoss: true},
-			monsterCard{baseCard: baseCard{name: "The Cage", id: theCage}, baseHealth: 8, baseRoll: 3, baseAttack: 1, isBoss: true},
-			monsterCard{baseCard: baseCard{name: "!HUSH!", id: hush}, baseHealth: 8, baseRoll: 3, baseAttack: 1, isBoss: true},
-			monsterCard{baseCard: baseCard{name: "I Am Error!", id: iAmError}},
-			monsterCard{baseCard: baseCard{name: "Trap Door!", id: trapDoor}},
-			monsterCard{baseCard: baseCard{name: "Curse of Fatigue", id: curseOfFatigue}},
-			monsterCard{baseCard: baseCard{name: "Curse of Tiny Hands", id: curseOfTinyHands}},
+			monsterCard{baseCard: baseCard{name: "Begotten", effect: begottenDesc, id: begotten}, baseHealth: 3, baseRoll: 4, baseAttack: 1},
+			monsterCard{baseCard: baseCard{name: "Boil", effect: boilDesc, id: boil}, baseHealth: 2, baseRoll: 4, baseAttack: 1},
+			monsterCard{baseCard: baseCard{name: "Charger", effect: chargerDesc, id: charger}, baseHealth: 1, baseRoll: 5, baseAttack: 1},
+			monsterCard{baseCard: baseCard{name: "Death's Head", effect: deathsHeadDesc, id: deathsHead}, baseHealth: 2, ef: deathsHeadEvent, rf: deathsHeadReward},
+			monsterCard{baseCard: baseCard{name: "Gaper", effect: gaperDesc, id: gaper}, baseHealth: 2, baseRoll: 4, baseAttack: 1},
+			monsterCard{baseCard: baseCard{name: "Imp", effect: impDesc, id: imp}, baseHealth: 3, baseRoll: 5, baseAttack: 1},
+			monsterCard{baseCard: baseCard{name: "Knight", effect: knightDesc, id: knight}, baseHealth: 2, baseRoll: 6, baseAttack: 1},
+			monsterCard{baseCard: baseCard{name: "Parabite", effect: parabiteDesc, id: parabite}, baseHealth: 2, baseRoll: 3, baseAttack: 1},
+			monsterCard{baseCard: baseCard{name: "Ragling", effect: raglingDesc, id: ragling}, baseHealth: 2, baseRoll: 3, baseAttack: 1},
+			monsterCard{baseCard: baseCard{name: "Round Worm", effect: roundWormDesc, id: roundWorm}, baseHealth: 1, baseRoll: 5, baseAttack: 1},
+			monsterCard{baseCard: baseCard{name: "Fistula", effect: fistulaDesc, id: fistula}, baseHealth: 4, baseRoll: 2, baseAttack: 1, isBoss: true},
+			monsterCard{baseCard: baseCard{name: "Gurglings", effect: gurglingsDesc, id: gurglings}, baseHealth: 4, baseRoll: 5, baseAttack: 1, isBoss: true},
+			monsterCard{baseCard: baseCard{name: "Polycephalus", effect: polycephalusDesc, id: polycephalus}, baseHealth: 3, baseRoll: 3, baseAttack: 1, isBoss: true},
+			monsterCard{baseCard: baseCard{name: "Steven", effect: stevenDesc, id: steven}, baseHealth: 4, baseRoll: 2, baseAttack: 1, isBoss: true},
+			monsterCard{baseCard: baseCard{name: "The Cage", effect: theCageDesc, id: theCage}, baseHealth: 8, baseRoll: 3, baseAttack: 1, isBoss: true},
+			monsterCard{baseCard: baseCard{name: "!HUSH!", effect: hushDesc, id: hush}, baseHealth: 8, baseRoll: 3, baseAttack: 1, isBoss: true},
+			monsterCard{baseCard: baseCard{name: "I Am Error!", effect: iAmErrorDesc, id: iAmError}},
+			monsterCard{baseCard: baseCard{name: "Trap Door!", effect: trapDoorDesc, id: trapDoor}},
+			monsterCard{baseCard: baseCard{name: "Curse of Fatigue", effect: curseOfFatigueDesc, id: curseOfFatigue}, isCurse: true, f: giveCurseHelper},
+			monsterCard{baseCard: baseCard{name: "Curse of Tiny Hands", effect: curseOfTinyHandsDesc, id: curseOfTinyHands}, isCurse: true, f: giveCurseHelper},
 		}...)
 	}
 	if useExpansionTwo == true {
 		deck.append([]card{
-			monsterCard{baseCard: baseCard{name: "Bony", id: bony}, baseHealth: 2, baseRoll: 3, baseAttack: 1},
-			monsterCard{baseCard: baseCard{name: "Brain", id: brain}, baseHealth: 2, baseRoll: 3, baseAttack: 1},
-			monsterCard{baseCard: baseCard{name: "Flaming Hopper", id: flaminHopper}, baseHealth: 1, baseRoll: 4, baseAttack: 2},
-			monsterCard{baseCard: baseCard{name: "Globin", id: globin}, baseHealth: 4, baseRoll: 4, baseAttack: 1},
-			monsterCard{baseCard: baseCard{name: "Nerve Ending", id: nerveEnding}, baseHealth: 4, baseRoll: 2, baseAttack: 1},
-			monsterCard{baseCard: baseCard{name: "Roundy", id: roundy}, baseHealth: 3, baseRoll: 4, baseAttack: 2},
-			monsterCard{baseCard: baseCard{name: "Sucker", id: sucker}, baseHealth: 1, baseRoll: 3, baseAttack: 1},
-			monsterCard{baseCard: baseCard{name: "Swarmer", id: swarmer}, baseHealth: 4, baseRoll: 3, baseAttack: 2},
-			monsterCard{baseCard: baseCard{name: "Tumor", id: tumor}, baseHealth: 3, baseRoll: 4, baseAttack: 1},
-			monsterCard{baseCard: baseCard{name: "Cursed Globin", id: cursedGlobin}, baseHealth: 3, baseRoll: 4, baseAttack: 1},
-			monsterCard{baseCard: baseCard{name: "Cursed Tumor", id: cursedTumor}, baseHealth: 3, baseRoll: 4, baseAttack: 1},
-			monsterCard{baseCard: baseCard{name: "Holy Bony", id: holyBony}, baseHealth: 1, baseRoll: 3, baseAttack: 1},
-			monsterCard{baseCard: baseCard{name: "Holy Mulligan", id: holyMulligan}, baseHealth: 1, baseRoll: 3, baseAttack: 1},
-			monsterCard{baseCard: baseCard{name: "Blastocyst", id: blastocyst}, baseHealth: 5, baseRoll: 4, baseAttack: 1, isBoss: true},
-			monsterCard{baseCard: baseCard{name: "Dingle", id: dingle}, baseHealth: 3, baseRoll: 3, baseAttack: 1, isBoss: true},
-			monsterCard{baseCard: baseCard{name: "Headless Horseman", id: headlessHorseman}, baseHealth: 5, baseRoll: 4, baseAttack: 1, isBoss: true},
-			monsterCard{baseCard: baseCard{name: "Krampus", id: krampus}, baseHealth: 4, baseRoll: 4, baseAttack: 2, isBoss: true},
-			monsterCard{baseCard: baseCard{name: "Monstro II", id: monstroII}, baseHealth: 5, baseRoll: 4, baseAttack: 1, isBoss: true},
-			monsterCard{baseCard: baseCard{name: "The Fallen", id: theFallen}, baseHealth: 4, baseRoll: 5, baseAttack: 2, isBoss: true},
-			monsterCard{baseCard: baseCard{name: "Widow", id: widow}, baseHealth: 3, baseRoll: 4, baseAttack: 1, isBoss: true},
-			monsterCard{baseCard: baseCard{name: "Isaac!", id: isaacMonster}, baseHealth: 7, baseRoll: 3, baseAttack: 1, isBoss: true},
-			monsterCard{baseCard: baseCard{name: "Mom's Heart!", id: momsHeart}, baseHealth: 8, baseRoll: 4, baseAttack: 2, isBoss: true},
-			monsterCard{baseCard: baseCard{name: "Angel Room", id: angelRoom}},
-			monsterCard{baseCard: baseCard{name: "Boss Rush!", id: bossRush}},
-			monsterCard{baseCard: baseCard{name: "Head Trauma", id: headTrauma}},
-			monsterCard{baseCard: baseCard{name: "Holy Chest", id: holyChest}},
-			monsterCard{baseCard: baseCard{name: "Spiked Chest", id: spikedChest}},
-			monsterCard{baseCard: baseCard{name: "Troll Bombs", id: trollBombs}},
-			monsterCard{baseCard: baseCard{name: "Curse of Blood Lust", id: curseOfBloodLust}},
-			monsterCard{baseCard: baseCard{name: "Curse of Impulse", id: curseOfImpulse}},
+			monsterCard{baseCard: baseCard{name: "Bony", effect: bonyDesc, id: bony}, baseHealth: 2, baseRoll: 3, baseAttack: 1},
+			monsterCard{baseCard: baseCard{name: "Brain", effect: brainDesc, id: brain}, baseHealth: 2, baseRoll: 3, baseAttack: 1},
+			monsterCard{baseCard: baseCard{name: "Flaming Hopper", effect: flaminHopperDesc, id: flaminHopper}, baseHealth: 1, baseRoll: 4, baseAttack: 2},
+			monsterCard{baseCard: baseCard{name: "Globin", effect: globinDesc, id: globin}, baseHealth: 4, baseRoll: 4, baseAttack: 1},
+			monsterCard{baseCard: baseCard{name: "Nerve Ending", effect: nerveEndingDesc, id: nerveEnding}, baseHealth: 4, baseRoll: 2, baseAttack: 1},
+			monsterCard{baseCard: baseCard{name: "Roundy", effect: roundyDesc, id: roundy}, baseHealth: 3, baseRoll: 4, baseAttack: 2},
+			monsterCard{baseCard: baseCard{name: "Sucker", effect: suckerDesc, id: sucker}, baseHealth: 1, baseRoll: 3, baseAttack: 1},
+			monsterCard{baseCard: baseCard{name: "Swarmer", effect: swarmerDesc, id: swarmer}, baseHealth: 4, baseRoll: 3, baseAttack: 2},
+			monsterCard{baseCard: baseCard{name: "Tumor", effect: tumorDesc, id: tumor}, baseHealth: 3, baseRoll: 4, baseAttack: 1},
+			monsterCard{baseCard: baseCard{name: "Cursed Globin", effect: cursedGlobinDesc, id: cursedGlobin}, baseHealth: 3, baseRoll: 4, baseAttack: 1},
+			monsterCard{baseCard: baseCard{name: "Cursed Tumor", effect: cursedTumorDesc, id: cursedTumor}, baseHealth: 3, baseRoll: 4, baseAttack: 1},
+			monsterCard{baseCard: baseCard{name: "Holy Bony", effect: holyBonyDesc, id: holyBony}, baseHealth: 1, baseRoll: 3, baseAttack: 1},
+			monsterCard{baseCard: baseCard{name: "Holy Mulligan", effect: holyMulliganDesc, id: holyMulligan}, baseHealth: 1, baseRoll: 3, baseAttack: 1},
+			monsterCard{baseCard: baseCard{name: "Blastocyst", effect: blastocystDesc, id: blastocyst}, baseHealth: 5, baseRoll: 4, baseAttack: 1, isBoss: true},
+			monsterCard{baseCard: baseCard{name: "Dingle", effect: dingleDesc, id: dingle}, baseHealth: 3, baseRoll: 3, baseAttack: 1, isBoss: true},
+			monsterCard{baseCard: baseCard{name: "Headless Horseman", effect: headlessHorsemanDesc, id: headlessHorseman}, baseHealth: 5, baseRoll: 4, baseAttack: 1, isBoss: true},
+			monsterCard{baseCard: baseCard{name: "Krampus", effect: krampusDesc, id: krampus}, baseHealth: 4, baseRoll: 4, baseAttack: 2, isBoss: true},
+			monsterCard{baseCard: baseCard{name: "Monstro II", effect: monstroIIDesc, id: monstroII}, baseHealth: 5, baseRoll: 4, baseAttack: 1, isBoss: true},
+			monsterCard{baseCard: baseCard{name: "The Fallen", effect: theFallenDesc, id: theFallen}, baseHealth: 4, baseRoll: 5, baseAttack: 2, isBoss: true},
+			monsterCard{baseCard: baseCard{name: "Widow", effect: widowDesc, id: widow}, baseHealth: 3, baseRoll: 4, baseAttack: 1, isBoss: true},
+			monsterCard{baseCard: baseCard{name: "Isaac!", effect: isaacMonsterDesc, id: isaacMonster}, baseHealth: 7, baseRoll: 3, baseAttack: 1, isBoss: true},
+			monsterCard{baseCard: baseCard{name: "Mom's Heart!", effect: momsHeartDesc, id: momsHeart}, baseHealth: 8, baseRoll: 4, baseAttack: 2, isBoss: true},
+			monsterCard{baseCard: baseCard{name: "Angel Room", effect: angelRoomDesc, id: angelRoom}},
+			monsterCard{baseCard: baseCard{name: "Boss Rush!", effect: bossRushDesc, id: bossRush}},
+			monsterCard{baseCard: baseCard{name: "Head Trauma", effect: headTraumaDesc, id: headTrauma}},
+			monsterCard{baseCard: baseCard{name: "Holy Chest", effect: holyChestDesc, id: holyChest}},
+			monsterCard{baseCard: baseCard{name: "Spiked Chest", effect: spikedChestDesc, id: spikedChest}},
+			monsterCard{baseCard: baseCard{name: "Troll Bombs", effect: trollBombsDesc, id: trollBombs}},
+			monsterCard{baseCard: baseCard{name: "Curse of Blood Lust", effect: curseOfBloodLustDesc, id: curseOfBloodLust}, isCurse: true, f: giveCurseHelper, ef: curseOfBloodLustEvent},
+			monsterCard{baseCard: baseCard{name: "Curse of Impulse", effect: curseOfImpulseDesc, id: curseOfImpulse}, isCurse: true, f: giveCurseHelper, ef: curseOfImpulseEvent},
 		}...)
 	}
 	return deck
@@ -996,7 +1017,7 @@ func getStartingCards() map[string]treasureCard {
 	items["Samson"] = treasureCard{baseCard: baseCard{name: "Blood Lust", effect: bloodLustDesc, id: bloodLust}, eternal: true, active: true, f: bloodLustFunc}
 	items["The Forgotten"] = treasureCard{baseCard: baseCard{name: "The Bone", effect: theBoneDesc, id: theBone}, eternal: true, active: true, f: theBoneFunc}
 	items["Apollyon"] = treasureCard{baseCard: baseCard{name: "Void", effect: voidDesc, id: void}, eternal: true, active: true}
-	items["Azazel"] = treasureCard{baseCard: baseCard{name: "Lord of the Pit", effect: lordOfThePitDesc, id: lordOfThePit}, eternal: true, active: true}
+	items["Azazel"] = treasureCard{baseCard: baseCard{name: "Lord of the Pit", effect: lordOfThePitDesc, id: lordOfThePit}, eternal: true, active: true, f: lordOfThePitFunc}
 	items["The Keeper"] = treasureCard{baseCard: baseCard{name: "Wooden Nickel", effect: woodenNickelDesc, id: woodenNickel}, eternal: true, active: true}
 	items["The Lost"] = treasureCard{baseCard: baseCard{name: "Holy Mantle", effect: holyMantleDesc, id: theHolyMantle}, eternal: true, active: true}
 	items["Dark Judas"] = treasureCard{baseCard: baseCard{name: "Dark Arts", effect: darkArtsDesc, id: darkArts}, eternal: true, passive: true}
@@ -1017,169 +1038,169 @@ func getTreasureCards(useExpansionOne bool, useExpansionTwo bool) deck {
 	}
 	deck := make(deck, 0, n)
 	deck.append([]card{
-		treasureCard{baseCard: baseCard{name: "Blank Card", id: blankCard}, active: true, f: blankCardFunc},
-		treasureCard{baseCard: baseCard{name: "Book of Sin", id: bookOfSin}, active: true, f: bookOfSinFunc},
-		treasureCard{baseCard: baseCard{name: "Boomerang", id: boomerang}, active: true, f: boomerangeFunc},
-		treasureCard{baseCard: baseCard{name: "Box!", id: box}, active: true, f: boxFunc},
-		treasureCard{baseCard: baseCard{name: "Bum Friend", id: bumFriend}, active: true, f: bumFriendFunc},
-		treasureCard{baseCard: baseCard{name: "Chaos", id: chaos}, active: true, f: chaosFunc},
-		treasureCard{baseCard: baseCard{name: "Chaos Card", id: chaosCard}, active: true, f: chaosCardFunc},
-		treasureCard{baseCard: baseCard{name: "Compost", id: compost}, active: true, f: compostFunc},
-		treasureCard{baseCard: baseCard{name: "Crystal Ball", id: crystalBall}, active: true, f: crystalBallFunc},
-		treasureCard{baseCard: baseCard{name: "Decoy", id: decoy}, active: true, f: decoyFunc},
-		treasureCard{baseCard: baseCard{name: "Diplopia", id: diplopia}, active: true, f: diplopiaFunc},
-		treasureCard{baseCard: baseCard{name: "Flush!", id: flush}, active: true, f: flushFunc},
-		treasureCard{baseCard: baseCard{name: "Glass Cannon", id: glassCannon}, active: true, f: glassCannonFunc},
-		treasureCard{baseCard: baseCard{name: "Godhead", id: godhead}, active: true, f: godheadFunc},
-		treasureCard{baseCard: baseCard{name: "Guppy's Head", id: guppysHead}, active: true, f: guppysHeadFunc},
-		treasureCard{baseCard: baseCard{name: "Guppy's Paw", id: guppysPaw}, active: true, f: guppysPawFunc},
-		treasureCard{baseCard: baseCard{name: "Host Hat", id: hostHat}, active: true, f: hostHatFunc},
-		treasureCard{baseCard: baseCard{name: "Jawbone", id: jawbone}, active: true, f: jawboneFunc},
-		treasureCard{baseCard: baseCard{name: "Lucky Foot", id: luckyFoot}, active: true, f: luckyFootFunc},
-		treasureCard{baseCard: baseCard{name: "Mini Mush", id: miniMush}, active: true, f: miniMushFunc},
-		treasureCard{baseCard: baseCard{name: "Modeling Clay", id: modelingClay}, active: true, f: modelingClayFunc},
-		treasureCard{baseCard: baseCard{name: "Mom's Bra", id: momsBra}, active: true, f: momsBraFunc},
-		treasureCard{baseCard: baseCard{name: "Mom's Shovel", id: momsShovel}, active: true, tapped: true, f: momsShovelFunc},
-		treasureCard{baseCard: baseCard{name: "Monster Manual", id: monsterManual}, active: true, f: monsterManualFunc},
-		treasureCard{baseCard: baseCard{name: "Mr. Boom", id: mrBoom}, active: true, f: mrBoomFunc},
-		treasureCard{baseCard: baseCard{name: "Mystery Sack", id: mysterySack}, active: true, f: mysterySackFunc},
-		treasureCard{baseCard: baseCard{name: "No!", id: no}, active: true, f: noFunc},
-		treasureCard{baseCard: baseCard{name: "Pandora's Box", id: pandorasBox}, active: true, f: pandorasBoxFunc},
-		treasureCard{baseCard: baseCard{name: "Placebo", id: placebo}, active: true, f: placeboFunc},
-		treasureCard{baseCard: baseCard{name: "Potato Peeler", id: potatoPeeler}, active: true, f: potatoPeelerFunc},
-		treasureCard{baseCard: baseCard{name: "Razor Blade", id: razorBlade}, active: true, f: razorBladeFunc},
-		treasureCard{baseCard: baseCard{name: "Remote Detonator", id: remoteDetonator}, active: true, f: remoteDetonatorFunc},
-		treasureCard{baseCard: baseCard{name: "Sack Head", id: sackHead}, active: true, f: sackHeadFunc},
-		treasureCard{baseCard: baseCard{name: "Sack of Pennies", id: sackOfPennies}, active: true, f: sackOfPenniesFunc},
-		treasureCard{baseCard: baseCard{name: "Spoon Bender", id: spoonBender}, active: true, f: spoonBenderFunc},
-		treasureCard{baseCard: baseCard{name: "The Battery", id: theBattery}, active: true, f: theBatteryFunc},
-		treasureCard{baseCard: baseCard{name: "The D4", id: theD4}, active: true, f: theD4Func},
-		treasureCard{baseCard: baseCard{name: "The D20", id: theD20}, active: true, f: theD20Func},
-		treasureCard{baseCard: baseCard{name: "The D100", id: theD100}, active: true, f: theD100Func},
-		treasureCard{baseCard: baseCard{name: "The Shovel", id: theShovel}, active: true, f: theShovelFunc},
-		treasureCard{baseCard: baseCard{name: "Two of Clubs", id: twoOfClubs}, active: true, f: twoOfClubsFunc},
-		treasureCard{baseCard: baseCard{name: "Battery Bum", id: batteryBum}, paid: true, f: batteryBumFunc},
-		treasureCard{baseCard: baseCard{name: "Contract From Below", id: contractFromBelow}, paid: true, f: contractFromBelowFunc},
-		treasureCard{baseCard: baseCard{name: "Donation Machine", id: donationMachine}, paid: true, f: donationMachineFunc},
-		treasureCard{baseCard: baseCard{name: "Golden Razor Blade", id: goldenRazorBlade}, paid: true, f: goldenRazorBladeFunc},
-		treasureCard{baseCard: baseCard{name: "Pay To Play", id: payToPlay}, paid: true, f: payToPlayFunc},
-		treasureCard{baseCard: baseCard{name: "Portable Slot Machine", id: portableSlotMachine}, paid: true, f: portableSlotMachineFunc},
-		treasureCard{baseCard: baseCard{name: "Smelter", id: smelter}, paid: true, f: smelterFunc},
-		treasureCard{baseCard: baseCard{name: "The Poop", id: thePoop}, paid: true, f: thePoopFunc},
-		treasureCard{baseCard: baseCard{name: "Tech X", id: techX}, active: true, paid: true, f: techXFunc},
-		treasureCard{baseCard: baseCard{name: "Baby Haunt", id: babyHaunt}, passive: true, ef: babyHauntFunc},
-		treasureCard{baseCard: baseCard{name: "Belly Button", id: bellyButton}, passive: true, ef: bellyButtonFuncEvent, cf: bellyButtonFuncConstant},
-		treasureCard{baseCard: baseCard{name: "Bob's Brain", id: bobsBrain}, passive: true, ef: bobsBrainFunc},
-		treasureCard{baseCard: baseCard{name: "Breakfast", id: breakfast}, passive: true, cf: breakfastFunc},
-		treasureCard{baseCard: baseCard{name: "Brimstone", id: brimstone}, passive: true, ef: brimstoneFuncEvent, cf: brimstoneFuncConstant},
-		treasureCard{baseCard: baseCard{name: "Bum-Bo!", id: bumbo}, passive: true, cf: bumboFunc},
-		treasureCard{baseCard: baseCard{name: "Cambion Conception", id: cambionConception}, passive: true, ef: cambionConceptionFunc},
-		treasureCard{baseCard: baseCard{name: "Champion Belt", id: championBelt}, passive: true, cf: championBeltFunc},
-		treasureCard{baseCard: baseCard{name: "Charged Baby", id: chargedBaby}, passive: true, ef: chargedBabyFunc},
-		treasureCard{baseCard: baseCard{name: "Cheese Grater", id: cheeseGrater}, passive: true, ef: cheeseGraterFunc},
-		treasureCard{baseCard: baseCard{name: "Curse of the Tower", id: curseOfTheTower}, passive: true, ef: curseOfTheTowerFunc},
-		treasureCard{baseCard: baseCard{name: "Dad's Lost Coin", id: dadsLostCoint}, passive: true, ef: dadsLostCoinFunc},
-		treasureCard{baseCard: baseCard{name: "Daddy Haunt", id: daddyHaunt}, passive: true, ef: daddyHauntFunc},
-		treasureCard{baseCard: baseCard{name: "Dark Bum", id: darkBum}, passive: true, ef: darkBumFunc},
-		treasureCard{baseCard: baseCard{name: "Dead Bird", id: deadBird}, passive: true, ef: deadBirdFunc},
-		treasureCard{baseCard: baseCard{name: "Dinner", id: dinner}, passive: true, cf: dinnerFunc},
-		treasureCard{baseCard: baseCard{name: "Dry Baby", id: dryBaby}, passive: true}, // No function need be attached
-		treasureCard{baseCard: baseCard{name: "Eden's Blessing", id: edensBlessing}, passive: true, ef: edensBlessingFunc},
-		treasureCard{baseCard: baseCard{name: "Empty Vessel", id: emptyVessel}, passive: true}, // No function need be attached
-		treasureCard{baseCard: baseCard{name: "Eye of Greed", id: eyeOfGreed}, passive: true, ef: eyeOfGreedFunc},
-		treasureCard{baseCard: baseCard{name: "Fanny Pack", id: fannyPack}, passive: true, ef: fannyPackFunc},
-		treasureCard{baseCard: baseCard{name: "Finger", id: finger}, passive: true, ef: fingerFunc},
-		treasureCard{baseCard: baseCard{name: "Greed's Gullet", id: greedsGullet}, passive: true, ef: greedsGulletFunc},
-		treasureCard{baseCard: baseCard{name: "Goat Head", id: goatHead}, passive: true, ef: goatHeadFunc},
-		treasureCard{baseCard: baseCard{name: "Guppy's Collar", id: guppysCollar}, passive: true, ef: guppysCollarFunc},
-		treasureCard{baseCard: baseCard{name: "Ipecac", id: ipecac}, passive: true, ef: ipecacFuncEvent, cf: ipecacFuncConstant},
-		treasureCard{baseCard: baseCard{name: "Meat!", id: meat}, passive: true}, // No function need be attached
-		treasureCard{baseCard: baseCard{name: "Mom's Box", id: momsBox}, passive: true, ef: momsBoxFunc},
-		treasureCard{baseCard: baseCard{name: "Mom's Coin Purse", id: momsCoinPurse}, passive: true, ef: momsPursesFunc},
-		treasureCard{baseCard: baseCard{name: "Mom's Purse", id: momsPurse}, passive: true, ef: momsPursesFunc},
-		treasureCard{baseCard: baseCard{name: "Mom's Razor", id: momsRazor}, passive: true, ef: momsRazorFunc},
-		treasureCard{baseCard: baseCard{name: "Monstro's Tooth", id: monstrosTooth}, passive: true, ef: monstrosToothFunc},
-		treasureCard{baseCard: baseCard{name: "Polydactyly", id: polydactyly}, passive: true, cf: polydactylyFunc},
-		treasureCard{baseCard: baseCard{name: "Restock", id: restock}, passive: true, ef: restockFunc},
-		treasureCard{baseCard: baseCard{name: "Sacred Heart", id: sacredHeart}, passive: true, ef: sacredHeartFunc},
-		treasureCard{baseCard: baseCard{name: "Shadow", id: shadow}, passive: true}, // No function need be attached
-		treasureCard{baseCard: baseCard{name: "Shiny Rock", id: shinyRock}, passive: true, ef: shinyRockFunc},
-		treasureCard{baseCard: baseCard{name: "Spider Mod", id: spiderMod}, passive: true, ef: spiderModFunc},
-		treasureCard{baseCard: baseCard{name: "Starter Deck", id: starterDeck}, passive: true, ef: starterDeckFunc},
-		treasureCard{baseCard: baseCard{name: "Steamy Sale!", id: steamySale}, passive: true}, // No function need be attached
-		treasureCard{baseCard: baseCard{name: "Suicide King", id: suicideKing}, passive: true, ef: suicideKingFunc},
-		treasureCard{baseCard: baseCard{name: "Synthoil", id: synthoil}, passive: true}, // No function need be attached
-		treasureCard{baseCard: baseCard{name: "Tarot Cloth", id: tarotCloth}, passive: true, ef: tarotClothFunc},
-		treasureCard{baseCard: baseCard{name: "There's Options", id: theresOptions}, passive: true, cf: theresOptionsFunc},
-		treasureCard{baseCard: baseCard{name: "The Blue Map", id: theBlueMap}, passive: true, ef: theBlueMapFunc},
-		treasureCard{baseCard: baseCard{name: "The Chest", id: theChest}, passive: true, cf: theChestFunc},
-		treasureCard{baseCard: baseCard{name: "The Compass", id: theCompass}, passive: true, ef: theCompassFunc},
-		treasureCard{baseCard: baseCard{name: "The D10", id: theD10}, passive: true, ef: theD10Func},
-		treasureCard{baseCard: baseCard{name: "The Dead Cat", id: theDeadCat}, passive: true, cf: theDeadCatFuncConstant},
-		treasureCard{baseCard: baseCard{name: "The Habit", id: theHabit}, passive: true, ef: theHabitFuncEvent, cf: theHabitFuncConstant},
-		treasureCard{baseCard: baseCard{name: "The Map", id: theMap}, passive: true, ef: theMapFunc},
-		treasureCard{baseCard: baseCard{name: "The Midas Touch", id: theMidasTouch}, passive: true}, // no function need be assigned
-		treasureCard{baseCard: baseCard{name: "The Polaroid", id: thePolaroid}, passive: true, ef: thePolaroidFunc},
-		treasureCard{baseCard: baseCard{name: "The Relic", id: theRelic}, passive: true, ef: theRelicFunc},
-		treasureCard{baseCard: baseCard{name: "Trinity Shield", id: trinityShield}, passive: true},
+		treasureCard{baseCard: baseCard{name: "Blank Card", effect: blankCardDesc, id: blankCard}, active: true, f: blankCardFunc},
+		treasureCard{baseCard: baseCard{name: "Book of Sin", effect: bookOfSinDesc, id: bookOfSin}, active: true, f: bookOfSinFunc},
+		treasureCard{baseCard: baseCard{name: "Boomerang", effect: boomerangDesc, id: boomerang}, active: true, f: boomerangeFunc},
+		treasureCard{baseCard: baseCard{name: "Box!", effect: boxDesc, id: box}, active: true, f: boxFunc},
+		treasureCard{baseCard: baseCard{name: "Bum Friend", effect: bumFriendDesc, id: bumFriend}, active: true, f: bumFriendFunc},
+		treasureCard{baseCard: baseCard{name: "Chaos", effect: chaosDesc, id: chaos}, active: true, f: chaosFunc},
+		treasureCard{baseCard: baseCard{name: "Chaos Card", effect: chaosCardDesc, id: chaosCard}, active: true, f: chaosCardFunc},
+		treasureCard{baseCard: baseCard{name: "Compost", effect: compostDesc, id: compost}, active: true, f: compostFunc},
+		treasureCard{baseCard: baseCard{name: "Crystal Ball", effect: crystalBallDesc, id: crystalBall}, active: true, f: crystalBallFunc},
+		treasureCard{baseCard: baseCard{name: "Decoy", effect: decoyDesc, id: decoy}, active: true, f: decoyFunc},
+		treasureCard{baseCard: baseCard{name: "Diplopia", effect: diplopiaDesc, id: diplopia}, active: true, f: diplopiaFunc},
+		treasureCard{baseCard: baseCard{name: "Flush!", effect: flushDesc, id: flush}, active: true, f: flushFunc},
+		treasureCard{baseCard: baseCard{name: "Glass Cannon", effect: glassCannonDesc, id: glassCannon}, active: true, f: glassCannonFunc},
+		treasureCard{baseCard: baseCard{name: "Godhead", effect: godheadDesc, id: godhead}, active: true, f: godheadFunc},
+		treasureCard{baseCard: baseCard{name: "Guppy's Head", effect: guppysHeadDesc, id: guppysHead}, active: true, f: guppysHeadFunc},
+		treasureCard{baseCard: baseCard{name: "Guppy's Paw", effect: guppysPawDesc, id: guppysPaw}, active: true, f: guppysPawFunc},
+		treasureCard{baseCard: baseCard{name: "Host Hat", effect: hostHatDesc, id: hostHat}, active: true, f: hostHatFunc},
+		treasureCard{baseCard: baseCard{name: "Jawbone", effect: jawboneDesc, id: jawbone}, active: true, f: jawboneFunc},
+		treasureCard{baseCard: baseCard{name: "Lucky Foot", effect: luckyFootDesc, id: luckyFoot}, active: true, f: luckyFootFunc},
+		treasureCard{baseCard: baseCard{name: "Mini Mush", effect: miniMushDesc, id: miniMush}, active: true, f: miniMushFunc},
+		treasureCard{baseCard: baseCard{name: "Modeling Clay", effect: modelingClayDesc, id: modelingClay}, active: true, f: modelingClayFunc},
+		treasureCard{baseCard: baseCard{name: "Mom's Bra", effect: momsBraDesc, id: momsBra}, active: true, f: momsBraFunc},
+		treasureCard{baseCard: baseCard{name: "Mom's Shovel", effect: momsShovelDesc, id: momsShovel}, active: true, tapped: true, f: momsShovelFunc},
+		treasureCard{baseCard: baseCard{name: "Monster Manual", effect: monsterManualDesc, id: monsterManual}, active: true, f: monsterManualFunc},
+		treasureCard{baseCard: baseCard{name: "Mr. Boom", effect: mrBoomDesc, id: mrBoom}, active: true, f: mrBoomFunc},
+		treasureCard{baseCard: baseCard{name: "Mystery Sack", effect: mysterySackDesc, id: mysterySack}, active: true, f: mysterySackFunc},
+		treasureCard{baseCard: baseCard{name: "No!", effect: noDesc, id: no}, active: true, f: noFunc},
+		treasureCard{baseCard: baseCard{name: "Pandora's Box", effect: pandorasBoxDesc, id: pandorasBox}, active: true, f: pandorasBoxFunc},
+		treasureCard{baseCard: baseCard{name: "Placebo", effect: placeboDesc, id: placebo}, active: true, f: placeboFunc},
+		treasureCard{baseCard: baseCard{name: "Potato Peeler", effect: potatoPeelerDesc, id: potatoPeeler}, active: true, f: potatoPeelerFunc},
+		treasureCard{baseCard: baseCard{name: "Razor Blade", effect: razorBladeDesc, id: razorBlade}, active: true, f: razorBladeFunc},
+		treasureCard{baseCard: baseCard{name: "Remote Detonator", effect: remoteDetonatorDesc, id: remoteDetonator}, active: true, f: remoteDetonatorFunc},
+		treasureCard{baseCard: baseCard{name: "Sack Head", effect: sackHeadDesc, id: sackHead}, active: true, f: sackHeadFunc},
+		treasureCard{baseCard: baseCard{name: "Sack of Pennies", effect: sackOfPenniesDesc, id: sackOfPennies}, active: true, f: sackOfPenniesFunc},
+		treasureCard{baseCard: baseCard{name: "Spoon Bender", effect: spoonBenderDesc, id: spoonBender}, active: true, f: spoonBenderFunc},
+		treasureCard{baseCard: baseCard{name: "The Battery", effect: theBatteryDesc, id: theBattery}, active: true, f: theBatteryFunc},
+		treasureCard{baseCard: baseCard{name: "The D4", effect: theD4Desc, id: theD4}, active: true, f: theD4Func},
+		treasureCard{baseCard: baseCard{name: "The D20", effect: theD20Desc, id: theD20}, active: true, f: theD20Func},
+		treasureCard{baseCard: baseCard{name: "The D100", effect: theD100Desc, id: theD100}, active: true, f: theD100Func},
+		treasureCard{baseCard: baseCard{name: "The Shovel", effect: theShovelDesc, id: theShovel}, active: true, f: theShovelFunc},
+		treasureCard{baseCard: baseCard{name: "Two of Clubs", effect: twoOfClubsDesc, id: twoOfClubs}, active: true, f: twoOfClubsFunc},
+		treasureCard{baseCard: baseCard{name: "Battery Bum", effect: batteryBumDesc, id: batteryBum}, paid: true, f: batteryBumFunc},
+		treasureCard{baseCard: baseCard{name: "Contract From Below", effect: contractFromBelowDesc, id: contractFromBelow}, paid: true, f: contractFromBelowFunc},
+		treasureCard{baseCard: baseCard{name: "Donation Machine", effect: donationMachineDesc, id: donationMachine}, paid: true, f: donationMachineFunc},
+		treasureCard{baseCard: baseCard{name: "Golden Razor Blade", effect: goldenRazorBladeDesc, id: goldenRazorBlade}, paid: true, f: goldenRazorBladeFunc},
+		treasureCard{baseCard: baseCard{name: "Pay To Play", effect: payToPlayDesc, id: payToPlay}, paid: true, f: payToPlayFunc},
+		treasureCard{baseCard: baseCard{name: "Portable Slot Machine", effect: portableSlotMachineDesc, id: portableSlotMachine}, paid: true, f: portableSlotMachineFunc},
+		treasureCard{baseCard: baseCard{name: "Smelter", effect: smelterDesc, id: smelter}, paid: true, f: smelterFunc},
+		treasureCard{baseCard: baseCard{name: "The Poop", effect: thePoopDesc, id: thePoop}, paid: true, f: thePoopFunc},
+		treasureCard{baseCard: baseCard{name: "Tech X", effect: techXDesc, id: techX}, active: true, paid: true, f: techXFunc},
+		treasureCard{baseCard: baseCard{name: "Baby Haunt", effect: babyHauntDesc, id: babyHaunt}, passive: true, ef: babyHauntFunc},
+		treasureCard{baseCard: baseCard{name: "Belly Button", effect: bellyButtonDesc, id: bellyButton}, passive: true, ef: bellyButtonFuncEvent},
+		treasureCard{baseCard: baseCard{name: "Bob's Brain", effect: bobsBrainDesc, id: bobsBrain}, passive: true, ef: bobsBrainFunc},
+		treasureCard{baseCard: baseCard{name: "Breakfast", effect: breakfastDesc, id: breakfast}, passive: true, cf: modifyHealth},
+		treasureCard{baseCard: baseCard{name: "Brimstone", effect: brimstoneDesc, id: brimstone}, passive: true, ef: brimstoneFuncEvent},
+		treasureCard{baseCard: baseCard{name: "Bum-Bo!", effect: bumboDesc, id: bumbo}, passive: true},
+		treasureCard{baseCard: baseCard{name: "Cambion Conception", effect: cambionConceptionDesc, id: cambionConception}, passive: true, ef: cambionConceptionFunc},
+		treasureCard{baseCard: baseCard{name: "Champion Belt", effect: championBeltDesc, id: championBelt}, passive: true},
+		treasureCard{baseCard: baseCard{name: "Charged Baby", effect: chargedBabyDesc, id: chargedBaby}, passive: true, ef: chargedBabyFunc},
+		treasureCard{baseCard: baseCard{name: "Cheese Grater", effect: cheeseGraterDesc, id: cheeseGrater}, passive: true, ef: cheeseGraterFunc},
+		treasureCard{baseCard: baseCard{name: "Curse of the Tower", effect: curseOfTheTowerDesc, id: curseOfTheTower}, passive: true, ef: curseOfTheTowerFunc},
+		treasureCard{baseCard: baseCard{name: "Dad's Lost Coin", effect: dadsLostCoinDesc, id: dadsLostCoint}, passive: true, ef: dadsLostCoinFunc},
+		treasureCard{baseCard: baseCard{name: "Daddy Haunt", effect: daddyHauntDesc, id: daddyHaunt}, passive: true, ef: daddyHauntFunc},
+		treasureCard{baseCard: baseCard{name: "Dark Bum", effect: darkBumDesc, id: darkBum}, passive: true, ef: darkBumFunc},
+		treasureCard{baseCard: baseCard{name: "Dead Bird", effect: deadBirdDesc, id: deadBird}, passive: true, ef: deadBirdFunc},
+		treasureCard{baseCard: baseCard{name: "Dinner", effect: dinnerDesc, id: dinner}, passive: true, cf: modifyHealth},
+		treasureCard{baseCard: baseCard{name: "Dry Baby", effect: dryBabyDesc, id: dryBaby}, passive: true}, // No function need be attached
+		treasureCard{baseCard: baseCard{name: "Eden's Blessing", effect: edensBlessingDesc, id: edensBlessing}, passive: true, ef: edensBlessingFunc},
+		treasureCard{baseCard: baseCard{name: "Empty Vessel", effect: emptyVesselDesc, id: emptyVessel}, passive: true}, // No function need be attached
+		treasureCard{baseCard: baseCard{name: "Eye of Greed", effect: eyeOfGreedDesc, id: eyeOfGreed}, passive: true, ef: eyeOfGreedFunc},
+		treasureCard{baseCard: baseCard{name: "Fanny Pack", effect: fannyPackDesc, id: fannyPack}, passive: true, ef: fannyPackFunc},
+		treasureCard{baseCard: baseCard{name: "Finger", effect: fingerDesc, id: finger}, passive: true, ef: fingerFunc},
+		treasureCard{baseCard: baseCard{name: "Greed's Gullet", effect: greedsGulletDesc, id: greedsGullet}, passive: true, ef: greedsGulletFunc},
+		treasureCard{baseCard: baseCard{name: "Goat Head", effect: goatHeadDesc, id: goatHead}, passive: true, ef: goatHeadFunc},
+		treasureCard{baseCard: baseCard{name: "Guppy's Collar", effect: guppysCollarDesc, id: guppysCollar}, passive: true, ef: guppysCollarFunc},
+		treasureCard{baseCard: baseCard{name: "Ipecac", effect: ipecacDesc, id: ipecac}, passive: true, ef: ipecacFuncEvent},
+		treasureCard{baseCard: baseCard{name: "Meat!", effect: meatDesc, id: meat}, passive: true}, // No function need be attached
+		treasureCard{baseCard: baseCard{name: "Mom's Box", effect: momsBoxDesc, id: momsBox}, passive: true, ef: momsBoxFunc},
+		treasureCard{baseCard: baseCard{name: "Mom's Coin Purse", effect: momsCoinPurseDesc, id: momsCoinPurse}, passive: true, ef: momsPursesFunc},
+		treasureCard{baseCard: baseCard{name: "Mom's Purse", effect: momsPurseDesc, id: momsPurse}, passive: true, ef: momsPursesFunc},
+		treasureCard{baseCard: baseCard{name: "Mom's Razor", effect: momsRazorDesc, id: momsRazor}, passive: true, ef: momsRazorFunc},
+		treasureCard{baseCard: baseCard{name: "Monstro's Tooth", effect: monstrosToothDesc, id: monstrosTooth}, passive: true, ef: monstrosToothFunc},
+		treasureCard{baseCard: baseCard{name: "Polydactyly", effect: polydactylyDesc, id: polydactyly}, passive: true},
+		treasureCard{baseCard: baseCard{name: "Restock", effect: restockDesc, id: restock}, passive: true, ef: restockFunc},
+		treasureCard{baseCard: baseCard{name: "Sacred Heart", effect: sacredHeartDesc, id: sacredHeart}, passive: true, ef: sacredHeartFunc},
+		treasureCard{baseCard: baseCard{name: "Shadow", effect: shadowDesc, id: shadow}, passive: true}, // No function need be attached
+		treasureCard{baseCard: baseCard{name: "Shiny Rock", effect: shinyRockDesc, id: shinyRock}, passive: true, ef: shinyRockFunc},
+		treasureCard{baseCard: baseCard{name: "Spider Mod", effect: spiderModDesc, id: spiderMod}, passive: true, ef: spiderModFunc},
+		treasureCard{baseCard: baseCard{name: "Starter Deck", effect: starterDeckDesc, id: starterDeck}, passive: true, ef: starterDeckFunc},
+		treasureCard{baseCard: baseCard{name: "Steamy Sale!", effect: steamySaleDesc, id: steamySale}, passive: true}, // No function need be attached
+		treasureCard{baseCard: baseCard{name: "Suicide King", effect: suicideKingDesc, id: suicideKing}, passive: true, ef: suicideKingFunc},
+		treasureCard{baseCard: baseCard{name: "Synthoil", effect: synthoilDesc, id: synthoil}, passive: true}, // No function need be attached
+		treasureCard{baseCard: baseCard{name: "Tarot Cloth", effect: tarotClothDesc, id: tarotCloth}, passive: true, ef: tarotClothFunc},
+		treasureCard{baseCard: baseCard{name: "There's Options", effect: theresOptionsDesc, id: theresOptions}, passive: true},
+		treasureCard{baseCard: baseCard{name: "The Blue Map", effect: theBlueMapDesc, id: theBlueMap}, passive: true, ef: theBlueMapFunc},
+		treasureCard{baseCard: baseCard{name: "The Chest", effect: theChestDesc, id: theChest}, passive: true, cf: theChestFunc},
+		treasureCard{baseCard: baseCard{name: "The Compass", effect: theCompassDesc, id: theCompass}, passive: true, ef: theCompassFunc},
+		treasureCard{baseCard: baseCard{name: "The D10", effect: theD10Desc, id: theD10}, passive: true, ef: theD10Func},
+		treasureCard{baseCard: baseCard{name: "The Dead Cat", effect: theDeadCatDesc, id: theDeadCat}, passive: true, cf: theDeadCatFuncConstant},
+		treasureCard{baseCard: baseCard{name: "The Habit", effect: theHabitDesc, id: theHabit}, passive: true, ef: theHabitFuncEvent, cf: theHabitFuncConstant},
+		treasureCard{baseCard: baseCard{name: "The Map", effect: theMapDesc, id: theMap}, passive: true, ef: theMapFunc},
+		treasureCard{baseCard: baseCard{name: "The Midas Touch", effect: theMidasTouchDesc, id: theMidasTouch}, passive: true}, // no function need be assigned
+		treasureCard{baseCard: baseCard{name: "The Polaroid", effect: thePolaroidDesc, id: thePolaroid}, passive: true, ef: thePolaroidFunc},
+		treasureCard{baseCard: baseCard{name: "The Relic", effect: theRelicDesc, id: theRelic}, passive: true, ef: theRelicFunc},
+		treasureCard{baseCard: baseCard{name: "Trinity Shield", effect: trinityShieldDesc, id: trinityShield}, passive: true},
 	}...)
 	if useExpansionOne == true {
 		deck.append([]card{
-			treasureCard{baseCard: baseCard{name: "Crooked Penny", id: crookedPenny}, active: true},
-			treasureCard{baseCard: baseCard{name: "Fruitcake", id: fruitCake}, active: true},
-			treasureCard{baseCard: baseCard{name: "I Can't Believe it's Not Butter Bean", id: iCantBelieveItsNotButterBean}, active: true},
-			treasureCard{baseCard: baseCard{name: "Lemon Mishap", id: lemonMishap}, active: true},
-			treasureCard{baseCard: baseCard{name: "Library Card", id: libraryCard}, active: true},
-			treasureCard{baseCard: baseCard{name: "Ouija Board", id: ouijaBoard}, active: true},
-			treasureCard{baseCard: baseCard{name: "Plan C", id: planC}, active: true},
-			treasureCard{baseCard: baseCard{name: "The Bible", id: theBible}, active: true},
-			treasureCard{baseCard: baseCard{name: "The Butter Bean", id: theButterBean}, active: true},
-			treasureCard{baseCard: baseCard{name: "Dad's Key", id: dadsKey}, paid: true},
-			treasureCard{baseCard: baseCard{name: "Succubus", id: succubus}, paid: true},
-			treasureCard{baseCard: baseCard{name: "9 Volt", id: nineVolt}, passive: true},
-			treasureCard{baseCard: baseCard{name: "Guppy's Tail", id: guppysTail}, passive: true},
-			treasureCard{baseCard: baseCard{name: "Infamy", id: infamy}, passive: true},
-			treasureCard{baseCard: baseCard{name: "Mom's Knife", id: momsKnife}, passive: true},
-			treasureCard{baseCard: baseCard{name: "More Options", id: moreOptions}, passive: true},
-			treasureCard{baseCard: baseCard{name: "Placenta", id: placenta}, passive: true},
-			treasureCard{baseCard: baseCard{name: "Skeleton Key", id: skeletonKey}, passive: true},
-			treasureCard{baseCard: baseCard{name: "Soy Milk", id: soyMilk}, passive: true},
-			treasureCard{baseCard: baseCard{name: "The Missing Page", id: theMissingPage}, passive: true},
+			treasureCard{baseCard: baseCard{name: "Crooked Penny", effect: crookedPennyDesc, id: crookedPenny}, active: true},
+			treasureCard{baseCard: baseCard{name: "Fruitcake", effect: fruitCakeDesc, id: fruitCake}, active: true},
+			treasureCard{baseCard: baseCard{name: "I Can't Believe it's Not Butter Bean", effect: iCantBelieveItsNotButterBeanDesc, id: iCantBelieveItsNotButterBean}, active: true},
+			treasureCard{baseCard: baseCard{name: "Lemon Mishap", effect: lemonMishapDesc, id: lemonMishap}, active: true},
+			treasureCard{baseCard: baseCard{name: "Library Card", effect: libraryCardDesc, id: libraryCard}, active: true},
+			treasureCard{baseCard: baseCard{name: "Ouija Board", effect: ouijaBoardDesc, id: ouijaBoard}, active: true},
+			treasureCard{baseCard: baseCard{name: "Plan C", effect: planCDesc, id: planC}, active: true},
+			treasureCard{baseCard: baseCard{name: "The Bible", effect: theBibleDesc, id: theBible}, active: true},
+			treasureCard{baseCard: baseCard{name: "The Butter Bean", effect: theButterBeanDesc, id: theButterBean}, active: true},
+			treasureCard{baseCard: baseCard{name: "Dad's Key", effect: dadsKeyDesc, id: dadsKey}, paid: true},
+			treasureCard{baseCard: baseCard{name: "Succubus", effect: succubusDesc, id: succubus}, paid: true},
+			treasureCard{baseCard: baseCard{name: "9 Volt", effect: nineVoltDesc, id: nineVolt}, passive: true},
+			treasureCard{baseCard: baseCard{name: "Guppy's Tail", effect: guppysTailDesc, id: guppysTail}, passive: true},
+			treasureCard{baseCard: baseCard{name: "Infamy", effect: infamyDesc, id: infamy}, passive: true},
+			treasureCard{baseCard: baseCard{name: "Mom's Knife", effect: momsKnifeDesc, id: momsKnife}, passive: true},
+			treasureCard{baseCard: baseCard{name: "More Options", effect: moreOptionsDesc, id: moreOptions}, passive: true},
+			treasureCard{baseCard: baseCard{name: "Placenta", effect: placentaDesc, id: placenta}, passive: true, cf: modifyHealth},
+			treasureCard{baseCard: baseCard{name: "Skeleton Key", effect: skeletonKeyDesc, id: skeletonKey}, passive: true},
+			treasureCard{baseCard: baseCard{name: "Soy Milk", effect: soyMilkDesc, id: soyMilk}, passive: true},
+			treasureCard{baseCard: baseCard{name: "The Missing Page", effect: theMissingPageDesc, id: theMissingPage}, passive: true},
 		}...)
 	}
 	if useExpansionTwo == true {
 		deck.append([]card{
-			treasureCard{baseCard: baseCard{name: "20/20", id: twentyTwenty}, active: true},
-			treasureCard{baseCard: baseCard{name: "Black Candle", id: blackCandle}, active: true},
-			treasureCard{baseCard: baseCard{name: "Distant Admiration", id: distantAdmiration}, active: true},
-			treasureCard{baseCard: baseCard{name: "Divorce Papers", id: divorcePapers}, active: true},
-			treasureCard{baseCard: baseCard{name: "Forget Me Now", id: forgetMeNow}, active: true},
-			treasureCard{baseCard: baseCard{name: "Head of Krampus", id: headOfKrampus}, active: true},
-			treasureCard{baseCard: baseCard{name: "Infestation", id: infestation}, active: true},
-			treasureCard{baseCard: baseCard{name: "Libra", id: libra}, active: true},
-			treasureCard{baseCard: baseCard{name: "Mutant Spider", id: mutantSpider}, active: true},
-			treasureCard{baseCard: baseCard{name: "Rainbow Baby", id: rainbowBaby}, active: true},
-			treasureCard{baseCard: baseCard{name: "Red Candle", id: redCandle}, active: true},
-			treasureCard{baseCard: baseCard{name: "Smart Fly", id: smartFly}, active: true, f: smartFlyFunc},
-			treasureCard{baseCard: baseCard{name: "Athame", id: athame}, paid: true},
-			treasureCard{baseCard: baseCard{name: "1-Up", id: oneUp}, passive: true},
-			treasureCard{baseCard: baseCard{name: "Abaddon", id: abaddon}, passive: true},
-			treasureCard{baseCard: baseCard{name: "Cursed Eye", id: cursedEye}, passive: true},
-			treasureCard{baseCard: baseCard{name: "Daddy Long Legs", id: daddyLongLegsTreasure}, passive: true},
-			treasureCard{baseCard: baseCard{name: "Euthanasia", id: euthanasia}, passive: true},
-			treasureCard{baseCard: baseCard{name: "Game Breaking Bug!", id: gameBreakingBug}, passive: true},
-			treasureCard{baseCard: baseCard{name: "Guppy's Eye", id: guppysEye}, passive: true},
-			treasureCard{baseCard: baseCard{name: "Head of the Keeper", id: headOfTheKeeper}, passive: true},
-			treasureCard{baseCard: baseCard{name: "Hourglass", id: hourGlass}, passive: true},
-			treasureCard{baseCard: baseCard{name: "Lard", id: lard}, passive: true},
-			treasureCard{baseCard: baseCard{name: "Magnet", id: magnet}, passive: true},
-			treasureCard{baseCard: baseCard{name: "Mama Haunt", id: mamaHaunt}, passive: true},
-			treasureCard{baseCard: baseCard{name: "Mom's Eye Shadow", id: momsEyeShaow}, passive: true},
-			treasureCard{baseCard: baseCard{name: "P.H.D", id: phd}, passive: true},
-			treasureCard{baseCard: baseCard{name: "Polyphemus", id: polyphemus}, passive: true},
-			treasureCard{baseCard: baseCard{name: "Rubber Cement", id: rubberCement}, passive: true},
-			treasureCard{baseCard: baseCard{name: "Telepathy For Dummies", id: telepathyForDummies}, passive: true},
-			treasureCard{baseCard: baseCard{name: "The Wiz", id: theWiz}, passive: true},
+			treasureCard{baseCard: baseCard{name: "20/20", effect: twentyTwentyDesc, id: twentyTwenty}, active: true},
+			treasureCard{baseCard: baseCard{name: "Black Candle", effect: blackCandleDesc, id: blackCandle}, active: true, f: blackCandleFunc},
+			treasureCard{baseCard: baseCard{name: "Distant Admiration", effect: distantAdmirationDesc, id: distantAdmiration}, active: true},
+			treasureCard{baseCard: baseCard{name: "Divorce Papers", effect: divorcePapersDesc, id: divorcePapers}, active: true},
+			treasureCard{baseCard: baseCard{name: "Forget Me Now", effect: forgetMeNowDesc, id: forgetMeNow}, active: true},
+			treasureCard{baseCard: baseCard{name: "Head of Krampus", effect: headOfKrampusDesc, id: headOfKrampus}, active: true},
+			treasureCard{baseCard: baseCard{name: "Infestation", effect: infestationDesc, id: infestation}, active: true},
+			treasureCard{baseCard: baseCard{name: "Libra", effect: libraDesc, id: libra}, active: true},
+			treasureCard{baseCard: baseCard{name: "Mutant Spider", effect: mutantSpiderDesc, id: mutantSpider}, active: true},
+			treasureCard{baseCard: baseCard{name: "Rainbow Baby", effect: rainbowBabyDesc, id: rainbowBaby}, active: true},
+			treasureCard{baseCard: baseCard{name: "Red Candle", effect: redCandleDesc, id: redCandle}, active: true},
+			treasureCard{baseCard: baseCard{name: "Smart Fly", effect: smartFlyDesc, id: smartFly}, active: true, f: smartFlyFunc},
+			treasureCard{baseCard: baseCard{name: "Athame", effect: athameDesc, id: athame}, paid: true},
+			treasureCard{baseCard: baseCard{name: "1-Up", effect: oneUpDesc, id: oneUp}, passive: true},
+			treasureCard{baseCard: baseCard{name: "Abaddon", effect: abaddonDesc, id: abaddon}, passive: true},
+			treasureCard{baseCard: baseCard{name: "Cursed Eye", effect: cursedEyeDesc, id: cursedEye}, passive: true},
+			treasureCard{baseCard: baseCard{name: "Daddy Long Legs", effect: daddyLongLegsTreasureDesc, id: daddyLongLegsTreasure}, passive: true},
+			treasureCard{baseCard: baseCard{name: "Euthanasia", effect: euthanasiaDesc, id: euthanasia}, passive: true},
+			treasureCard{baseCard: baseCard{name: "Game Breaking Bug!", effect: gameBreakingBugDesc, id: gameBreakingBug}, passive: true},
+			treasureCard{baseCard: baseCard{name: "Guppy's Eye", effect: guppysEyeDesc, id: guppysEye}, passive: true},
+			treasureCard{baseCard: baseCard{name: "Head of the Keeper", effect: headOfTheKeeperDesc, id: headOfTheKeeper}, passive: true},
+			treasureCard{baseCard: baseCard{name: "Hourglass", effect: hourGlassDesc, id: hourGlass}, passive: true},
+			treasureCard{baseCard: baseCard{name: "Lard", effect: lardDesc, id: lard}, passive: true, cf: modifyHealth},
+			treasureCard{baseCard: baseCard{name: "Magnet", effect: magnetDesc, id: magnet}, passive: true},
+			treasureCard{baseCard: baseCard{name: "Mama Haunt", effect: mamaHauntDesc, id: mamaHaunt}, passive: true},
+			treasureCard{baseCard: baseCard{name: "Mom's Eye Shadow", effect: momsEyeShadowDesc, id: momsEyeShaow}, passive: true},
+			treasureCard{baseCard: baseCard{name: "P.H.D", effect: phdDesc, id: phd}, passive: true},
+			treasureCard{baseCard: baseCard{name: "Polyphemus", effect: polyphemusDesc, id: polyphemus}, passive: true},
+			treasureCard{baseCard: baseCard{name: "Rubber Cement", effect: rubberCementDesc, id: rubberCement}, passive: true},
+			treasureCard{baseCard: baseCard{name: "Telepathy For Dummies", effect: telepathyForDummiesDesc, id: telepathyForDummies}, passive: true},
+			treasureCard{baseCard: baseCard{name: "The Wiz", effect: theWizDesc, id: theWiz}, passive: true},
 		}...)
 	}
 	return deck
@@ -0,0 +1,186 @@
+package four_souls
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// The sets a card can be printed in, matching the expansion switches of NewGame.
+const (
+	baseSet          = "Base Game"
+	kickstarterSet   = "Kickstarter Expansion"
+	fourSoulsPlusSet = "Four Souls+ Expansion"
+)
+
+// Everything printed on a single card: its text, stats and the set it comes from.
+type CardInfo struct {
+	Id     uint16
+	Name   string
+	Kind   string // Character, Starting Item, Loot, Trinket, Monster, Boss, Mega Boss, Bonus, Curse, Active Item, Paid Item or Passive Item
+	Set    string
+	Text   string
+	Health uint8 // Characters and monsters only
+	Attack uint8 // Characters and monsters only
+	Roll   uint8 // Monsters only. 0 means the monster can't be attacked
+}
+
+// The card as a small sheet: name, kind and set, stats, then its rules text.
+func (ci CardInfo) String() string {
+	s := fmt.Sprintf("%s (%s, %s)\n", ci.Name, ci.Kind, ci.Set)
+	switch ci.Kind {
+	case "Character":
+		s += fmt.Sprintf("HP: %d  AP: %d\n", ci.Health, ci.Attack)
+	case "Monster", "Boss", "Mega Boss":
+		s += fmt.Sprintf("HP: %d  AP: %d  Roll: %d+\n", ci.Health, ci.Attack, ci.Roll)
+	}
+	return s + ci.Text + "\n"
+}
+
+var catalogue struct {
+	once  sync.Once
+	cards []CardInfo
+	byId  map[uint16]int
+}
+
+// Every distinct card in the game, grouped by deck.
+// Cards that appear more than once in a deck (A Penny!, Chest...) are listed once,
+// under the set they first appear in.
+func Catalogue() []CardInfo {
+	catalogue.once.Do(buildCatalogue)
+	cards := make([]CardInfo, len(catalogue.cards))
+	copy(cards, catalogue.cards)
+	return cards
+}
+
+// Find the cards whose name, kind or text contains the query, ignoring case.
+// Cards matched by name come first, in alphabetical order.
+// An empty query returns the whole catalogue.
+func SearchCards(query string) []CardInfo {
+	q := strings.ToLower(strings.TrimSpace(query))
+	all := Catalogue()
+	if q == "" {
+		return all
+	}
+	byName, byText := make([]CardInfo, 0), make([]CardInfo, 0)
+	for _, ci := range all {
+		if strings.Contains(strings.ToLower(ci.Name), q) {
+			byName = append(byName, ci)
+		} else if strings.Contains(strings.ToLower(ci.Kind), q) || strings.Contains(strings.ToLower(ci.Text), q) {
+			byText = append(byText, ci)
+		}
+	}
+	sort.SliceStable(byName, func(i, j int) bool { return byName[i].Name < byName[j].Name })
+	return append(byName, byText...)
+}
+
+// Look up a card by its id.
+func LookUpCard(id uint16) (CardInfo, error) {
+	catalogue.once.Do(buildCatalogue)
+	if i, ok := catalogue.byId[id]; ok {
+		return catalogue.cards[i], nil
+	}
+	return CardInfo{}, wrapError(ErrInvalidCard, "no card with id %d", id)
+}
+
+// The catalogue entry for a card in play.
+// The text and stats are read from the card itself, so a Modeling Clay
+// that copied another item describes the item it became.
+func cardInfoFor(c card) CardInfo {
+	ci, _ := LookUpCard(c.getId())
+	ci.Id, ci.Name = c.getId(), c.getName()
+	switch c.(type) {
+	case characterCard:
+		cc := c.(characterCard)
+		ci.Text, ci.Health, ci.Attack = cc.effect, cc.baseHealth, cc.baseAttack
+	case lootCard:
+		ci.Text = c.(lootCard).effect
+	case *lootCard:
+		ci.Text = c.(*lootCard).effect
+	case monsterCard:
+		mc := c.(monsterCard)
+		ci.Text, ci.Health, ci.Attack, ci.Roll = mc.effect, mc.baseHealth, mc.baseAttack, mc.baseRoll
+	case *monsterCard:
+		mc := c.(*monsterCard)
+		ci.Text, ci.Health, ci.Attack, ci.Roll = mc.effect, mc.baseHealth, mc.baseAttack, mc.baseRoll
+	case treasureCard:
+		ci.Text = c.(treasureCard).effect
+	case *treasureCard:
+		ci.Text = c.(*treasureCard).effect
+	}
+	return ci
+}
+
+func buildCatalogue() {
+	catalogue.byId = make(map[uint16]int)
+	add := func(ci CardInfo) {
+		if _, ok := catalogue.byId[ci.Id]; !ok {
+			catalogue.byId[ci.Id] = len(catalogue.cards)
+			catalogue.cards = append(catalogue.cards, ci)
+		}
+	}
+	// The expansion cards are whatever is appended after the base game cards.
+	sets := [3]string{baseSet, kickstarterSet, fourSoulsPlusSet}
+	switches := [3][2]bool{{false, false}, {true, false}, {false, true}}
+	for i, s := range switches {
+		for _, cc := range getCharacterCards(s[0], s[1]) {
+			add(CardInfo{Id: cc.id, Name: cc.name, Kind: "Character", Set: sets[i], Text: cc.effect,
+				Health: cc.baseHealth, Attack: cc.baseAttack})
+		}
+	}
+	startingItems := getStartingCards()
+	for i, s := range switches {
+		for _, cc := range getCharacterCards(s[0], s[1]) {
+			if tc, ok := startingItems[cc.name]; ok {
+				add(CardInfo{Id: tc.id, Name: tc.name, Kind: "Starting Item", Set: sets[i], Text: tc.effect})
+			}
+		}
+	}
+	for i, s := range switches {
+		for _, c := range getLootCards(s[0], s[1]) {
+			lc := c.(lootCard)
+			kind := "Loot"
+			if lc.trinket {
+				kind = "Trinket"
+			}
+			add(CardInfo{Id: lc.id, Name: lc.name, Kind: kind, Set: sets[i], Text: lc.effect})
+		}
+	}
+	for i, s := range switches {
+		for _, c := range getTreasureCards(s[0], s[1]) {
+			tc := c.(treasureCard)
+			kind := "Active Item"
+			if tc.paid {
+				kind = "Paid Item"
+			} else if tc.passive {
+				kind = "Passive Item"
+			}
+			add(CardInfo{Id: tc.id, Name: tc.name, Kind: kind, Set: sets[i], Text: tc.effect})
+		}
+	}
+	for i, s := range switches {
+		for _, c := range getMonsterCards(s[0], s[1]) {
+			mc := c.(monsterCard)
+			add(CardInfo{Id: mc.id, Name: mc.name, Kind: monsterKind(mc), Set: sets[i], Text: mc.effect,
+				Health: mc.baseHealth, Attack: mc.baseAttack, Roll: mc.baseRoll})
+		}
+	}
+}
+
+func monsterKind(mc monsterCard) string {
+	var kind string
+	switch {
+	case mc.isCurse:
+		kind = "Curse"
+	case mc.isBonusCard():
+		kind = "Bonus"
+	case mc.id >= mom && mc.id <= momsHeart:
+		kind = "Mega Boss"
+	case mc.isBoss:
+		kind = "Boss"
+	default:
+		kind = "Monster"
+	}
+	return kind
+}
@@ -3,53 +3,92 @@ package four_souls
 import (
 	"fmt"
 	"os"
+	"strings"
 	"text/tabwriter"
 )
 
-func showCharacterCards(players []*player, offset int) {
+func (c *console) showCharacterCards(players []*player, offset int) {
 	var s = "Player Characters\n"
 	s += characterCard{}.header()
 	for i, p := range players {
 		s += p.showCard(i + offset)
+		c.list(Option{Value: i + offset, Label: p.Character.name})
 	}
-	writeToStdout(s)
+	c.write(s)
 }
 
-func showDeck(cards deck, reverse bool) {
+// Print the cards of the catalogue matching the query, for the cards reference.
+// A handful of matches are printed in full; more are listed so the search can be narrowed.
+func ShowCards(query string) {
+	cards := SearchCards(query)
+	switch {
+	case len(cards) == 0:
+		fmt.Printf("No card matches %q.\n", query)
+	case len(cards) <= 3:
+		for _, ci := range cards {
+			fmt.Println(ci)
+		}
+	default:
+		var s = fmt.Sprintf("%d cards match %q\n\tId\tName\tKind\tSet\n", len(cards), query)
+		for _, ci := range cards {
+			s += fmt.Sprintf("\t%d\t%s\t%s\t%s\n", ci.Id, ci.Name, ci.Kind, ci.Set)
+		}
+		writeToStdout(s)
+	}
+}
+
+func (c *console) showDeck(cards deck, reverse bool) {
 	var s = "Some collection of cards\n"
-	if card, err := cards.peek(); err == nil {
-		s += card.header()
+	if top, err := cards.peek(); err == nil {
+		s += top.header()
 		if !reverse {
-			for i, c := range cards {
-				s += c.showCard(i)
+			for i, dc := range cards {
+				s += dc.showCard(i)
+				c.list(Option{Value: i, Label: dc.getName()})
 			}
 		} else {
 			l := len(cards)
 			for i := l - 1; i >= 0; i-- {
 				s += cards[i].showCard(l - i - 1)
+				c.list(Option{Value: l - i - 1, Label: cards[i].getName()})
 			}
 		}
 	}
+	c.write(s)
+}
+
+func (c *console) showEffects(effects []EffectView) {
+	var s = "Effects in play\n\tCard\tApplies To\tDuration\n"
+	for _, e := range effects {
+		owner := e.Owner
+		if owner == "" {
+			owner = "Everyone"
+		}
+		s += fmt.Sprintf("\t%s\t%s\t%s\n", e.Source, owner, e.Duration)
+	}
+	c.write(s)
 }
 
-func showEvents(events []*eventNode) {
+func (c *console) showEvents(events []*eventNode) {
 	var s = "Events (in resolveNextEvent order).\n"
 	s += headerEventStack()
 	for i, e := range events {
 		s += e.showEvent(i)
+		c.list(Option{Value: i, Label: e.label()})
 	}
-	writeToStdout(s)
+	c.write(s)
 }
 
-func showItems(cards []itemCard, offset int) {
+func (c *console) showItems(cards []itemCard, offset int) {
 	var s = fmt.Sprintf("Items\n%s", treasureCard{}.header())
-	for i, c := range cards {
-		s += c.showCard(i + offset)
+	for i, ic := range cards {
+		s += ic.showCard(i + offset)
+		c.list(Option{Value: i + offset, Label: ic.getName()})
 	}
-	writeToStdout(s)
+	c.write(s)
 }
 
-func showLootCards(lc interface{}, owner string, offset int) {
+func (c *console) showLootCards(lc interface{}, owner string, offset int) error {
 	var s = fmt.Sprintf("Loot Cards owned by %s\n", owner)
 	s += lootCard{}.header()
 	switch lc.(type) {
@@ -57,19 +96,33 @@ func showLootCards(lc interface{}, owner string, offset int) {
 		cards := lc.([]*lootCard)
 		for i, l := range cards {
 			s += l.showCard(i + offset)
+			c.list(Option{Value: i + offset, Label: l.name})
 		}
 	case []lootCard:
 		cards := lc.([]lootCard)
 		for i, l := range cards {
 			s += l.showCard(i + offset)
+			c.list(Option{Value: i + offset, Label: l.name})
 		}
 	default:
-		panic("not a loot value slice")
+		return wrapError(ErrInvalidCard, "not a loot card slice: %T", lc)
+	}
+	c.write(s)
+	return nil
+}
+
+// Show a player's hand to the player the engine is asking only.
+func (c *console) showHand(p *player, offset int) {
+	if c != nil && c.asking != nil {
+		defer c.privately(c.asking)()
+		if c.b != nil {
+			c.b.revealHand(p, c.asking)
+		}
 	}
-	writeToStdout(s)
+	c.showLootCards(p.Hand, p.Character.name, offset)
 }
 
-func showMonsterCards(monsters interface{}, offset int) {
+func (c *console) showMonsterCards(monsters interface{}, offset int) error {
 	var s = "Monsters, Curses, or Bonuses\n"
 	s += monsterCard{}.header()
 	switch monsters.(type) {
@@ -77,57 +130,61 @@ func showMonsterCards(monsters interface{}, offset int) {
 		cards := monsters.([]*monsterCard)
 		for i, m := range cards {
 			s += m.showCard(i + offset)
+			c.list(Option{Value: i + offset, Label: m.name})
 		}
 	case []monsterCard:
 		cards := monsters.([]monsterCard)
 		for i, m := range cards {
 			s += m.showCard(i + offset)
+			c.list(Option{Value: i + offset, Label: m.name})
 		}
 	default:
-		panic("not a monster value.")
+		return wrapError(ErrInvalidCard, "not a monster card slice: %T", monsters)
 	}
-
-	writeToStdout(s)
+	c.write(s)
+	return nil
 }
 
-func showPlayers(players interface{}, offset int) {
+func (c *console) showPlayers(players interface{}, offset int) error {
 	var s = fmt.Sprintf("Players\n%s", player{}.header())
 	switch players.(type) {
 	case []player:
 		ps := players.([]player)
 		for i, p := range ps {
-			s += fmt.Sprintf("\t%d\t%s\t%d\t%v\t%v\t%v\t\n",
-				i+offset, p.Character.name, p.Pennies, p.Souls, p.ActiveItems, p.PassiveItems)
+			s += p.showCard(i + offset)
+			c.list(Option{Value: i + offset, Label: p.Character.name})
 		}
 	case []*player:
 		ps := players.([]*player)
 		for i, p := range ps {
-			s += fmt.Sprintf("\t%d\t%s\t%d\t%v\t%v\t%v\t\n",
-				i+offset, p.Character.name, p.Pennies, p.Souls, p.ActiveItems, p.PassiveItems)
+			s += p.showCard(i + offset)
+			c.list(Option{Value: i + offset, Label: p.Character.name})
 		}
 	default:
-		panic("not a players type")
+		return wrapError(ErrInvalidTarget, "not a player slice: %T", players)
 	}
-	writeToStdout(s)
+	c.write(s)
+	return nil
 }
 
-func showSouls(souls []card, owner string, offset int) {
-	var s = fmt.Sprintf("Souls for %s\n\tIndex\tName\n", owner)
+func (c *console) showSouls(souls []soul, owner string, offset int) {
+	var s = fmt.Sprintf("Souls for %s\n\tIndex\tName\tValue\tOrigin\n", owner)
 	for i := range souls {
-		s += fmt.Sprintf("\t%d\t%s", i+offset, souls[i].getName())
+		s += fmt.Sprintf("\t%d\t%s\t%d\t%s\n", i+offset, souls[i].getName(), souls[i].value, souls[i].origin)
+		c.list(Option{Value: i + offset, Label: souls[i].getName()})
 	}
-	writeToStdout(s)
+	c.write(s)
 }
 
-func showSoulsByPlayer(souls []card, playerMap map[uint16]*player, offset int) {
+func (c *console) showSoulsByPlayer(souls []soul, playerMap map[uint16]*player, offset int) {
 	var s string
 	for i := range souls {
 		s += fmt.Sprintf("%s owned by %s\n", souls[i].getName(), playerMap[souls[i].getId()].Character.name)
 	}
-	writeToStdout(s)
+	c.write(s)
 }
 
-func showTreasureCards(items interface{}, owner string, offset int) {
+func (c *console) showTreasureCards(items interface{}, owner string, offset int) error {
 	var s = fmt.Sprintf("active Items for %s\n", owner)
 	s += treasureCard{}.header()
 	switch items.(type) {
@@ -135,38 +192,40 @@ func showTreasureCards(items interface{}, owner string, offset int) {
 		cards := items.([]*treasureCard)
 		for i, t := range cards {
 			s += t.showCard(i + offset)
+			c.list(Option{Value: i + offset, Label: t.name})
 		}
 	case []treasureCard:
 		cards := items.([]treasureCard)
 		for i, t := range cards {
 			s += t.showCard(i + offset)
+			c.list(Option{Value: i + offset, Label: t.name})
+		}
+	case []passiveItem:
+		for i, pi := range items.([]passiveItem) {
+			s += pi.showCard(i + offset)
+			c.list(Option{Value: i + offset, Label: pi.getName()})
 		}
 	default:
-		panic("not an itemCard value collection.")
+		return wrapError(ErrInvalidCard, "not an item card slice: %T", items)
 	}
-	writeToStdout(s)
+	c.write(s)
+	return nil
 }
 
 func (cc characterCard) header() string {
-	return "\tIndex\tName\thp\tap\tSoul Hearts\ttapped\n"
+	return "\tIndex\tName\thp\tap\ttapped\n"
 }
 
 func headerEventStack() string {
-	return "\tIndex\tName\tEvent Type\tTargeting\n"
+	return "\tIndex\tId\tName\tEvent Type\tController\tTargeting\n"
 }
 
 func (lc lootCard) header() string {
-	return fmt.Sprintf("\tIndex\tName\tTrinket\n")
+	return "\tIndex\tName\tTrinket\n"
 }
 
 func (mc monsterCard) header() string {
-	var s = fmt.Sprintf("\tIndex\tName")
-	if mc.baseHealth > 0 {
-		s = fmt.Sprintf("\t%s\t%s\t%s\t%s\n", "hp", "ap", "roll", "Reward")
-	} else {
-		s += "\n"
-	}
-	return s
+	return "\tIndex\tName\thp\tap\troll\n"
 }
 
 func (tc treasureCard) header() string {
@@ -174,25 +233,28 @@ func (tc treasureCard) header() string {
 }
 
 func (p player) header() string {
-	return "Players\n\tIndex\tCharacter\tHP\tAP\tPennies\tSouls\tActive Items\tPassive Items\n"
-
+	return "\tIndex\tCharacter\tHP\tAP\tPennies\tSouls\tHand\tActive Items\tPassive Items\n"
 }
 
+// Everything on a player's board is public, so is the row. Their hand is only counted.
 func (p player) showCard(idx int) string {
-	return fmt.Sprintf("\t%d\t%s\t%d\t%d\t%d\t%v\t%v\t%v",
-		idx, p.Character.name, p.Character.hp, p.Character.ap, p.Pennies, p.Souls, p.ActiveItems, p.PassiveItems)
+	active := make([]string, len(p.ActiveItems))
+	for i := range p.ActiveItems {
+		active[i] = p.ActiveItems[i].name
+	}
+	passive := make([]string, len(p.PassiveItems))
+	for i := range p.PassiveItems {
+		passive[i] = p.PassiveItems[i].getName()
+	}
+	return fmt.Sprintf("\t%d\t%s\t%d\t%d\t%d\t%d\t%d\t%s\t%s\n", idx, p.Character.name, p.Character.hp,
+		p.getAttack(), p.Pennies, p.soulValue(), len(p.Hand), strings.Join(active, ", "), strings.Join(passive, ", "))
 }
 
 func (cc characterCard) showCard(idx int) string {
-	return fmt.Sprintf("\t%d\t%s\t%d\t%d\t%t", idx, cc.name, cc.hp, cc.ap, cc.tapped)
+	return fmt.Sprintf("\t%d\t%s\t%d\t%d\t%t\n", idx, cc.name, cc.hp, cc.ap, cc.tapped)
 }
 
 func (lc lootCard) showCard(idx int) string {
-	var s = fmt.Sprintf("\t%d\t%s", idx, lc.name)
-	if lc.trinket {
-		s += fmt.Sprintf("\t%t\t%t\t%t\t%t", lc.eternal, false, false, true)
-	}
-	s += "\n"
 	return fmt.Sprintf("\t%d\t%s\t%t\n", idx, lc.name, lc.trinket)
 }
 
@@ -207,64 +269,33 @@ func (mc monsterCard) showCard(idx int) string {
 }
 
 func (tc treasureCard) showCard(idx int) string {
-	return fmt.Sprintf("\tc%d\tc%s\tc%tc\tc%tc\tc%tc\tc%tc\tc%tc\tc%d\n", idx, tc.name, tc.eternal, tc.active, tc.paid,
+	return fmt.Sprintf("\t%d\t%s\t%t\t%t\t%t\t%t\t%t\t%d\n", idx, tc.name, tc.eternal, tc.active, tc.paid,
 		tc.passive, tc.tapped, tc.counters)
 }
 
 func (en eventNode) showEvent(idx int) string {
-	var name, eventType string
-	value := en.event.e
-	switch value.(type) {
-	case activateEvent:
-		name, eventType = value.(activateEvent).c.getName(), "Activated effect"
-	case damageEvent:
-		name, eventType = value.(damageEvent).target.getName(), "Damage"
-	case deathOfCharacterEvent:
-		name, eventType = en.event.p.Character.name, "Dead Character"
-	case declareAttackEvent:
-		name, eventType = value.(declareAttackEvent).m.name, "Attack Monster"
-	case declarePurchaseEvent:
-		eventType = "Buy Item"
-	case intentionToAttackEvent:
-		eventType = "Starting Attack Phase"
-	case intentionToPurchaseEvent:
-		eventType = "Starting Purchase"
-	case lootCardEvent:
-		name, eventType = value.(lootCardEvent).l.name, "Hand Activation"
-	case paidItemEvent:
-		name, eventType = value.(paidItemEvent).t.name, "paid Item tapped"
-	case diceRollEvent:
-		eventType = fmt.Sprintf("Rolled %d", value.(diceRollEvent).n)
-	case triggeredEffectEvent:
-		name, eventType = value.(triggeredEffectEvent).c.getName(), "tapped effect"
+	node := en.view()
+	name := node.Source
+	if node.Kind == "Dice Roll" {
+		name = fmt.Sprintf("Rolled %d", node.Roll)
 	}
-	return fmt.Sprintf("\t%d\t%s\t%s\n", idx, name, eventType)
+	return fmt.Sprintf("\t%d\t%d\t%s\t%s\t%s\t%v\n", idx, node.Id, name, node.Kind, node.Controller, node.Targets)
 }
 
-// Reads the input from the command line and returns the player's choice.
-// decStatements: a message where each sentence is prepende with some auto-incremented positive id, starting from 1.
-// max: the user's input must be a number that falls in the range of [0, max)
-func readInput(min int, max int) int {
-	var choice int = -1
-	for choice < 0 {
-		var val int
-		fmt.Print("Enter id number -> ")
-		_, err := fmt.Scanf("%d", &val)
-		if err != nil {
-			panic(err)
-		}
-		if val < min || val > max {
-			fmt.Println("Not a valid target.")
-		} else {
-			choice = val
-		}
+// The event as an answer to pick it: "Rolled 4 (Isaac)", "Bomb! (Loot Card, Cain)"...
+func (en eventNode) label() string {
+	node := en.view()
+	if node.Kind == "Dice Roll" {
+		return fmt.Sprintf("Rolled %d (%s)", node.Roll, node.Controller)
+	} else if node.Source == "" {
+		return fmt.Sprintf("%s (%s)", node.Kind, node.Controller)
 	}
-	return choice
+	return fmt.Sprintf("%s (%s, %s)", node.Source, node.Kind, node.Controller)
 }
 
 func writeToStdout(s string) {
 	w := new(tabwriter.Writer)
 	defer w.Flush()
 	w.Init(os.Stdout, 8, 8, 1, '\t', 0)
-	_, _ = fmt.Fprintf(w, s)
+	_, _ = fmt.Fprint(w, s)
 }
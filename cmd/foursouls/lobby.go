@@ -0,0 +1,250 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ZeDespo/four_souls"
+)
+
+const lobbyHelp = `Commands:
+  list                                   list the tables
+  create <table> [players] [kickstarter] [plus]
+                                         open a table and sit at it
+  join <table>                           sit at a table
+  bot                                    seat a bot at your table
+  leave                                  leave your table
+  ready                                  start once every seat is taken and everyone is ready
+  watch <table>                          spectate the game at a table
+  quit                                   disconnect
+`
+
+// Run a lobby: players connect, open or join tables, fill seats with bots,
+// and play as many games at once as there are tables.
+// Like with serve, every connection starts with a session token: empty to enter the lobby,
+// the token of a seat to resume it, or "watch <table>" to spectate the game at a table.
+// With -http, games can also be created and played over the HTTP API.
+func lobby(args []string) error {
+	fs := flag.NewFlagSet("lobby", flag.ContinueOnError)
+	addr := fs.String("addr", ":7777", "the address to listen on")
+	policy, timeout := disconnectFlags(fs)
+	timeouts := timeoutFlags(fs)
+	delay := spectatorFlag(fs)
+	api := apiFlag(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	onDisconnect, err := four_souls.ParseDisconnectPolicy(*policy)
+	if err != nil {
+		return err
+	}
+	if err := serveAPI(*api); err != nil {
+		return err
+	}
+	ln, err := net.Listen("tcp", *addr)
+	if err != nil {
+		return err
+	}
+	defer ln.Close()
+	ls := &lobbyServer{l: four_souls.NewLobby(), s: newSessions(), base: four_souls.GameOptions{Players: 2},
+		policy: onDisconnect, timeout: *timeout, delay: *delay}
+	timeouts(&ls.base)
+	fmt.Printf("Lobby open on %s\n", ln.Addr())
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			return err
+		}
+		go func() {
+			defer conn.Close()
+			if err := ls.visit(conn); err != nil && err != io.EOF {
+				fmt.Printf("%s: %v\n", conn.RemoteAddr(), err)
+			}
+		}()
+	}
+}
+
+// A lobby, the seats of its games, and how its tables are set up.
+type lobbyServer struct {
+	l       *four_souls.Lobby
+	s       *sessions
+	base    four_souls.GameOptions // The options of a table, before those typed to create it
+	policy  four_souls.DisconnectPolicy
+	timeout time.Duration
+	delay   time.Duration // How far behind spectators watch
+}
+
+// Walk one connection through the lobby until their game ends or they quit.
+func (ls *lobbyServer) visit(conn net.Conn) error {
+	l, s := ls.l, ls.s
+	token, err := readToken(conn)
+	if err != nil {
+		return err
+	} else if name, ok := watching(token); ok {
+		return ls.watch(name, conn)
+	} else if token != "" {
+		if seat, table, ok := s.resume(token, conn); ok && table != nil {
+			return playAtTable(table, "", seat, conn)
+		}
+		_, _ = fmt.Fprintln(conn, "This isn't the token of a seat at a table.")
+		return nil
+	}
+	in := bufio.NewReader(conn)
+	readLine := func() (string, error) {
+		line, err := in.ReadString('\n')
+		return strings.TrimSpace(line), err
+	}
+	_, _ = fmt.Fprint(conn, "Welcome to the Four Souls lobby. Your name: ")
+	user, err := readLine()
+	if err != nil {
+		return err
+	}
+	_, _ = fmt.Fprint(conn, lobbyHelp)
+	var table *four_souls.Table
+	seat, err := four_souls.NewRemoteSeat(ls.policy, ls.timeout, time.Now().UnixNano())
+	if err != nil {
+		return err
+	}
+	seat.Attach(in, conn)
+	leave := func() {
+		if table != nil {
+			_ = l.Leave(table.Name(), user)
+			s.forget(seat.Token())
+		}
+	}
+	for {
+		_, _ = fmt.Fprint(conn, "> ")
+		line, err := readLine()
+		if err != nil {
+			leave()
+			return err
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		switch fields[0] {
+		case "list":
+			for _, ti := range l.Tables() {
+				status := fmt.Sprintf("%d open", ti.Open)
+				if ti.Started {
+					status = "playing"
+				}
+				_, _ = fmt.Fprintf(conn, "%-16s %d players  %-10s %s\n", ti.Name, ti.Options.Players, status,
+					strings.Join(ti.Seated, ", "))
+			}
+		case "create", "join":
+			if table != nil {
+				err = fmt.Errorf("you already sit at %q", table.Name())
+			} else if len(fields) < 2 {
+				err = fmt.Errorf("which table?")
+			} else if fields[0] == "create" {
+				if _, err = l.CreateTable(fields[1], tableOptions(ls.base, fields[2:])); err == nil {
+					table, err = l.Join(fields[1], user, seat)
+				}
+			} else {
+				table, err = l.Join(fields[1], user, seat)
+			}
+			if table != nil {
+				s.open(seat, conn, table)
+			}
+		case "bot", "leave", "ready":
+			if table == nil {
+				err = fmt.Errorf("you don't sit at a table")
+			} else if fields[0] == "bot" {
+				err = l.AddBot(table.Name())
+			} else if fields[0] == "leave" {
+				leave()
+				table = nil
+			} else if _, err = l.Ready(table.Name(), user); err == nil {
+				return playAtTable(table, user, seat, conn)
+			} else if _, closed := l.Table(table.Name()); closed != nil {
+				s.forget(seat.Token())
+				table = nil // The game couldn't be dealt, and the table was closed
+			}
+		case "watch":
+			if table != nil {
+				err = fmt.Errorf("you sit at %q", table.Name())
+			} else if len(fields) < 2 {
+				err = fmt.Errorf("which table?")
+			} else {
+				return ls.watch(fields[1], conn)
+			}
+		case "quit":
+			leave()
+			return nil
+		default:
+			_, _ = fmt.Fprint(conn, lobbyHelp)
+		}
+		if err != nil {
+			_, _ = fmt.Fprintln(conn, err)
+		}
+	}
+}
+
+// Once ready, the connection belongs to the game: the lobby doesn't read from it any more.
+// A user who resumes their seat has no name here: they were told who they play already.
+func playAtTable(table *four_souls.Table, user string, seat *four_souls.RemoteSeat, conn net.Conn) error {
+	if user != "" {
+		_, _ = fmt.Fprintln(conn, "Waiting for the table to fill up and everyone to be ready...")
+		<-table.Started()
+		character, _ := table.Character(user)
+		_, _ = fmt.Fprintf(conn, "The game at %s starts. You play %s.\nIf you get disconnected, resume with: foursouls join -token %s\n",
+			table.Name(), character, seat.Token())
+	}
+	winners, err := table.Wait()
+	if err != nil {
+		_, _ = fmt.Fprintf(conn, "The game stopped: %v\n", err)
+		return nil
+	}
+	_, _ = fmt.Fprintf(conn, "Winners: %s\n", strings.Join(winners, ", "))
+	return nil
+}
+
+// Spectate the game at the table until it ends, or the spectator leaves.
+// The connection isn't read from any more.
+func (ls *lobbyServer) watch(name string, conn net.Conn) error {
+	sp := four_souls.NewSpectator(conn, ls.delay)
+	table, err := ls.l.Watch(name, sp)
+	if err != nil {
+		sp.Close()
+		_, _ = fmt.Fprintln(conn, err)
+		return nil
+	}
+	_, _ = fmt.Fprintf(conn, "You are watching the game at %s, %s behind.\n", name, ls.delay)
+	select {
+	case <-table.Finished():
+		if winners, err := table.Wait(); err != nil {
+			sp.Show(nil, fmt.Sprintf("The game stopped: %v\n", err))
+		} else {
+			sp.Show(nil, fmt.Sprintf("Winners: %s\n", strings.Join(winners, ", ")))
+		}
+		sp.Close()
+	case <-sp.Gone(): // They left, or the table closed before its game started
+	}
+	<-sp.Gone()
+	return nil
+}
+
+// The options of a table, as typed after its name: the number of players and the expansions.
+func tableOptions(o four_souls.GameOptions, fields []string) four_souls.GameOptions {
+	for _, f := range fields {
+		switch f {
+		case "kickstarter":
+			o.Kickstarter = true
+		case "plus":
+			o.FourSoulsPlus = true
+		default:
+			if n, err := strconv.Atoi(f); err == nil && n > 0 && n < 256 {
+				o.Players = uint8(n)
+			}
+		}
+	}
+	return o
+}
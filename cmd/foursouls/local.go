@@ -0,0 +1,183 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ZeDespo/four_souls"
+)
+
+func play(args []string) error {
+	fs := flag.NewFlagSet("play", flag.ContinueOnError)
+	options := gameFlags(fs)
+	bots := fs.Int("bots", 0, "the number of seats played by bots")
+	tui := fs.Bool("tui", false, "play full-screen instead of line by line")
+	record := fs.String("record", "", "save the game to this file, to replay it later")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	b, err := four_souls.NewGameWithOptions(options())
+	if err != nil {
+		return err
+	}
+	var human four_souls.Decider
+	var t *four_souls.TUI
+	if *tui {
+		t = four_souls.NewTerminalTUI()
+		human = t
+	} else if len(b.Players())-*bots > 1 { // Players share the terminal: hand it off between them
+		human = four_souls.NewHotSeat(os.Stdin, os.Stdout)
+	} else {
+		human = four_souls.NewPromptDecider(os.Stdin, os.Stdout)
+	}
+	seats := four_souls.NewSeats()
+	if err = seatBots(b, seats, *bots, human); err != nil {
+		return err
+	}
+	recorder := four_souls.NewRecorder(seats)
+	var winners []string
+	if t != nil {
+		winners, err = t.Run(b, recorder)
+	} else {
+		b.SetDecider(recorder)
+		winners, err = b.Play()
+	}
+	if saveErr := saveLog(*record, recorder, b); saveErr != nil && err == nil {
+		err = saveErr
+	}
+	if err != nil {
+		return err
+	}
+	printWinners(winners)
+	return nil
+}
+
+// Stops a game that goes on for too many decisions.
+type capped struct {
+	four_souls.Decider
+	left int
+}
+
+func (c *capped) Decide(b *four_souls.Board, d four_souls.Decision) (int, error) {
+	if c.left <= 0 {
+		return 0, fmt.Errorf("%w: the game went on for too many decisions", four_souls.ErrUnfinished)
+	}
+	c.left -= 1
+	return c.Decider.Decide(b, d)
+}
+
+func sim(args []string) error {
+	fs := flag.NewFlagSet("sim", flag.ContinueOnError)
+	options := gameFlags(fs)
+	games := fs.Int("games", 10, "the number of games to play")
+	maxDecisions := fs.Int("max-decisions", 20000, "stop a game that takes more decisions than this")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	wins := make(map[string]int)
+	var unfinished, cappedGames int
+	for i := 0; i < *games; i++ {
+		o := options()
+		if o.Seed != 0 {
+			o.Seed += int64(i) // Each game its own deal, and the same games for the same seed
+		}
+		b, err := four_souls.NewGameWithOptions(o)
+		if err != nil {
+			unfinished += 1
+			fmt.Printf("Game %d (seed %d): %v\n", i+1, o.Seed, err)
+			continue
+		}
+		seats := four_souls.NewSeats()
+		if err = seatBots(b, seats, len(b.Players()), nil); err != nil {
+			return err
+		}
+		b.SetDecider(&capped{Decider: seats, left: *maxDecisions})
+		winners, err := b.Play()
+		if errors.Is(err, four_souls.ErrUnfinished) {
+			cappedGames += 1
+			fmt.Printf("Game %d (seed %d): capped after %d decisions\n", i+1, b.Options().Seed, *maxDecisions)
+			continue
+		} else if err != nil {
+			unfinished += 1
+			fmt.Printf("Game %d (seed %d): %v\n", i+1, b.Options().Seed, err)
+			continue
+		}
+		for _, w := range winners {
+			wins[w] += 1
+		}
+		fmt.Printf("Game %d (seed %d): %s won\n", i+1, b.Options().Seed, strings.Join(winners, ", "))
+	}
+	fmt.Printf("\n%d games, %d capped, %d unfinished\n", *games, cappedGames, unfinished)
+	for name, n := range wins {
+		fmt.Printf("%s\t%d\n", name, n)
+	}
+	return nil
+}
+
+// Shows the replayed game on the terminal, and waits for Enter before each decision is played back.
+type stepper struct {
+	*four_souls.Replayer
+	in   *bufio.Reader
+	auto bool
+}
+
+func (s *stepper) Show(b *four_souls.Board, str string) {
+	fmt.Print(str)
+}
+
+func (s *stepper) Decide(b *four_souls.Board, d four_souls.Decision) (int, error) {
+	n, err := s.Replayer.Decide(b, d)
+	if err != nil {
+		return n, err
+	}
+	done, total := s.Progress()
+	fmt.Printf("[%d/%d] %s chose %d", done, total, d.Player, n)
+	if s.auto {
+		fmt.Println()
+	} else if _, readErr := s.in.ReadString('\n'); readErr != nil {
+		s.auto = true // Nothing left to read: play the rest back without stopping
+	}
+	return n, nil
+}
+
+func replay(args []string) error {
+	fs := flag.NewFlagSet("replay", flag.ContinueOnError)
+	auto := fs.Bool("auto", false, "play the whole log back without waiting for Enter")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: foursouls replay [flags] <log>\n\nStep through a game saved with -record, one decision per Enter.")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return flag.ErrHelp
+	}
+	f, err := os.Open(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	gl, err := four_souls.LoadGameLog(f)
+	_ = f.Close()
+	if err != nil {
+		return fmt.Errorf("reading %s: %w", fs.Arg(0), err)
+	}
+	b, err := four_souls.NewGameWithOptions(gl.Options)
+	if err != nil {
+		return err
+	}
+	s := &stepper{in: bufio.NewReader(os.Stdin), auto: *auto}
+	s.Replayer = four_souls.NewReplayer(gl, s)
+	b.SetDecider(s)
+	winners, err := b.Play()
+	if err != nil {
+		return err
+	}
+	printWinners(winners)
+	return nil
+}
@@ -0,0 +1,126 @@
+// The foursouls command plays The Binding of Isaac: Four Souls.
+//
+// Usage:
+//
+//	foursouls play [flags]             play a game on this terminal
+//	foursouls serve [flags]            host a game for players who join over the network
+//	foursouls join [flags]             join a hosted game
+//	foursouls lobby [flags]            run a lobby where players set up and join games
+//	foursouls web [flags]              play in a browser
+//	foursouls sim [flags]              play games between bots and report who won
+//	foursouls replay [flags] <log>     step through a saved game
+//	foursouls cards [query]            look up cards by name or text
+//
+// Run "foursouls <command> -h" for the flags of a command.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ZeDespo/four_souls"
+)
+
+const usage = `usage: foursouls <command> [flags]
+
+commands:
+  play     play a game on this terminal
+  serve    host a game for players who join over the network
+  join     join a hosted game
+  lobby    run a lobby where players set up and join games
+  web      play in a browser
+  sim      play games between bots and report who won
+  replay   step through a saved game
+  cards    look up cards by name or text
+`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	commands := map[string]func([]string) error{
+		"play":   play,
+		"serve":  serve,
+		"join":   join,
+		"lobby":  lobby,
+		"web":    web,
+		"sim":    sim,
+		"replay": replay,
+		"cards":  cards,
+	}
+	run, ok := commands[os.Args[1]]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "foursouls: unknown command %q\n\n%s", os.Args[1], usage)
+		os.Exit(2)
+	}
+	if err := run(os.Args[2:]); err != nil {
+		if !errors.Is(err, flag.ErrHelp) {
+			fmt.Fprintln(os.Stderr, "foursouls:", err)
+		}
+		os.Exit(1)
+	}
+}
+
+// Add the flags every command that sets up a game takes.
+func gameFlags(fs *flag.FlagSet) func() four_souls.GameOptions {
+	players := fs.Uint("players", 2, "the number of players, from 2 to 4")
+	kickstarter := fs.Bool("kickstarter", false, "include the Kickstarter expansion's cards")
+	plus := fs.Bool("plus", false, "include the Four Souls+ expansion's cards")
+	seed := fs.Int64("seed", 0, "seed the shuffles and dice; 0 picks a seed from the clock")
+	return func() four_souls.GameOptions {
+		return four_souls.GameOptions{Players: uint8(*players), Kickstarter: *kickstarter, FourSoulsPlus: *plus, Seed: *seed}
+	}
+}
+
+// Seat the first players at the decider and bots at the last seats.
+func seatBots(b *four_souls.Board, seats *four_souls.Seats, bots int, human four_souls.Decider) error {
+	players := b.Players()
+	if bots < 0 || bots > len(players) {
+		return fmt.Errorf("%d bots can't sit at a game of %d players", bots, len(players))
+	}
+	humans := len(players) - bots
+	for i, name := range players {
+		if i < humans {
+			seats.Sit(name, human)
+		} else {
+			seats.Sit(name, four_souls.NewBot(b.Options().Seed+int64(i)))
+		}
+	}
+	return nil
+}
+
+// Save the game played so far, if asked to.
+func saveLog(path string, r *four_souls.Recorder, b *four_souls.Board) error {
+	if path == "" {
+		return nil
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err = r.Log(b).Save(f); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func printWinners(winners []string) {
+	fmt.Println("Winners:", strings.Join(winners, ", "))
+}
+
+func cards(args []string) error {
+	fs := flag.NewFlagSet("cards", flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: foursouls cards [query]\n\nPrint the cards whose name, kind or text matches the query.")
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	four_souls.ShowCards(strings.Join(fs.Args(), " "))
+	return nil
+}
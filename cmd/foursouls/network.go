@@ -0,0 +1,335 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ZeDespo/four_souls"
+)
+
+// Host a game. Each player who connects plays line by line, as on a terminal: the server
+// writes what the engine shows, and reads each answer as a number on its own line.
+// Every connection starts with a session token on its own line: an empty one takes a new seat,
+// and the token of a seat resumes it after a dropped connection. A connection that starts with
+// "watch" instead spectates the game, before or after it starts.
+// With -http, games can also be created and played over the HTTP API.
+func serve(args []string) error {
+	fs := flag.NewFlagSet("serve", flag.ContinueOnError)
+	options := gameFlags(fs)
+	addr := fs.String("addr", ":7777", "the address to listen on")
+	bots := fs.Int("bots", 0, "the number of seats played by bots")
+	record := fs.String("record", "", "save the game to this file, to replay it later")
+	policy, timeout := disconnectFlags(fs)
+	timeouts := timeoutFlags(fs)
+	delay := spectatorFlag(fs)
+	api := apiFlag(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	onDisconnect, err := four_souls.ParseDisconnectPolicy(*policy)
+	if err != nil {
+		return err
+	}
+	if err := serveAPI(*api); err != nil {
+		return err
+	}
+	o := options()
+	timeouts(&o)
+	b, err := four_souls.NewGameWithOptions(o)
+	if err != nil {
+		return err
+	}
+	players := b.Players()
+	if *bots < 0 || *bots > len(players) {
+		return fmt.Errorf("%d bots can't sit at a game of %d players", *bots, len(players))
+	}
+	ln, err := net.Listen("tcp", *addr)
+	if err != nil {
+		return err
+	}
+	defer ln.Close()
+	seats := four_souls.NewSeats()
+	sessions := newSessions()
+	defer sessions.closeAll()
+	fmt.Printf("Waiting for %d players on %s\n", len(players)-*bots, ln.Addr())
+	for i, name := range players {
+		if i >= len(players)-*bots {
+			seats.Sit(name, four_souls.NewBot(b.Options().Seed+int64(i)))
+			continue
+		}
+		var conn net.Conn
+		for conn == nil {
+			c, err := ln.Accept()
+			if err != nil {
+				return err
+			}
+			token, err := readToken(c)
+			if _, ok := watching(token); err == nil && ok {
+				sessions.watch(seats, c, *delay)
+				continue
+			} else if err != nil || token != "" {
+				_, _ = fmt.Fprintln(c, "The game hasn't started: there is no seat to resume.")
+				_ = c.Close()
+				return fmt.Errorf("%s didn't take a seat", c.RemoteAddr())
+			}
+			conn = c
+		}
+		seat, err := four_souls.NewRemoteSeat(onDisconnect, *timeout, b.Options().Seed+int64(i))
+		if err != nil {
+			return err
+		}
+		seat.Attach(conn, conn)
+		sessions.open(seat, conn, nil)
+		seats.Sit(name, seat)
+		fmt.Printf("%s joined as %s\n", conn.RemoteAddr(), name)
+		_, _ = fmt.Fprintf(conn, "You play %s. If you get disconnected, resume with: foursouls join -token %s\n",
+			name, seat.Token())
+	}
+	go sessions.resumeFrom(ln, seats, *delay)
+	recorder := four_souls.NewRecorder(seats)
+	b.SetDecider(recorder)
+	winners, err := b.Play()
+	if saveErr := saveLog(*record, recorder, b); saveErr != nil && err == nil {
+		err = saveErr
+	}
+	if err != nil {
+		sessions.broadcast("The game stopped: %v\n", err)
+		return err
+	}
+	sessions.broadcast("Winners: %s\n", strings.Join(winners, ", "))
+	printWinners(winners)
+	return nil
+}
+
+// Add the flags of what happens to the seat of a player who disconnects.
+func disconnectFlags(fs *flag.FlagSet) (*string, *time.Duration) {
+	policy := fs.String("on-disconnect", "wait", "what plays the seat of a player disconnected past the timeout: wait, auto-pass or bot")
+	timeout := fs.Duration("disconnect-timeout", 2*time.Minute, "how long to wait for a disconnected player to come back")
+	return policy, timeout
+}
+
+// Add the flags of how long players have to decide.
+func timeoutFlags(fs *flag.FlagSet) func(*four_souls.GameOptions) {
+	priority := fs.Duration("priority-timeout", four_souls.DefaultPriorityTimeout, "how long a player has to act or respond before passing; 0 for no limit")
+	choice := fs.Duration("choice-timeout", four_souls.DefaultChoiceTimeout, "how long a player has to make other decisions before the default is chosen; 0 for no limit")
+	return func(o *four_souls.GameOptions) {
+		o.PriorityTimeout, o.ChoiceTimeout = *priority, *choice
+	}
+}
+
+// Add the flag of how far behind the game spectators watch it.
+func spectatorFlag(fs *flag.FlagSet) *time.Duration {
+	return fs.Duration("spectator-delay", 0, "how long after it happens spectators are shown the game, so that a streamed hand can't be matched with it")
+}
+
+// Add the flag of the address of the HTTP API and the browser client, served next to the games on the socket.
+func apiFlag(fs *flag.FlagSet) *string {
+	return fs.String("http", "", "also serve the HTTP API and the browser client on this address, ex: :8080")
+}
+
+// Serve the HTTP API and the browser client in the background, if they have an address.
+func serveAPI(addr string) error {
+	if addr == "" {
+		return nil
+	}
+	handler, err := httpHandler()
+	if err != nil {
+		return err
+	}
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("HTTP API on %s\n", ln.Addr())
+	go func() {
+		if err := http.Serve(ln, handler); err != nil {
+			fmt.Printf("The HTTP API stopped: %v\n", err)
+		}
+	}()
+	return nil
+}
+
+// Whether the first line of a connection asks to watch a game, and which table's.
+func watching(token string) (string, bool) {
+	fields := strings.Fields(token)
+	if len(fields) == 0 || fields[0] != "watch" {
+		return "", false
+	}
+	return strings.Join(fields[1:], " "), true
+}
+
+// The first line of a connection: the session token it resumes, or nothing for a new seat.
+// It is read a byte at a time, so none of what follows is read with it.
+func readToken(conn net.Conn) (string, error) {
+	var line []byte
+	b := make([]byte, 1)
+	for len(line) < 128 {
+		if _, err := conn.Read(b); err != nil {
+			return "", err
+		}
+		if b[0] == '\n' {
+			break
+		}
+		line = append(line, b[0])
+	}
+	return strings.TrimSpace(string(line)), nil
+}
+
+// The remote seats of the games a server hosts, by session token: the connection each is played on,
+// and the lobby table of its game if there is one. And the spectators of a hosted game.
+type sessions struct {
+	mu         sync.Mutex
+	seats      map[string]*four_souls.RemoteSeat
+	conns      map[string]net.Conn
+	tables     map[string]*four_souls.Table
+	spectators []*four_souls.Spectator
+}
+
+func newSessions() *sessions {
+	return &sessions{seats: make(map[string]*four_souls.RemoteSeat), conns: make(map[string]net.Conn),
+		tables: make(map[string]*four_souls.Table)}
+}
+
+func (s *sessions) open(seat *four_souls.RemoteSeat, conn net.Conn, table *four_souls.Table) {
+	s.mu.Lock()
+	s.seats[seat.Token()], s.conns[seat.Token()], s.tables[seat.Token()] = seat, conn, table
+	s.mu.Unlock()
+}
+
+// The seat won't be played: its token resumes nothing.
+func (s *sessions) forget(token string) {
+	s.mu.Lock()
+	delete(s.seats, token)
+	delete(s.conns, token)
+	delete(s.tables, token)
+	s.mu.Unlock()
+}
+
+// Play the seat of the token on the connection, closing the one it was played on.
+func (s *sessions) resume(token string, conn net.Conn) (*four_souls.RemoteSeat, *four_souls.Table, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	seat, ok := s.seats[token]
+	if ok {
+		if old := s.conns[token]; old != nil {
+			_ = old.Close()
+		}
+		s.conns[token] = conn
+		seat.Attach(conn, conn)
+	}
+	return seat, s.tables[token], ok
+}
+
+// Let the connection spectate the game of the seats.
+func (s *sessions) watch(seats *four_souls.Seats, conn net.Conn, delay time.Duration) {
+	sp := four_souls.NewSpectator(conn, delay)
+	go func() {
+		<-sp.Gone()
+		_ = conn.Close()
+	}()
+	_, _ = fmt.Fprintf(conn, "You are watching the game, %s behind.\n", delay)
+	seats.Watch(sp)
+	s.mu.Lock()
+	s.spectators = append(s.spectators, sp)
+	s.mu.Unlock()
+	fmt.Printf("%s is watching\n", conn.RemoteAddr())
+}
+
+// Resume the seats of the players who connect again, and let others watch, until the listener closes.
+func (s *sessions) resumeFrom(ln net.Listener, seats *four_souls.Seats, delay time.Duration) {
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		go func() {
+			token, err := readToken(conn)
+			if _, ok := watching(token); err == nil && ok {
+				s.watch(seats, conn, delay)
+			} else if err != nil {
+				_ = conn.Close()
+			} else if _, _, ok := s.resume(token, conn); !ok {
+				_, _ = fmt.Fprintln(conn, "The game has started, and this isn't the token of one of its seats.")
+				_ = conn.Close()
+			} else {
+				fmt.Printf("%s resumed a seat\n", conn.RemoteAddr())
+			}
+		}()
+	}
+}
+
+func (s *sessions) broadcast(format string, a ...interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, conn := range s.conns {
+		_, _ = fmt.Fprintf(conn, format, a...)
+	}
+	for _, sp := range s.spectators {
+		sp.Show(nil, fmt.Sprintf(format, a...))
+	}
+}
+
+// Close the connections, once the spectators were shown the end of the game.
+func (s *sessions) closeAll() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, conn := range s.conns {
+		_ = conn.Close()
+	}
+	for _, sp := range s.spectators {
+		sp.Close()
+	}
+	for _, sp := range s.spectators {
+		<-sp.Gone()
+	}
+}
+
+// Join a hosted game, playing it on this terminal.
+func join(args []string) error {
+	fs := flag.NewFlagSet("join", flag.ContinueOnError)
+	addr := fs.String("addr", "localhost:7777", "the address of the server")
+	token := fs.String("token", "", "the session token of the seat to resume, after a dropped connection")
+	watch := fs.Bool("watch", false, "spectate the game instead of playing")
+	table := fs.String("table", "", "with -watch, the lobby table to spectate")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	first := *token
+	if *watch {
+		if *token != "" {
+			return fmt.Errorf("a spectator has no seat to resume")
+		}
+		first = strings.TrimSpace("watch " + *table)
+	}
+	conn, err := net.Dial("tcp", *addr)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if _, err := fmt.Fprintln(conn, first); err != nil {
+		return err
+	}
+	if *watch {
+		_, err = io.Copy(os.Stdout, conn) // Spectators only read
+		return err
+	}
+	go func() { // Send each line typed to the server
+		in := bufio.NewReader(os.Stdin)
+		for {
+			line, err := in.ReadString('\n')
+			if _, writeErr := io.WriteString(conn, line); writeErr != nil || err != nil {
+				return
+			}
+		}
+	}()
+	_, err = io.Copy(os.Stdout, conn) // Until the server closes the game
+	return err
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"embed"
+	"flag"
+	"fmt"
+	"io/fs"
+	"net"
+	"net/http"
+
+	"github.com/ZeDespo/four_souls"
+)
+
+// The browser client, built into the binary so that it runs offline.
+//
+//go:embed web
+var webClient embed.FS
+
+// The HTTP API, and the browser client that plays over it.
+func httpHandler() (http.Handler, error) {
+	assets, err := fs.Sub(webClient, "web")
+	if err != nil {
+		return nil, err
+	}
+	api := four_souls.NewAPI()
+	mux := http.NewServeMux()
+	mux.Handle("/games", api)
+	mux.Handle("/games/", api)
+	mux.Handle("/", http.FileServer(http.FS(assets)))
+	return mux, nil
+}
+
+// Play in a browser: serve the browser client, and the HTTP API its games are played over.
+// Players deal a game in the page and hand out the links to its other seats.
+func web(args []string) error {
+	fs := flag.NewFlagSet("web", flag.ContinueOnError)
+	addr := fs.String("addr", "localhost:8080", "the address to serve the browser client on")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	handler, err := httpHandler()
+	if err != nil {
+		return err
+	}
+	ln, err := net.Listen("tcp", *addr)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Open http://%s in a browser\n", ln.Addr())
+	return http.Serve(ln, handler)
+}
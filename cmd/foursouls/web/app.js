@@ -0,0 +1,440 @@
+"use strict";
+// The browser client of foursouls. It plays over the HTTP API: it polls the game, draws the board
+// as the player may see it, and answers the game's decisions with clicks on buttons or cards.
+// The page's address names the game and the seat: #game=1&token=... A game without a token is watched.
+
+const pollEvery = 500; // Milliseconds between two looks at the game
+const logLength = 400; // The number of lines of the log kept on the page
+
+const $ = (id) => document.getElementById(id);
+
+let session = null; // The game and the token of the seat played in this page
+let timer = null;
+let cursor = 0; // The next event of the log to fetch
+let drawn = ""; // The state and prompt last drawn, to only draw what changed
+let shownStack = []; // The event stack as it was last drawn
+let deadline = 0; // When the decision's default is answered for the player, in ms. 0 for never
+
+// Call the API, returning the JSON it answered. Errors are thrown with the API's message.
+async function call(method, path, body) {
+  const headers = {};
+  if (session && session.token) {
+    headers.Authorization = "Bearer " + session.token;
+  }
+  if (body !== undefined) {
+    headers["Content-Type"] = "application/json";
+  }
+  const resp = await fetch(path, { method, headers, body: body === undefined ? undefined : JSON.stringify(body) });
+  if (resp.status === 204) {
+    return null;
+  }
+  const data = await resp.json();
+  if (!resp.ok) {
+    throw new Error(data && data.error ? data.error : resp.statusText);
+  }
+  return data;
+}
+
+function el(tag, cls, text) {
+  const e = document.createElement(tag);
+  if (cls) {
+    e.className = cls;
+  }
+  if (text !== undefined) {
+    e.textContent = text;
+  }
+  return e;
+}
+
+function gamePath() {
+  return "/games/" + encodeURIComponent(session.game);
+}
+
+function seatHash(game, token) {
+  return "#game=" + encodeURIComponent(game) + (token ? "&token=" + encodeURIComponent(token) : "");
+}
+
+function readHash() {
+  const p = new URLSearchParams(location.hash.slice(1));
+  return p.get("game") ? { game: p.get("game"), token: p.get("token") || "" } : null;
+}
+
+// Setting up
+
+$("create").addEventListener("submit", async (e) => {
+  e.preventDefault();
+  const f = e.target.elements;
+  const options = {
+    players: +f.players.value,
+    kickstarter: f.kickstarter.checked,
+    four_souls_plus: f.plus.checked,
+    seed: f.seed.value ? +f.seed.value : 0,
+  };
+  try {
+    const created = await call("POST", "/games", { options, bots: +f.bots.value });
+    sessionStorage.setItem("seats-" + created.id, JSON.stringify(created.seats));
+    const first = created.seats.find((s) => s.token);
+    location.hash = seatHash(created.id, first ? first.token : "");
+  } catch (err) {
+    $("setup-error").textContent = err.message;
+  }
+});
+
+$("open").addEventListener("submit", (e) => {
+  e.preventDefault();
+  const f = e.target.elements;
+  location.hash = seatHash(f.game.value.trim(), f.token.value.trim());
+});
+
+window.addEventListener("hashchange", () => start(readHash()));
+
+function start(s) {
+  clearTimeout(timer);
+  session = s;
+  cursor = 0;
+  drawn = "";
+  shownStack = [];
+  $("log").textContent = "";
+  $("stack").textContent = "";
+  $("replay").hidden = true;
+  $("setup").hidden = !!s;
+  $("table").hidden = !s;
+  $("status").textContent = "";
+  if (s) {
+    drawSeats();
+    poll();
+  }
+}
+
+// Whoever dealt the game is given the links to its other seats, to hand them out or play them in other tabs.
+function drawSeats() {
+  const seats = JSON.parse(sessionStorage.getItem("seats-" + session.game) || "null");
+  $("seats-panel").hidden = !seats;
+  const ul = $("seats");
+  ul.textContent = "";
+  for (const s of seats || []) {
+    const li = el("li");
+    if (s.bot) {
+      li.textContent = s.player + " (bot)";
+    } else if (s.token === session.token) {
+      li.textContent = s.player + " (you)";
+    } else {
+      const a = el("a", "", s.player);
+      a.href = seatHash(session.game, s.token);
+      a.target = "_blank";
+      li.append(a);
+    }
+    ul.append(li);
+  }
+  const watch = el("a", "", "Link for spectators");
+  watch.href = seatHash(session.game, "");
+  watch.target = "_blank";
+  const li = el("li");
+  li.append(watch);
+  ul.append(li);
+}
+
+// Following the game
+
+async function poll() {
+  clearTimeout(timer);
+  const polled = session;
+  try {
+    const [state, prompt, log] = await Promise.all([
+      call("GET", gamePath()),
+      call("GET", gamePath() + "/actions"),
+      call("GET", gamePath() + "/events?since=" + cursor),
+    ]);
+    if (polled !== session) {
+      return; // Another game was opened meanwhile
+    }
+    drawLog(log);
+    const key = JSON.stringify([state, prompt]);
+    if (key !== drawn) {
+      drawn = key;
+      drawStatus(state);
+      drawBoard(state.board);
+      drawPrompt(state, prompt);
+    }
+    if (state.finished) {
+      return;
+    }
+  } catch (err) {
+    $("status").textContent = err.message;
+  }
+  timer = setTimeout(poll, pollEvery);
+}
+
+async function answer(seq, value) {
+  try {
+    await call("POST", gamePath() + "/decisions", { seq, answer: value });
+  } catch (err) {
+    $("status").textContent = err.message;
+  }
+  poll();
+}
+
+function drawStatus(state) {
+  let s = "Game " + state.id + ": " + (state.viewer ? "you play " + state.viewer : "watching");
+  if (state.finished) {
+    s += state.error ? ". The game stopped: " + state.error : ". Winners: " + (state.winners || []).join(", ");
+    $("replay").href = gamePath() + "/replay";
+    $("replay").hidden = false;
+  } else if (state.board.turn) {
+    s += ". " + state.board.turn + "'s turn";
+  }
+  $("status").textContent = s;
+}
+
+function drawLog(log) {
+  const div = $("log");
+  for (const e of log.events) {
+    div.append(el("div", e.private ? "private" : "", e.text));
+  }
+  while (div.childElementCount > logLength) {
+    div.firstElementChild.remove();
+  }
+  if (log.events.length) {
+    div.scrollTop = div.scrollHeight;
+  }
+  cursor = log.next;
+}
+
+// The board
+
+function card(cv, cls) {
+  const d = el("div", "card" + (cls ? " " + cls : ""));
+  d.dataset.name = cv.name;
+  d.dataset.kind = cv.kind;
+  d.title = cv.name + " (" + cv.kind + ")";
+  if (cv.tapped) {
+    d.classList.add("tapped");
+  }
+  d.append(el("div", "name", cv.name), el("div", "kind", cv.kind));
+  if (cv.counters) {
+    d.append(el("span", "overlay counters", cv.counters));
+  }
+  return d;
+}
+
+// A face down card. Named, it can be chosen like a card, ex: "Monster Deck".
+function cardBack(text, name) {
+  const d = el("div", "card back", text);
+  if (name) {
+    d.dataset.name = name;
+  }
+  return d;
+}
+
+function fill(id, children) {
+  const e = $(id);
+  e.textContent = "";
+  e.append(...children);
+}
+
+function drawBoard(board) {
+  fill("monsters", (board.monsters || []).map((m) => {
+    const zone = el("div", "zone");
+    const c = card(m.card);
+    c.append(
+      el("span", "overlay hp", m.health + "/" + m.max_health),
+      el("span", "overlay roll", m.roll + "+"),
+      el("span", "overlay ap", m.attack),
+    );
+    if (m.beneath > 0) {
+      c.append(el("span", "overlay beneath", "+" + m.beneath));
+      c.title += ", covering " + m.beneath;
+    }
+    zone.append(c, el("small", "", "Zone " + m.zone));
+    return zone;
+  }));
+  fill("shop", (board.shop || []).map((cv) => card(cv)));
+  fill("decks", (board.decks || []).map((d) => {
+    const deck = el("div", "deck");
+    deck.append(cardBack(d.name + " deck: " + d.size, d.name + " Deck"));
+    for (const cv of d.known || []) {
+      deck.append(card(cv, "small"));
+    }
+    const discard = d.discard || [];
+    deck.append(discard.length ? card(discard[0]) : cardBack("Discard: empty"));
+    return deck;
+  }));
+  fill("players", (board.players || []).map((p) => drawPlayer(p, board.viewer)));
+  drawStack(board.stack || []);
+}
+
+function drawPlayer(p, viewer) {
+  const div = el("div", "player");
+  div.classList.toggle("active", p.active);
+  div.classList.toggle("viewer", p.name === viewer);
+  div.append(el("h3", "", p.name));
+  const stats = el("div", "stats");
+  stats.append(
+    el("span", "", "HP " + p.health + "/" + p.max_health),
+    el("span", "", "AP " + p.attack),
+    el("span", "", p.pennies + "¢"),
+    el("span", "", "Souls " + p.soul_value + "/" + p.souls_to_win),
+    el("span", "", "Hand " + p.hand_size),
+  );
+  const table = el("div", "row");
+  table.append(card(p.character), ...(p.items || []).map((cv) => card(cv)),
+    ...(p.souls || []).map((cv) => card(cv)), ...(p.curses || []).map((cv) => card(cv)));
+  const hand = el("div", "row");
+  const seen = p.hand || [];
+  hand.append(...seen.map((cv) => card(cv)));
+  for (let i = seen.length; i < p.hand_size; i++) {
+    hand.append(cardBack("", ""));
+  }
+  div.append(stats, table, hand);
+  return div;
+}
+
+// The stack, top first. What resolved since it was last drawn slides away, what was pushed slides in,
+// and each new dice roll is rolled on screen.
+function drawStack(stack) {
+  const ol = $("stack");
+  ol.textContent = "";
+  const now = new Map(stack.map((n) => [n.id, n]));
+  const before = new Map(shownStack.map((n) => [n.id, n]));
+  for (const n of shownStack) {
+    if (!now.has(n.id)) {
+      const li = stackItem(n);
+      ol.append(li);
+      setTimeout(() => li.classList.add("resolving"), 20);
+      setTimeout(() => li.remove(), 700);
+    }
+  }
+  for (const n of stack) {
+    const li = stackItem(n);
+    const old = before.get(n.id);
+    if (!old) {
+      li.classList.add("pushed");
+    }
+    if (n.roll && (!old || old.roll !== n.roll)) {
+      rollDie(n.roll);
+    }
+    ol.append(li);
+  }
+  shownStack = stack;
+}
+
+function stackItem(n) {
+  const li = el("li", n.fizzled ? "fizzled" : "");
+  let text = n.kind;
+  if (n.source) {
+    text += ": " + n.source;
+  }
+  if (n.targets && n.targets.length) {
+    text += " → " + n.targets.join(", ");
+  }
+  if (n.controller) {
+    text += " (" + n.controller + ")";
+  }
+  if (n.roll) {
+    li.append(el("span", "roll", "🎲 " + n.roll));
+  }
+  li.append(text);
+  return li;
+}
+
+// The dice rolls waiting to be shown, one after the other.
+const rolls = [];
+
+function rollDie(n) {
+  rolls.push(n);
+  if (rolls.length === 1) {
+    tumble();
+  }
+}
+
+function tumble() {
+  const die = $("die");
+  const n = rolls[0];
+  let spins = 0;
+  die.hidden = false;
+  die.className = "rolling";
+  const spin = setInterval(() => {
+    die.textContent = 1 + Math.floor(Math.random() * 6);
+    if (++spins < 8) {
+      return;
+    }
+    clearInterval(spin);
+    die.textContent = n;
+    die.className = "landed";
+    setTimeout(() => {
+      rolls.shift();
+      if (rolls.length) {
+        tumble();
+      } else {
+        die.hidden = true;
+      }
+    }, 800);
+  }, 70);
+}
+
+// The decision
+
+function drawPrompt(state, p) {
+  const panel = $("prompt-panel");
+  const opts = $("options");
+  opts.textContent = "";
+  deadline = 0;
+  $("deadline").textContent = "";
+  panel.classList.add("waiting");
+  if (state.finished) {
+    $("prompt-text").textContent = "The game is over.";
+    return;
+  }
+  if (!p) {
+    $("prompt-text").textContent = "The bots are thinking...";
+    return;
+  }
+  if (!p.options || p.player !== state.viewer) {
+    $("prompt-text").textContent = "Waiting on " + p.player + "...";
+    return;
+  }
+  panel.classList.remove("waiting");
+  $("prompt-text").textContent = p.text;
+  if (p.deadline) {
+    deadline = Date.parse(p.deadline);
+    tick();
+  }
+  const claimed = new Set();
+  for (const o of p.options) {
+    const choose = () => answer(p.seq, o.value);
+    const b = el("button", o.value === p.default ? "default" : "", o.label.split(/\t+/).join(" · "));
+    b.addEventListener("click", choose);
+    opts.append(b);
+    const c = cardFor(o.label, claimed);
+    if (c) { // Clicking the card the answer is about answers too
+      claimed.add(c);
+      c.classList.add("choosable");
+      c.title += ": click to choose";
+      c.addEventListener("click", choose);
+    }
+  }
+}
+
+// The card on the board an answer names. The longest name wins, so that "Lil Battery" isn't taken
+// for "Battery"; among cards of the same name, the first one no other answer claimed.
+function cardFor(label, claimed) {
+  let best = null;
+  for (const c of document.querySelectorAll("#board [data-name]")) {
+    const name = c.dataset.name;
+    if (name && !claimed.has(c) && label.includes(name) && (!best || name.length > best.dataset.name.length)) {
+      best = c;
+    }
+  }
+  return best;
+}
+
+function tick() {
+  if (!deadline) {
+    return;
+  }
+  const left = Math.max(0, Math.round((deadline - Date.now()) / 1000));
+  $("deadline").textContent = left + "s left";
+}
+
+setInterval(tick, 1000);
+start(readHash());
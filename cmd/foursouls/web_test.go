@@ -0,0 +1,48 @@
+package main
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestWebClientIsServed(t *testing.T) {
+	handler, err := httpHandler()
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := httptest.NewServer(handler)
+	defer srv.Close()
+	for _, tc := range []struct {
+		path        string
+		status      int
+		contentType string // Part of the Content-Type: the system may name JavaScript's differently
+		body        string
+	}{
+		{"/", http.StatusOK, "text/html", "<script"},
+		{"/app.js", http.StatusOK, "javascript", "/games"},
+		{"/style.css", http.StatusOK, "text/css", "{"},
+		{"/games/42", http.StatusNotFound, "application/json", "error"}, // The API is served next to it
+	} {
+		resp, err := srv.Client().Get(srv.URL + tc.path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode != tc.status {
+			t.Errorf("%s: status %d, not %d", tc.path, resp.StatusCode, tc.status)
+		}
+		if ct := resp.Header.Get("Content-Type"); !strings.Contains(ct, tc.contentType) {
+			t.Errorf("%s should be served as %s, not %q", tc.path, tc.contentType, ct)
+		}
+		if !strings.Contains(string(body), tc.body) {
+			t.Errorf("%s should contain %q", tc.path, tc.body)
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package four_souls
+
+import "fmt"
+
+// The battle between the active player and the monster they are attacking.
+// A battle starts when the intention to attack resolves, then goes through rounds:
+// each round the attacking player rolls, and every player gets priority to respond
+// before the roll resolves. It ends when the monster leaves play, the attacking player
+// dies, the attack is cancelled or the turn ends.
+type combat struct {
+	p      *player      // The attacking player
+	m      *monsterCard // The monster being attacked
+	rounds uint8        // The number of attack rolls resolved so far
+}
+
+// A monster ability that reacts to the attacking player's roll.
+type rollReaction struct {
+	low, high uint8 // The range of attack rolls the ability reacts to
+	hit       bool  // The ability reacts to the rolls that hit the monster, instead of those that miss it
+	bonus     uint8 // Extra damage the monster deals when it's missed, or takes when it's hit
+	double    bool  // The monster deals double damage when it's missed
+	prevent   bool  // The monster takes no damage when it's hit
+}
+
+// The monsters whose damage depends on the attack roll that missed or hit them.
+var rollReactions = map[uint16]rollReaction{
+	carrionQueen:     {low: 0, high: 5, hit: true, prevent: true}, // Any damage but an attack roll of 6, which is 0
+	flaminHopper:     {low: 5, high: 6, hit: true, prevent: true},
+	gurglings:        {low: 1, high: 1, bonus: 1},
+	headlessHorseman: {low: 1, high: 1, bonus: 1},
+	horf:             {low: 2, high: 2, bonus: 1},
+	hush:             {low: 1, high: 1, double: true},
+	leaper:           {low: 1, high: 1, double: true},
+	mom:              {low: 1, high: 1, double: true},
+	momsHeart:        {low: 1, high: 2, double: true},
+	pin:              {low: 6, high: 6, hit: true, prevent: true},
+	polycephalus:     {low: 6, high: 6, hit: true, bonus: 1},
+}
+
+// The monster's ability that reacts to this roll, if it hit or missed the monster.
+func (mc monsterCard) rollReaction(roll uint8, hit bool) (rollReaction, bool) {
+	r, ok := rollReactions[mc.id]
+	return r, ok && r.hit == hit && roll >= r.low && roll <= r.high
+}
+
+// The damage a monster deals to the attacking player after missing them with this roll.
+func (mc monsterCard) missDamage(roll uint8) uint8 {
+	damage := mc.ap
+	if r, ok := mc.rollReaction(roll, false); ok {
+		damage += r.bonus
+		if r.double {
+			damage *= 2
+		}
+	}
+	return damage
+}
+
+// The damage a monster takes from n damage dealt on this roll. 0 is any damage not dealt by an attack roll.
+func (mc monsterCard) hitDamage(n uint8, roll uint8) uint8 {
+	if r, ok := mc.rollReaction(roll, true); ok {
+		if r.prevent {
+			return 0
+		}
+		n += r.bonus
+	}
+	return n
+}
+
+// Start a battle between the player and the monster.
+func (b *Board) startCombat(p *player, m *monsterCard) {
+	p.inBattle, m.inBattle = true, true
+	b.combat = &combat{p: p, m: m}
+}
+
+// Resolve one round of the battle: the attacking player's roll either hits the
+// monster or the monster hits back.
+func (b *Board) attackRound(p *player, m *monsterCard, roll uint8) {
+	if b.combat == nil || b.combat.m != m { // The battle is already over. Nothing to roll against
+		return
+	}
+	if !b.monster.isActive(m) || p.isDead() { // The monster left play some other way, ex: it was covered, or the player died
+		b.endCombat()
+		return
+	}
+	if roll >= m.roll { // successful hit
+		b.damagePlayerToMonster(p, m, p.getAttack(), roll)
+	} else { // missed
+		b.damageMonsterToPlayer(m, p, m.missDamage(roll), roll)
+	}
+	b.combat.rounds += 1
+	p.numAttackRolls += 1
+}
+
+// Whether the monster is on top of one of the monster zones.
+func (m mArea) isActive(mc *monsterCard) bool {
+	for _, am := range m.getActiveMonsters() {
+		if am == mc {
+			return true
+		}
+	}
+	return false
+}
+
+// End the battle in progress, if any. Neither the player nor the monster is in battle afterwards.
+func (b *Board) endCombat() {
+	if c := b.combat; c != nil {
+		c.p.inBattle, c.m.inBattle = false, false
+		b.combat = nil
+	}
+}
+
+// Cancel the attack in progress: every attack on the stack fizzles and the battle
+// ends without the monster dying.
+// An attack is only spent once its intention resolves, so one cancelled on the stack is never refunded.
+// attackAgain: if the attacking player may attack again this turn.
+func (b *Board) cancelAttack(attackAgain bool) error {
+	var cancelled bool
+	if b.eventStack.head != nil {
+		for curr := b.eventStack.head.top; curr != nil; curr = curr.next {
+			switch curr.event.e.(type) {
+			case intentionToAttackEvent, declareAttackEvent:
+				curr.event.e = fizzledEvent{}
+				cancelled = true
+			}
+		}
+	}
+	if c := b.combat; c != nil {
+		b.endCombat()
+		if attackAgain {
+			c.p.numAttacks += 1
+		}
+		cancelled = true
+	}
+	if !cancelled {
+		return wrapError(ErrNoEvent, "there is no attack to cancel")
+	}
+	return nil
+}
+
+// The player's choice to roll against the monster they are battling.
+func (c combat) String() string {
+	return fmt.Sprintf("Roll against %s (round %d)", c.m.name, c.rounds+1)
+}
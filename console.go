@@ -0,0 +1,440 @@
+package four_souls
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+)
+
+// A question the engine asks one player: pick a number between Min and Max.
+type Decision struct {
+	Player  string   // The character name of the player who decides
+	Text    string   // Everything shown since the last decision: the cards to choose from and the question
+	Min     int      // The smallest valid answer
+	Max     int      // The largest valid answer
+	Options []Option // One per valid answer, from Min to Max
+	Kind    DecisionKind
+	Default int           // The answer given for the player if they run out of time
+	Timeout time.Duration // How long the player has to answer. 0 for as long as they need
+}
+
+// What a decision is about, which sets how long a player has and what is answered for them.
+type DecisionKind uint8
+
+const (
+	Choice   DecisionKind = iota // A choice the game needs, like a target. Defaults to doing nothing if possible, or the first answer
+	Priority                     // A chance to act or respond. Defaults to passing
+	Discard                      // A card the player must discard from their hand. Defaults to a random one
+)
+
+func (dk DecisionKind) String() string {
+	switch dk {
+	case Priority:
+		return "Priority"
+	case Discard:
+		return "Discard"
+	default:
+		return "Choice"
+	}
+}
+
+// One valid answer to a decision.
+type Option struct {
+	Value int    `json:"value"`
+	Label string `json:"label"`           // What the answer does or picks, or the number if the engine didn't list it
+	Inert bool   `json:"inert,omitempty"` // The answer only shows the player something, and the game asks again
+}
+
+// The label of an answer.
+func (d Decision) label(n int) string {
+	for _, o := range d.Options {
+		if o.Value == n {
+			return o.Label
+		}
+	}
+	return strconv.Itoa(n)
+}
+
+// Plays the game for one or more players: a terminal, a bot, a remote client...
+// The engine shows everything through Show and asks for every choice through Decide.
+type Decider interface {
+	// Show the players something the engine printed: a table of cards, a message...
+	Show(b *Board, s string)
+	// Answer the decision. An error means the player can't answer anymore, ex: their input closed.
+	Decide(b *Board, d Decision) (int, error)
+}
+
+// A decider that can show something to one player only, like their hand or a peek at a deck.
+// Deciders that can't are shown everything.
+type PrivateDecider interface {
+	Decider
+	ShowTo(b *Board, player string, s string)
+}
+
+// Show something to one player only, if the decider can.
+func showTo(d Decider, b *Board, player string, s string) {
+	if pd, ok := d.(PrivateDecider); ok {
+		pd.ShowTo(b, player, s)
+	} else {
+		d.Show(b, s)
+	}
+}
+
+// Something shown to the players, and who it was for.
+type shown struct {
+	to   *player // nil if everyone may see it
+	text string
+}
+
+// The board's side of the conversation with its players.
+// Everything the engine prints and every number it asks for goes through the console,
+// to whichever Decider plays the game. Shared by the board, its players and the areas.
+// What is shown privately is kept until its player's next decision, so they get it with the question.
+// A nil console prompts on the standard input and output.
+type console struct {
+	b       *Board
+	d       Decider
+	asking  *player  // The player the engine is waiting on
+	whisper *player  // The player the console shows things to privately, if any
+	text    []shown  // Everything shown that isn't part of a decision yet
+	listed  []Option // The answers listed for the next decision
+	asked   int      // The number of decisions asked so far
+	err     error    // Why the players can't be asked anymore, once the decider failed
+}
+
+func newConsole(d Decider) *console {
+	return &console{d: d}
+}
+
+// The decider of the console, or a prompt on the standard input and output.
+func (c *console) decider() Decider {
+	if c == nil || c.d == nil {
+		return stdPrompt
+	}
+	return c.d
+}
+
+// The board the console belongs to, if any.
+func (c *console) board() *Board {
+	if c == nil {
+		return nil
+	}
+	return c.b
+}
+
+// The number of decisions asked so far.
+func (c *console) decisions() int {
+	if c == nil {
+		return 0
+	}
+	return c.asked
+}
+
+// Why the players can't be asked anymore, if the decider failed. The game has to stop.
+func (c *console) failed() error {
+	if c == nil {
+		return nil
+	}
+	return c.err
+}
+
+// Direct the next decisions to the player.
+func (c *console) ask(p *player) {
+	if c != nil {
+		c.asking = p
+	}
+}
+
+// Show only the player what is shown until the returned function is called, ex:
+// defer b.ui.privately(p)()
+func (c *console) privately(p *player) (done func()) {
+	if c == nil {
+		return func() {}
+	}
+	prev := c.whisper
+	c.whisper = p
+	return func() { c.whisper = prev }
+}
+
+// Show some text, aligning the columns of its tab separated tables.
+func (c *console) write(s string) {
+	var out bytes.Buffer
+	w := tabwriter.NewWriter(&out, 8, 8, 1, '\t', 0)
+	_, _ = fmt.Fprint(w, s)
+	_ = w.Flush()
+	if c != nil {
+		c.text = append(c.text, shown{to: c.whisper, text: out.String()})
+		if c.whisper != nil {
+			showTo(c.decider(), c.board(), c.whisper.Character.name, out.String())
+			return
+		}
+	}
+	c.decider().Show(c.board(), out.String())
+}
+
+// Take what the player is asked about out of what was shown: everything public,
+// and what was shown to them privately. What was shown privately to others is kept for them.
+func (c *console) takeText(p *player) string {
+	var sb strings.Builder
+	kept := c.text[:0]
+	for _, sh := range c.text {
+		if sh.to == nil || sh.to == p {
+			sb.WriteString(sh.text)
+		} else {
+			kept = append(kept, sh)
+		}
+	}
+	c.text = kept
+	return sb.String()
+}
+
+// Label an answer to the next decision, as it is listed: a card of a table, an action...
+func (c *console) list(o Option) {
+	if c != nil {
+		c.listed = append(c.listed, o)
+	}
+}
+
+// Print answers to the next decision numbered from first, and label them.
+func (c *console) menu(first int, labels ...string) {
+	for i, label := range labels {
+		c.offer(Option{Value: first + i, Label: label})
+	}
+}
+
+// Print an answer to the next decision, and label it.
+func (c *console) offer(o Option) {
+	c.Println(o.Value, ") ", o.Label)
+	c.list(o)
+}
+
+// One option for each answer in [min, max], labelled as the answers were listed.
+func (c *console) takeOptions(min, max int) []Option {
+	options := make([]Option, 0, max-min+1)
+	for i := min; i <= max; i++ {
+		options = append(options, Option{Value: i, Label: strconv.Itoa(i)})
+	}
+	if c != nil {
+		for _, o := range c.listed {
+			if o.Value >= min && o.Value <= max {
+				options[o.Value-min] = o
+			}
+		}
+		c.listed = c.listed[:0]
+	}
+	return options
+}
+
+func (c *console) Print(a ...interface{}) {
+	c.write(fmt.Sprint(a...))
+}
+
+func (c *console) Println(a ...interface{}) {
+	c.write(fmt.Sprintln(a...))
+}
+
+func (c *console) Printf(format string, a ...interface{}) {
+	c.write(fmt.Sprintf(format, a...))
+}
+
+// Ask the player the engine is waiting on for a number in [min, max].
+// Answers out of range are asked again. The first answer is given for them if they run out of time:
+// prompts that can do nothing use readChoice to say which answer does.
+func (c *console) readInput(min int, max int) int {
+	return c.read(Decision{Kind: Choice, Min: min, Max: max}, func(Decision) int { return min })
+}
+
+// Ask for a number in [min, max] like readInput, but answer def if the player runs out of time.
+func (c *console) readChoice(def int, min int, max int) int {
+	return c.read(Decision{Kind: Choice, Min: min, Max: max}, func(Decision) int { return def })
+}
+
+// Give the player priority: ask them to pick one of the actions in [0, max], where pass is the one to pass.
+func (c *console) readPriority(pass int, max int) int {
+	return c.read(Decision{Kind: Priority, Min: 0, Max: max}, func(Decision) int { return pass })
+}
+
+// Ask the player which of the n cards of their hand to discard.
+func (c *console) readDiscard(n int) int {
+	random := 0
+	if b := c.board(); b != nil && b.rng != nil && n > 0 {
+		random = b.rng.Intn(n)
+	}
+	return c.read(Decision{Kind: Discard, Min: 0, Max: n - 1}, func(Decision) int { return random })
+}
+
+// Ask the decision, with the default answer for it.
+// A decision without any answer isn't asked: its smallest answer is returned, and the caller should have checked.
+// If the player can't answer anymore, the default is answered for them and the console fails with
+// ErrInvalidInput, or with ErrUnfinished if the decider stopped the game: the game loop stops once
+// the action being taken is over. Nothing is asked after that.
+func (c *console) read(d Decision, def func(Decision) int) int {
+	min, max := d.Min, d.Max
+	if c != nil {
+		d.Text = c.takeText(c.asking)
+		if c.asking != nil {
+			d.Player = c.asking.Character.name
+		}
+	}
+	d.Options = c.takeOptions(min, max)
+	d.Default = def(d)
+	if max < min || c.failed() != nil {
+		return d.Default
+	}
+	if b := c.board(); b != nil {
+		d.Timeout = b.options.ChoiceTimeout
+		if d.Kind == Priority {
+			d.Timeout = b.options.PriorityTimeout
+		}
+	}
+	if c != nil {
+		c.asked += 1
+	}
+	for {
+		choice, err := c.decider().Decide(c.board(), d)
+		if err != nil {
+			if c != nil && errors.Is(err, ErrUnfinished) {
+				c.err = err
+			} else if c != nil {
+				c.err = wrapError(ErrInvalidInput, "%s", err)
+			}
+			return d.Default
+		} else if choice >= min && choice <= max {
+			return choice
+		}
+		c.decider().Show(c.board(), "Not a valid target.\n")
+	}
+}
+
+// Share the console with everything on the board that talks to the players.
+func (b *Board) setConsole(c *console) {
+	b.ui = c
+	if c != nil {
+		c.b = b
+	}
+	for i := range b.players {
+		b.players[i].ui = c
+	}
+	if b.loot != nil {
+		b.loot.ui = c
+	}
+	if b.monster != nil {
+		b.monster.ui = c
+	}
+	if b.treasure != nil {
+		b.treasure.ui = c
+	}
+}
+
+// Let the decider play the game: it is shown everything and makes every decision.
+func (b *Board) SetDecider(d Decider) {
+	c := b.ui
+	if c == nil {
+		c = newConsole(d)
+	}
+	c.d = d
+	b.setConsole(c) // The board may have been copied since the console was set
+}
+
+// A line by line prompt: shows everything as it comes and reads each answer as a number on its own line.
+// Typing "auto" instead turns auto-pass on or off for the player deciding.
+type promptDecider struct {
+	in    *bufio.Reader
+	out   io.Writer
+	once  sync.Once
+	lines chan promptLine // The lines read from in, once the first one was asked for
+	err   error           // Why in can't be read from anymore
+}
+
+type promptLine struct {
+	text string
+	err  error
+}
+
+// The decider used when none is set.
+var stdPrompt Decider = NewPromptDecider(os.Stdin, os.Stdout)
+
+// Make a decider that prompts on a plain text stream, like the standard input and output.
+func NewPromptDecider(in io.Reader, out io.Writer) Decider {
+	return &promptDecider{in: bufio.NewReader(in), out: out}
+}
+
+func (pd *promptDecider) Show(b *Board, s string) {
+	_, _ = fmt.Fprint(pd.out, s)
+}
+
+// Read the next line, unless the timeout comes first. The lines are read in the background
+// so that waiting on one can time out: a line typed after a decision timed out answers the next one.
+// return: the line, and false if the timeout came first.
+func (pd *promptDecider) readLine(timeout <-chan time.Time) (string, bool, error) {
+	pd.once.Do(func() {
+		pd.lines = make(chan promptLine, 1)
+		go func() {
+			for {
+				line, err := pd.in.ReadString('\n')
+				pd.lines <- promptLine{text: line, err: err}
+				if err != nil {
+					return
+				}
+			}
+		}()
+	})
+	if pd.err != nil {
+		return "", true, pd.err
+	}
+	select {
+	case l := <-pd.lines:
+		pd.err = l.err
+		return l.text, true, l.err
+	case <-timeout:
+		return "", false, nil
+	}
+}
+
+func (pd *promptDecider) Decide(b *Board, d Decision) (int, error) {
+	var timeout <-chan time.Time
+	if d.Timeout > 0 {
+		timer := time.NewTimer(d.Timeout)
+		defer timer.Stop()
+		timeout = timer.C
+		_, _ = fmt.Fprintf(pd.out, "You have %s to answer.\n", d.Timeout)
+	}
+	for {
+		_, _ = fmt.Fprint(pd.out, "Enter id number -> ")
+		line, inTime, err := pd.readLine(timeout)
+		if !inTime {
+			_, _ = fmt.Fprintf(pd.out, "\nTime's up: %s.\n", d.label(d.Default))
+			return d.Default, nil
+		}
+		line = strings.TrimSpace(line)
+		if n, convErr := strconv.Atoi(line); convErr == nil {
+			return n, nil
+		}
+		if err != nil {
+			return 0, fmt.Errorf("input closed while waiting for a choice: %w", err)
+		}
+		if line == "auto" && b != nil && d.Player != "" {
+			on := !b.AutoPass(d.Player)
+			if err := b.SetAutoPass(d.Player, on); err == nil {
+				_, _ = fmt.Fprintf(pd.out, "Auto-pass is %s: you are only given priority while you hold a response.\n", onOff(on))
+			}
+			continue
+		}
+		_, _ = fmt.Fprintln(pd.out, "Not a number.")
+	}
+}
+
+func onOff(on bool) string {
+	if on {
+		return "on"
+	}
+	return "off"
+}
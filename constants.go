@@ -10,8 +10,15 @@ const (
 	endActivePlayerTurn uint8 = 5
 	doNothing           uint8 = 6
 	peekTheresOptions   uint8 = 7
+	readCard            uint8 = 8
+	viewEffects         uint8 = 9
 	forceAttackDeck     int8  = -1
 	forceAttackMon      int8  = -2
+	lootPlaysPerTurn    int8  = 1  // Loot cards a player may play each turn
+	purchasesPerTurn    int8  = 1  // Items a player may buy each turn
+	attacksPerTurn      int8  = 1  // Monsters a player may attack each turn
+	shopCost            int8  = 10 // The price of an item in the shop
+	soulsToWin          uint8 = 4  // Souls a player needs to win the game
 )
 
 // !!! ID NUMBERS FOR THE CARDS!!! \\
@@ -514,46 +521,755 @@ const (
 // !!! CARD TEXT !!! \\
 
 //Generic Character card effects.
-const characterEffect string = "Play an additional lArea baseCard this turn.\n" +
+const characterEffect string = "Play an additional loot card this turn.\n" +
 	"This can be done on any player's turn in response to any action."
-const characterEdenEffect string = "Play an additional lArea baseCard this turn.\n" +
-	"When you start the game, look at the top 3 cards of the tArea deck." +
-	"Choose one, it becomes your Starting Item and gains eternal."
+const characterEdenEffect string = "Play an additional loot card this turn.\n" +
+	"When you start the game, look at the top 3 cards of the treasure deck. " +
+	"Choose one, it becomes your starting item and gains eternal."
 
 // Starting Items
-const foreverAloneDesc = "Choose One:\n" +
+const foreverAloneDesc = "Choose one:\n" +
 	"- Steal 1¢ from a player.\n" +
-	"- discardPile a lArea baseCard, then draw a lArea baseCard.\n" +
-	"When you take decreaseHP, recharge this."
-const sleightOfHandDesc = "Look at the top 3 cards of the deck. Put them back in any order."
-const theCurseDesc = "Put the top value of any discardPile pile on top of its deck."
-const theD6Desc = "Force a player to re-roll any Dice roll."
-const bookOfBelialDesc = "Add or subtractUint8 1 to any Dice roll."
-const lazarusRagsDesc = "Each time you die, after paying penalties: gain +1 tArea."
-const incubusDesc = "Choose One:\n" +
-	"- Look at a player's hand, you may switch a value from your hand with one of theirs.\n" +
-	"- lArea 1, then place a value from your hand on top of the lArea deck."
-const yumHeartDesc = "Prevent 1 decreaseHP dealt to any player or mArea."
-const bloodLustDesc = "Add +1 baseAttack to a player or mArea till the end of the turn."
-const theBoneDesc = "Put a Counter on this.\n" +
-	"Remove 1 Counter, add +1 to a Dice roll.\n" +
-	"Remove 2 counters. Deal 1 decreaseHP to a mArea or player.\n" +
-	"Remove 3 counters, this loses all abilities and becomes a Soul."
-const voidDesc = "Choose One:\n" +
-	"- discardPile your hand, then lArea equal to the number of cards discarded.\n" +
-	"- discardPile an active mArea that isn't being attacked or a tArea Item."
-const lordOfThePitDesc = "Cancel any numAttacks on a mArea. That player may numAttacks again this turn."
-const woodenNickelDesc = "Choose a player then roll:\n" +
-	"That player gains ¢ equal to the Dice roll."
-const holyMantleDesc = "If a player would die, prevent deathPenalty and end that player's turn."
-const darkArtsDesc = "When anyone rolls a 6, gain 3¢.\n" +
-	"Each time another player dies, lArea 2."
-const infestationDesc = "lArea 2, then discardPile 1 lArea baseCard."
-const gimpyDesc = "Each time you take decreaseHP, Choose 1:\n" +
-	"Gain +1 baseAttack.\n" +
-	"Gain 1¢.\n" +
-	"lArea 1, then discardPile a lArea baseCard."
-const bagOTrashDesc = "Pay 4¢ and Choose 1:\n" +
-	"lArea 1.\n" +
-	"Deal 1 decreaseHP to a mArea or player.\n" +
-	"Play an additional lArea baseCard this turn."
+	"- Look at the top card of any deck.\n" +
+	"- Discard a loot card, then loot 1.\n" +
+	"Each time you take damage, recharge this."
+const sleightOfHandDesc = "Look at the top 3 cards of any deck. Put them back in any order."
+const theCurseDesc = "Put the top card of any discard pile on top of its deck."
+const theD6Desc = "Force a player to reroll any dice roll."
+const bookOfBelialDesc = "Add or subtract 1 from any dice roll."
+const lazarusRagsDesc = "Each time you die, after paying penalties, gain +1 treasure."
+const incubusDesc = "Choose one:\n" +
+	"- Look at a player's hand. You may switch a card from your hand with one of theirs.\n" +
+	"- Loot 1, then put a card from your hand on top of the loot deck."
+const yumHeartDesc = "Prevent 1 damage dealt to any player or monster."
+const bloodLustDesc = "A player or monster gains +1 attack till the end of the turn."
+const theBoneDesc = "Put a counter on this.\n" +
+	"Remove 1 counter: Add 1 to a dice roll.\n" +
+	"Remove 2 counters: Deal 1 damage to a monster or player.\n" +
+	"Remove 3 counters: This loses all abilities and becomes a soul."
+const voidDesc = "Choose one:\n" +
+	"- Discard your hand, then loot equal to the number of cards discarded.\n" +
+	"- Discard an active monster that isn't being attacked, or a shop item."
+const lordOfThePitDesc = "Cancel an attack on a monster. That player may attack again this turn."
+const woodenNickelDesc = "Choose a player, then roll:\n" +
+	"That player gains cents equal to the number rolled."
+const holyMantleDesc = "If a player would die, prevent it. If it's their turn, end it."
+const darkArtsDesc = "Each time anyone rolls a 6, gain 3¢.\n" +
+	"Each time another player dies, loot 2."
+const infestationDesc = "Loot 2, then discard a loot card."
+const gimpyDesc = "Each time you take damage, choose one:\n" +
+	"- Gain +1 attack till the end of the turn.\n" +
+	"- Gain 1¢.\n" +
+	"- Loot 1, then discard a loot card."
+const bagOTrashDesc = "Pay 4¢, then choose one:\n" +
+	"- Loot 1.\n" +
+	"- Deal 1 damage to a monster or player.\n" +
+	"- Play an additional loot card this turn."
+
+// Loot Cards
+const aPennyDesc = "Gain 1¢."
+const twoCentsDesc = "Gain 2¢."
+const threeCentsDesc = "Gain 3¢."
+const fourCentsDesc = "Gain 4¢."
+const aNickelDesc = "Gain 5¢."
+const aDimeDesc = "Gain 10¢."
+const blankRuneDesc = "Roll:\n" +
+	"1: Each player gains 1¢.\n" +
+	"2: Each player loots 2.\n" +
+	"3: Each player takes 3 damage.\n" +
+	"4: Each player gains 4¢.\n" +
+	"5: Each player loots 5.\n" +
+	"6: Each player gains 6¢."
+const bombDesc = "Deal 1 damage to a monster or player."
+const butterBeanDesc = "Cancel the effect of any active item or loot card being played."
+const dagazDesc = "Choose one:\n" +
+	"- Destroy a curse.\n" +
+	"- Prevent 1 damage to any player."
+const diceShardDesc = "Reroll any dice roll."
+const ehwazDesc = "Put all active monsters that aren't being attacked into discard and replace them with the top cards of the monster deck."
+const goldBombDesc = "Deal 3 damage to a monster or player."
+const lilBatteryDesc = "Recharge an item."
+const lostSoulDesc = "This becomes a soul. Gain it."
+const megaBatteryDesc = "Recharge all items a player controls."
+const pillsBlueDesc = "Roll:\n" +
+	"1-2: Loot 2.\n" +
+	"3-4: Loot 4.\n" +
+	"5-6: Discard a loot card."
+const pillsRedDesc = "Roll:\n" +
+	"1-2: Gain +1 attack till the end of the turn.\n" +
+	"3-4: Gain +1 health till the end of the turn.\n" +
+	"5-6: Take 1 damage."
+const pillsYellowDesc = "Roll:\n" +
+	"1-2: Gain 4¢.\n" +
+	"3-4: Gain 7¢.\n" +
+	"5-6: Lose 8¢."
+const soulHeartDesc = "Prevent 1 damage to any player."
+const theFoolDesc = "End a player's turn. Cancel any effects or loot cards that haven't resolved."
+const theMagicianDesc = "Change the result of a dice roll to the number of your choosing."
+const theHighPriestessDesc = "Choose a player or monster, then roll:\n" +
+	"Deal damage to it equal to the number rolled."
+const theEmpressDesc = "A player gains +1 attack and adds +1 to all their dice rolls till the end of the turn."
+const theEmperorDesc = "Look at the top 5 cards of the monster deck. Put 4 on the bottom of the deck and 1 back on top."
+const theHierophantDesc = "Prevent up to 2 damage to a player or monster."
+const theLoversDesc = "A player gains +2 health till the end of the turn."
+const theChariotDesc = "A player gains +1 attack and +1 health till the end of the turn."
+const justiceDesc = "Choose a player. Gain loot and cents until you have as many as that player."
+const theHermitDesc = "Look at the top 5 cards of the treasure deck. Put 4 on the bottom of the deck and 1 back on top."
+const wheelOfFortuneDesc = "Roll:\n" +
+	"1: Gain 1¢.\n" +
+	"2: Take 2 damage.\n" +
+	"3: Loot 3.\n" +
+	"4: Lose 4¢.\n" +
+	"5: Gain 5¢.\n" +
+	"6: Gain +1 treasure."
+const strengthDesc = "A player gains +1 attack till the end of the turn. If it's their turn, they may attack an additional time."
+const theHangedManDesc = "Look at the top card of each deck. You may put any of them on the bottom of their decks. Then loot 2."
+const deathLootDesc = "Kill a player."
+const theTowerDesc = "Roll:\n" +
+	"1-2: Each player takes 1 damage.\n" +
+	"3-4: Each monster takes 1 damage.\n" +
+	"5-6: Each player takes 2 damage."
+const theDevilDesc = "Destroy an item you control: steal an item another player controls, or an item in the shop."
+const temperanceDesc = "Choose one:\n" +
+	"- Take 1 damage, then gain 4¢.\n" +
+	"- Take 2 damage, then gain 8¢."
+const theStarsDesc = "Gain +1 treasure."
+const theMoonDesc = "Look at the top 5 cards of the loot deck. Put 4 on the bottom of the deck and 1 back on top."
+const theSunDesc = "If it's your turn, take an additional turn after this one."
+const judgementDesc = "Choose the player with the most souls, or tied for the most. That player discards a soul card they control."
+const theWorldDesc = "Look at each player's hand, then loot 2."
+const bloodyPennyDesc = "Each time a player dies, loot 1."
+const brokenAnkhDesc = "Each time you die, roll:\n" +
+	"1-5: Nothing.\n" +
+	"6: Prevent death. If it's your turn, end it."
+const cainsEyeDesc = "At the start of your turn, look at the top card of the loot deck. You may put it on the bottom."
+const counterfeitPennyDesc = "Each time you gain cents, gain an additional 1¢."
+const curvedHornDesc = "Gain +1 attack for the first attack roll of your turn."
+const goldenHorseShoeDesc = "At the start of your turn, look at the top card of the treasure deck. You may put it on the bottom of the deck."
+const guppysHairballDesc = "Each time you take damage, roll:\n" +
+	"1-5: Nothing.\n" +
+	"6: Prevent 1 damage."
+const purpleHeartDesc = "At the start of your turn, look at the top card of the monster deck. You may put it on the bottom."
+const swallowedPennyDesc = "Each time you take damage, gain 1¢."
+const aSackDesc = "Loot 3."
+const chargedPennyDesc = "Gain 1¢, then recharge an item."
+const creditCardDesc = "The next item you buy this turn costs 0¢."
+const holyCardDesc = "The next time you would die this turn, prevent it. If it's your turn, end it."
+const jeraDesc = "For each loot card in your hand, loot 1."
+const jokerDesc = "Cancel any effect that targets you and choose a new target for it."
+const pillsPurpleDesc = "Roll:\n" +
+	"1-2: Recharge each of your items.\n" +
+	"3-4: Loot 2, then discard a loot card.\n" +
+	"5-6: Take 2 damage."
+const twoOfDiamondsDesc = "Double your cents."
+const cancerDesc = "Each time you would play a loot card that deals damage, it deals an additional 1 damage."
+const pinkEyeDesc = "Each time you deal damage to a player, roll:\n" +
+	"1-5: Nothing.\n" +
+	"6: Deal an additional 1 damage."
+const ansuzDesc = "Look at the top card of each deck. Gain one of them and put the rest back on top."
+const blackRuneDesc = "Roll:\n" +
+	"1-2: Deal 3 damage to each monster.\n" +
+	"3-4: Each player discards a loot card, then loots 1.\n" +
+	"5-6: Lose 4¢, then gain +1 treasure."
+const getOutOfJailDesc = "Cancel an attack. The attacking player may attack again this turn."
+const goldKeyDesc = "Gain 4¢. You may play an additional loot card this turn."
+const perthroDesc = "Destroy an item you control, then gain +1 treasure."
+const pillsBlackDesc = "Roll:\n" +
+	"1-2: Loot 3.\n" +
+	"3-4: Gain 5¢.\n" +
+	"5-6: Discard 2 loot cards."
+const pillsSpotsDesc = "Roll:\n" +
+	"1-2: A player gains +1 attack till the end of the turn.\n" +
+	"3-4: A player gains +1 health till the end of the turn.\n" +
+	"5-6: Each player takes 1 damage."
+const pillsWhiteDesc = "Roll:\n" +
+	"1-2: Each player loots 1.\n" +
+	"3-4: Each player gains 3¢.\n" +
+	"5-6: Each player takes 1 damage."
+const questionMarkCardDesc = "Use the effect of any active item in play as if you controlled it."
+const aaaBatteryDesc = "Each time you recharge an item, gain 1¢."
+const pokerChipDesc = "Each time you gain cents, roll:\n" +
+	"1-3: Gain that many cents again.\n" +
+	"4-6: Lose that many cents instead."
+const tapeWormDesc = "At the start of your turn, you may discard a loot card. If you do, loot 1."
+const theLeftHandDesc = "Each time you attack the monster deck, you may look at the top card first and put it on the bottom."
+
+// Monster Cards. Bonus cards and curses are prefixed with their card type.
+const bigSpiderDesc = "When this dies, you may attack the monster deck an additional time.\n" +
+	"Reward: Loot 1."
+const blackBonyDesc = "When this dies, it deals 1 damage to the player that killed it.\n" +
+	"Reward: Roll: Loot X."
+const boomFlyDesc = "When this dies, it deals 1 damage to each player.\n" +
+	"Reward: Gain 4¢."
+const clottyDesc = "Reward: Gain 4¢."
+const codWormDesc = "Reward: Gain 3¢."
+const conjoinedFattyDesc = "Reward: Loot 2."
+const dankGlobinDesc = "When this dies, force a player to discard 2 loot cards.\n" +
+	"Reward: Loot 2."
+const dingaDesc = "If this dies on an attack roll of 6, double its reward.\n" +
+	"Reward: Roll: Gain X¢."
+const dipDesc = "Reward: Gain 1¢."
+const dopleDesc = "Each time this takes damage, the player to your left takes the same amount.\n" +
+	"Reward: Gain 7¢."
+const evilTwinDesc = "Each time this takes damage, the player to your left takes the same amount.\n" +
+	"Reward: Gain +1 treasure."
+const fatBatDesc = "Reward: Gain +1 treasure."
+const fattyDesc = "Reward: Loot 1."
+const flyDesc = "Reward: Gain 1¢."
+const greedlingDesc = "When this dies, force a player to lose 7¢.\n" +
+	"Reward: Gain 7¢."
+const hangerDesc = "When this dies, add the top card of the treasure deck to the shop.\n" +
+	"Reward: Gain 7¢."
+const hopperDesc = "Prevent any damage dealt to this on an attack roll of 6.\n" +
+	"Reward: Gain 3¢."
+const horfDesc = "This deals 1 additional damage when the player attacking it rolls a 2.\n" +
+	"Reward: Gain 3¢."
+const keeperHeadDesc = "Each time this deals damage to a player, they also lose 2¢.\n" +
+	"Reward: Roll: Gain X¢."
+const leaperDesc = "This deals double damage when the player attacking it rolls a 1.\n" +
+	"Reward: Gain 5¢."
+const leechDesc = "Reward: Loot 1."
+const momsDeadHandDesc = "When this dies, you may steal an item from a player.\n" +
+	"Reward: Gain 4¢."
+const momsEyeDesc = "When this dies, you may look at a player's hand.\n" +
+	"Reward: Loot 1."
+const momsHandDesc = "When the attacking player rolls a 6, cancel the attack and end their turn.\n" +
+	"Reward: Gain 4¢."
+const mulliboomDesc = "When this dies, deal 3 damage to a player.\n" +
+	"Reward: Gain 6¢."
+const mulliganDesc = "When this dies, add a monster slot and fill it with the top card of the monster deck.\n" +
+	"Reward: Gain 3¢."
+const paleFattyDesc = "Reward: Gain 6¢."
+const pooterDesc = "Reward: Loot 1."
+const portalDesc = "When this dies, you must attack the monster deck an additional time.\n" +
+	"Reward: Gain 3¢."
+const psyHorfDesc = "When this dies, recharge each of your active items.\n" +
+	"Reward: Loot 1."
+const rageCreepDesc = "Each time this deals damage, it also deals that damage to the player to your right.\n" +
+	"Reward: Loot 2."
+const redHostDesc = "Reward: Gain 5¢."
+const ringOfFliesDesc = "When the attacking player rolls a 3, they steal a loot card at random from another player.\n" +
+	"Reward: Gain 3¢."
+const spiderDesc = "Reward: Loot 1."
+const squirtDesc = "Reward: Loot 1."
+const stoneyDesc = "This can't be attacked.\n" +
+	"Each other monster gets +1 to its dice roll while this is active.\n" +
+	"When another active monster dies, this dies.\n" +
+	"Reward: Loot 1."
+const swarmOfFliesDesc = "Each time the attacking player rolls a 5, they take 1 damage.\n" +
+	"Reward: Gain 5¢."
+const triteDesc = "Reward: Loot 2."
+const wizoobDesc = "When this dies, you may force a player to discard a soul card.\n" +
+	"Reward: Loot 3."
+const cursedFattyDesc = "When any player rolls a 5, they discard a loot card.\n" +
+	"Reward: Loot 2."
+const cursedGaperDesc = "When any player rolls a 4, each active monster gains +1 attack till the end of the turn.\n" +
+	"Reward: Gain 3¢."
+const cursedHorfDesc = "When any player rolls a 2, they take 2 damage.\n" +
+	"Reward: Gain 3¢."
+const cursedKeeperHeadDesc = "When any player rolls a 1, they lose 2¢.\n" +
+	"Reward: Roll: Gain X¢."
+const cursedMomsHandDesc = "When any player rolls a 6, end their turn.\n" +
+	"Reward: Gain 4¢."
+const cursedPsyHorfDesc = "Each time a player activates an item, they take 1 damage.\n" +
+	"Reward: Loot 2."
+const holyDingaDesc = "When any player rolls a 6, they heal 1 HP.\n" +
+	"Reward: Roll: Gain X¢."
+const holyDipDesc = "When any player rolls a 1, they gain 1¢.\n" +
+	"Reward: Gain 1¢."
+const holyKeeperHeadDesc = "When any player rolls a 4, they gain 2¢.\n" +
+	"Reward: Roll: Gain X¢."
+const holyMomsEyeDesc = "When any player rolls a 2, they may recharge an item.\n" +
+	"Reward: Loot 1."
+const holySquirtDesc = "When any player rolls a 5, they loot 1.\n" +
+	"Reward: Loot 2."
+const carrionQueenDesc = "Reward: Gain +1 treasure."
+const chubDesc = "Each time the attacking player rolls a 1, this heals 2 HP.\n" +
+	"Reward: Loot 2 and gain 3¢."
+const conquestDesc = "When this dies, the active player must attack an additional time this turn.\n" +
+	"Reward: Loot 2 and gain 3¢."
+const daddyLongLegsMonsterDesc = "Each time the attacking player rolls a 1, each monster gets +1 to its dice roll till the end of the turn.\n" +
+	"Reward: Gain 7¢."
+const darkOneDesc = "Each time this takes damage, it gains +1 attack till the end of the turn.\n" +
+	"Reward: Gain +1 treasure."
+const deathMonsterDesc = "When this dies, the active player must kill a player.\n" +
+	"Reward: Gain +1 treasure."
+const deliriumDesc = "Each time this is attacked, it gets +1 to its dice roll.\n" +
+	"Reward: Gain +2 treasure."
+const envyDesc = "When this dies, you must attack an additional time this turn.\n" +
+	"Reward: Gain 1¢."
+const famineDesc = "When this dies, the active player skips their next turn.\n" +
+	"Reward: Loot 2 and gain 3¢."
+const geminiDesc = "While this is at 1 HP, it has +1 attack.\n" +
+	"Reward: Gain 5¢."
+const gluttonyDesc = "When this takes damage on an attack roll of 6, it deals 1 damage to the player to your left.\n" +
+	"Reward: Loot 2."
+const greedMonsterDesc = "Each time this deals damage, each player loses 4¢.\n" +
+	"Reward: Gain 9¢."
+const gurdyJrDesc = "Each time the attacking player activates an item, they take 1 damage.\n" +
+	"Reward: Gain +1 treasure."
+const gurdyDesc = "Reward: Gain 7¢."
+const larryJrDesc = "While this is at 2 HP or lower, attack rolls against it get -1.\n" +
+	"Reward: Gain 6¢."
+const littleHornDesc = "Reward: Loot 2."
+const lustDesc = "Each time this takes damage from an attack, it deals 1 damage to the attacking player.\n" +
+	"Reward: Loot 2."
+const maskOfInfamyDesc = "While this is at 1 HP, it gets +2 to its dice roll.\n" +
+	"Reward: Loot 2."
+const megaFattyDesc = "Each time this deals damage, it heals 1 HP.\n" +
+	"Reward: Loot 2."
+const monstroDesc = "Reward: Gain 6¢."
+const peepDesc = "When this dies, search the monster deck for The Bloat and put it into an active slot, then shuffle the deck.\n" +
+	"Reward: Gain +1 treasure."
+const pestilenceDesc = "When this dies, deal 2 damage divided as you choose among any number of players and monsters.\n" +
+	"Reward: Loot 2 and gain 3¢."
+const pinDesc = "This takes no damage on an attack roll of 6.\n" +
+	"Reward: Gain 5¢."
+const prideDesc = "When this is attacked, you must force a player to discard 2 loot cards.\n" +
+	"Reward: Gain 5¢."
+const ragmanDesc = "When this dies, roll:\n" +
+	"1 or 6: Put this on top of the monster deck.\n" +
+	"Reward: Loot 3."
+const scolexDesc = "Each time this deals damage to a player, they also discard a loot card.\n" +
+	"Reward: Gain +1 treasure."
+const slothDesc = "When this dies, the player that killed it discards all loot cards in their hand.\n" +
+	"Reward: Gain 1¢."
+const theBloatDesc = "Each time this deals damage, it also deals 1 damage to each other player.\n" +
+	"Reward: Gain +1 treasure."
+const theDukeOfFliesDesc = "When this takes damage, roll:\n" +
+	"1: Prevent that damage.\n" +
+	"2-6: Nothing.\n" +
+	"Reward: Loot 2 and gain 3¢."
+const theHauntDesc = "When this takes 2 damage, the attacking player's dice rolls get -1 till the end of the attack.\n" +
+	"Reward: Gain +1 treasure."
+const warDesc = "Each time this takes damage, it gains +1 attack.\n" +
+	"Reward: Loot 2 and gain 3¢."
+const wrathDesc = "When this dies, roll:\n" +
+	"1-3: Each player takes 1 damage.\n" +
+	"4-6: Each player takes 2 damage.\n" +
+	"Reward: Loot 2 and gain 3¢."
+const momDesc = "This deals double damage on an attack roll of 1.\n" +
+	"When this dies, add a monster slot.\n" +
+	"This counts as 2 souls.\n" +
+	"Reward: Gain +1 treasure and 6¢."
+const satanDesc = "When the attacking player rolls a 6, they must kill a player of their choice.\n" +
+	"This counts as 2 souls.\n" +
+	"Reward: Gain +1 treasure and 6¢."
+const theLambDesc = "When this dies, you may force a player to give you a soul card.\n" +
+	"This counts as 2 souls.\n" +
+	"Reward: Gain 3¢."
+const ambushDesc = "Bonus\n" +
+	"You must attack the monster deck 2 times this turn."
+const chestDesc = "Bonus\n" +
+	"Roll:\n" +
+	"1-2: Gain 1¢.\n" +
+	"3-4: Gain 3¢.\n" +
+	"5-6: Gain 6¢."
+const cursedChestDesc = "Bonus\n" +
+	"Roll:\n" +
+	"1-3: Take 1 damage.\n" +
+	"4-5: Take 2 damage.\n" +
+	"6: Reveal cards from the top of the treasure deck until you reveal a Guppy item. Gain it, then shuffle the other revealed cards into the deck."
+const darkChestDesc = "Bonus\n" +
+	"Roll:\n" +
+	"1-2: Loot 1.\n" +
+	"3-4: Gain 3¢.\n" +
+	"5-6: Take 2 damage."
+const devilDealDesc = "Bonus\n" +
+	"Choose one:\n" +
+	"- Discard this.\n" +
+	"- Loot 2 and take 1 damage.\n" +
+	"- Search the treasure deck for a Guppy item, gain it and take 2 damage. Shuffle the deck."
+const goldChestDesc = "Bonus\n" +
+	"Roll:\n" +
+	"1-2: Gain +1 treasure.\n" +
+	"3-4: Gain 5¢.\n" +
+	"5-6: Gain 7¢."
+const greedHappeningDesc = "Bonus\n" +
+	"Choose the player with the most cents, or tied for the most. They lose all their cents."
+const iCanSeeForeverDesc = "Bonus\n" +
+	"Look at the top 6 cards of the loot deck and put them back in any order, then loot 1."
+const trollBombsDesc = "Bonus\n" +
+	"Take 2 damage."
+const megaTrollBombDesc = "Bonus\n" +
+	"Each player takes 2 damage."
+const secretRoomDesc = "Bonus\n" +
+	"Roll:\n" +
+	"1: Take 3 damage.\n" +
+	"2-3: Discard 2 loot cards.\n" +
+	"4-5: Gain 7¢.\n" +
+	"6: Gain +1 treasure."
+const shopUpgradeDesc = "Bonus\n" +
+	"Add 2 shop slots and fill them with the top cards of the treasure deck.\n" +
+	"You may attack an additional time this turn."
+const weNeedToGoDeeperDesc = "Bonus\n" +
+	"Put any number of cards from the monster discard pile on top of the monster deck.\n" +
+	"You may attack an additional time this turn."
+const xlFloorDesc = "Bonus\n" +
+	"Add a monster slot and fill it with the top card of the monster deck.\n" +
+	"You may attack an additional time this turn."
+const curseOfAmnesiaDesc = "Curse\n" +
+	"When revealed, give this to a player.\n" +
+	"At the end of your turn, discard 2 loot cards.\n" +
+	"When you die, discard this."
+const curseOfGreedDesc = "Curse\n" +
+	"When revealed, give this to a player.\n" +
+	"At the end of your turn, lose 4¢.\n" +
+	"When you die, discard this."
+const curseOfLossDesc = "Curse\n" +
+	"When revealed, give this to a player.\n" +
+	"You need 5 souls to win.\n" +
+	"When you die, discard this."
+const curseOfPainDesc = "Curse\n" +
+	"When revealed, give this to a player.\n" +
+	"At the start of your turn, take 1 damage.\n" +
+	"When you die, discard this."
+const curseOfTheBlindDesc = "Curse\n" +
+	"When revealed, give this to a player.\n" +
+	"Each monster you attack gets +1 to its dice roll.\n" +
+	"When you die, discard this."
+const begottenDesc = "This can't be attacked while another monster is active.\n" +
+	"Reward: Loot 2."
+const boilDesc = "Each time this takes damage, it gains +1 attack.\n" +
+	"Reward: Gain 4¢."
+const chargerDesc = "Reward: Loot 1 and gain 2¢."
+const deathsHeadDesc = "This can't be attacked. At the end of each turn, it takes 1 damage.\n" +
+	"Reward: Gain 5¢."
+const gaperDesc = "Reward: Gain 3¢."
+const impDesc = "When this dies, you may look at a player's hand and steal a loot card.\n" +
+	"Reward: Loot 1."
+const knightDesc = "Each time this takes damage, roll:\n" +
+	"1-3: Prevent that damage.\n" +
+	"Reward: Gain +1 treasure."
+const parabiteDesc = "When the attacking player rolls a 1, this heals 1 HP.\n" +
+	"Reward: Loot 1."
+const raglingDesc = "When this dies, roll:\n" +
+	"1-3: Put this on top of the monster deck.\n" +
+	"Reward: Gain 4¢."
+const roundWormDesc = "Each time this deals damage, the damaged player discards a loot card.\n" +
+	"Reward: Loot 2."
+const fistulaDesc = "When this dies, fill each empty monster slot with the top card of the monster deck.\n" +
+	"Reward: Gain 5¢."
+const gurglingsDesc = "This deals 1 additional damage on an attack roll of 1.\n" +
+	"Reward: Loot 2 and gain 2¢."
+const polycephalusDesc = "When this takes damage on an attack roll of 6, it takes 1 additional damage.\n" +
+	"Reward: Gain 8¢."
+const stevenDesc = "Each time this takes damage, each other player takes 1 damage.\n" +
+	"Reward: Gain +1 treasure."
+const theCageDesc = "Each time this takes damage, it deals 1 damage to the attacking player.\n" +
+	"Reward: Gain +1 treasure and 5¢."
+const hushDesc = "This deals double damage on an attack roll of 1.\n" +
+	"This counts as 2 souls.\n" +
+	"Reward: Gain +1 treasure and 6¢."
+const iAmErrorDesc = "Bonus\n" +
+	"The active player gives the player to their right a loot card, then ends their turn."
+const trapDoorDesc = "Bonus\n" +
+	"Put this on the bottom of the monster deck. You must attack the monster deck an additional time."
+const curseOfFatigueDesc = "Curse\n" +
+	"When revealed, give this to a player.\n" +
+	"You can't activate your character card.\n" +
+	"When you die, discard this."
+const curseOfTinyHandsDesc = "Curse\n" +
+	"When revealed, give this to a player.\n" +
+	"You may play 1 less loot card each turn.\n" +
+	"When you die, discard this."
+const bonyDesc = "Reward: Gain 3¢."
+const brainDesc = "When this dies, each player loots 1.\n" +
+	"Reward: Gain 3¢."
+const flaminHopperDesc = "Prevent any damage dealt to this on an attack roll of 5 or 6.\n" +
+	"Reward: Loot 1."
+const globinDesc = "When this dies, roll:\n" +
+	"1-2: Put this on top of the monster deck.\n" +
+	"Reward: Gain 6¢."
+const nerveEndingDesc = "This can't be killed by damage from items.\n" +
+	"Reward: Loot 2."
+const roundyDesc = "Reward: Gain +1 treasure."
+const suckerDesc = "When this dies, it deals 1 damage to each player.\n" +
+	"Reward: Loot 1."
+const swarmerDesc = "Each time this deals damage, the damaged player loses 2¢.\n" +
+	"Reward: Gain 6¢."
+const tumorDesc = "When this dies, put the top card of the monster deck into its slot.\n" +
+	"Reward: Loot 2."
+const cursedGlobinDesc = "When any player rolls a 1, each monster heals 1 HP.\n" +
+	"Reward: Gain 5¢."
+const cursedTumorDesc = "When any player rolls a 6, they take 1 damage.\n" +
+	"Reward: Loot 2."
+const holyBonyDesc = "When any player rolls a 3, they gain 3¢.\n" +
+	"Reward: Gain 2¢."
+const holyMulliganDesc = "When any player rolls a 6, they may recharge an item.\n" +
+	"Reward: Loot 1."
+const blastocystDesc = "When this dies, add a monster slot and fill it with the top card of the monster deck.\n" +
+	"Reward: Gain +1 treasure."
+const dingleDesc = "When this deals damage, roll:\n" +
+	"1-2: It heals 1 HP.\n" +
+	"Reward: Gain 6¢."
+const headlessHorsemanDesc = "When the attacking player rolls a 1, they take 1 additional damage.\n" +
+	"Reward: Gain +1 treasure."
+const krampusDesc = "When this dies, each player discards a loot card.\n" +
+	"Reward: Gain +1 treasure."
+const monstroIIDesc = "Each time this deals damage, each player takes 1 damage.\n" +
+	"Reward: Loot 2 and gain 5¢."
+const theFallenDesc = "When this dies, force a player to destroy an item they control.\n" +
+	"Reward: Gain +1 treasure."
+const widowDesc = "Each time this deals damage to a player, they discard a loot card.\n" +
+	"Reward: Gain 6¢."
+const isaacMonsterDesc = "When this dies, each player loots 1.\n" +
+	"This counts as 2 souls.\n" +
+	"Reward: Gain +1 treasure and 6¢."
+const momsHeartDesc = "This deals double damage on an attack roll of 1 or 2.\n" +
+	"This counts as 2 souls.\n" +
+	"Reward: Gain +1 treasure and 6¢."
+const angelRoomDesc = "Bonus\n" +
+	"Each player may destroy an item they control. Each player who does gains +1 treasure."
+const bossRushDesc = "Bonus\n" +
+	"You must attack a boss in play, or the monster deck, an additional time this turn."
+const headTraumaDesc = "Bonus\n" +
+	"Each player takes 1 damage, then loots 1."
+const holyChestDesc = "Bonus\n" +
+	"Roll:\n" +
+	"1-3: Prevent the next damage you would take this turn.\n" +
+	"4-6: Gain +1 treasure."
+const spikedChestDesc = "Bonus\n" +
+	"Roll:\n" +
+	"1-2: Take 1 damage.\n" +
+	"3-4: Gain 5¢.\n" +
+	"5-6: Loot 3."
+const curseOfBloodLustDesc = "Curse\n" +
+	"When revealed, give this to a player.\n" +
+	"Each time you deal damage to a monster, take 1 damage.\n" +
+	"When you die, discard this."
+const curseOfImpulseDesc = "Curse\n" +
+	"When revealed, give this to a player.\n" +
+	"At the start of your turn, you must attack the monster deck.\n" +
+	"When you die, discard this."
+
+// Treasure Cards
+const blankCardDesc = "Double the effect of the next loot card you play."
+const bookOfSinDesc = "Roll:\n" +
+	"1-2: Gain 1¢.\n" +
+	"3-4: Loot 1.\n" +
+	"5-6: Gain +1 health till the end of the turn."
+const boomerangDesc = "Steal a loot card at random from a player."
+const boxDesc = "Destroy this: You may play any number of additional loot cards this turn."
+const bumFriendDesc = "Loot 1, then put a loot card from your hand on top of the loot deck."
+const chaosDesc = "Each player gives all of their non-eternal items to the player to their left."
+const chaosCardDesc = "Destroy this: Destroy any monster, player, item or soul card."
+const compostDesc = "The next time a player would loot, they take the top card of the loot discard pile instead."
+const crystalBallDesc = "Before a dice roll, say a number. If the result is that number, loot 3."
+const decoyDesc = "Swap this with a non-eternal item another player controls."
+const diplopiaDesc = "This becomes a copy of any non-eternal passive item in play till the end of the turn."
+const flushDesc = "Choose one:\n" +
+	"- Put all monsters that aren't being attacked on the bottom of the monster deck.\n" +
+	"- Put all shop items on the bottom of the treasure deck."
+const glassCannonDesc = "Destroy another item in play, then roll:\n" +
+	"1-5: Destroy this and loot 2.\n" +
+	"6: Recharge this."
+const godheadDesc = "Change the result of a dice roll to a 1 or a 6."
+const guppysHeadDesc = "A player gives you a loot card of their choice from their hand."
+const guppysPawDesc = "Take 1 damage: Prevent up to 2 damage dealt to a player."
+const hostHatDesc = "Prevent 1 damage dealt to you. If you do, deal 1 damage to another player."
+const jawboneDesc = "Steal 3¢ from another player."
+const luckyFootDesc = "Add up to 2 to any dice roll that isn't an attack roll."
+const miniMushDesc = "Subtract up to 2 from any dice roll."
+const modelingClayDesc = "This becomes a copy of any non-eternal item in play. This change is permanent."
+const momsBraDesc = "Reduce the damage dealt to a player or monster to 1."
+const momsShovelDesc = "This enters play deactivated.\n" +
+	"Destroy this: Steal a soul card from a player."
+const monsterManualDesc = "Force the active player to attack. You choose what they attack."
+const mrBoomDesc = "Deal 1 damage to a monster."
+const mysterySackDesc = "Roll:\n" +
+	"1-2: Loot 1.\n" +
+	"3-4: Gain 4¢.\n" +
+	"5-6: Nothing."
+const noDesc = "Cancel the effect of an active item being activated."
+const pandorasBoxDesc = "Destroy this, then roll:\n" +
+	"1: Gain 1¢.\n" +
+	"2: Gain 6¢.\n" +
+	"3: Kill a monster.\n" +
+	"4: Loot 3.\n" +
+	"5: Gain 9¢.\n" +
+	"6: This becomes a soul. Gain it."
+const placeboDesc = "Copy the activated effect of any non-eternal item in play."
+const potatoPeelerDesc = "Put the top card of each deck into its discard pile."
+const razorBladeDesc = "Deal 1 damage to another player."
+const remoteDetonatorDesc = "Each player votes on an item in play. Destroy the item with the most votes. If there is a tie, cancel this."
+const sackHeadDesc = "Look at the top card of any deck. You may put it on the bottom of that deck."
+const sackOfPenniesDesc = "Gain 1¢.\n" +
+	"Each time anyone rolls a 1, you may recharge this."
+const spoonBenderDesc = "Add 1 to any dice roll."
+const theBatteryDesc = "Recharge another item."
+const theD4Desc = "Destroy this: A player destroys all items they control, then gains that many treasure."
+const theD20Desc = "Destroy an item in play and replace it with the top card of the treasure deck."
+const theD100Desc = "Roll:\n" +
+	"1: Loot 1.\n" +
+	"2: Loot 2.\n" +
+	"3: Gain 3¢.\n" +
+	"4: Gain 4¢.\n" +
+	"5: Gain +1 health till the end of the turn.\n" +
+	"6: Gain +1 attack till the end of the turn."
+const theShovelDesc = "Put a card from the monster discard pile on top of the monster deck."
+const twoOfClubsDesc = "Double the number of loot cards a player would draw till the end of the turn."
+const batteryBumDesc = "Pay 4¢: Recharge an item."
+const contractFromBelowDesc = "Destroy 2 items you control: Steal an item from any player."
+const donationMachineDesc = "Give one of your other items to another player: Gain 8¢."
+const goldenRazorBladeDesc = "Pay 5¢: Deal 1 damage to a monster or player."
+const payToPlayDesc = "Pay 10¢: Steal an item from any player."
+const portableSlotMachineDesc = "Pay 3¢, then roll:\n" +
+	"1-2: Loot 1.\n" +
+	"3-4: Gain 4¢.\n" +
+	"5-6: Nothing."
+const smelterDesc = "Discard a loot card: Gain 3¢."
+const thePoopDesc = "Each time you take damage, put a counter on this.\n" +
+	"Remove a counter: Prevent 1 damage dealt to you."
+const techXDesc = "Put a counter on this.\n" +
+	"Remove 3 counters: Kill a player or monster."
+const babyHauntDesc = "Each monster you attack gets +1 to its dice roll.\n" +
+	"When you die, before paying penalties, give this to another player."
+const bellyButtonDesc = "You may play an additional loot card on your turn.\n" +
+	"Each time you take damage, you may recharge your character card."
+const bobsBrainDesc = "When you start an attack, roll:\n" +
+	"1-2: Deal 1 damage to an active monster.\n" +
+	"3-4: Deal 1 damage to a player.\n" +
+	"5-6: Deal 1 damage to yourself."
+const breakfastDesc = "+1 health."
+const brimstoneDesc = "+1 attack.\n" +
+	"Each time you deal damage to a monster, also deal 1 damage to another player."
+const bumboDesc = "Each time you would gain cents, put that many counters on this instead.\n" +
+	"1+ counters: Add 2 to your first attack roll each turn.\n" +
+	"10+ counters: +1 attack.\n" +
+	"25+ counters: You may attack any number of times each turn."
+const cambionConceptionDesc = "Each time you take damage, put a counter on this.\n" +
+	"When this has 6 counters, remove them and gain +1 treasure."
+const championBeltDesc = "+1 attack for the first attack roll of your turn.\n" +
+	"You may attack an additional time each turn."
+const chargedBabyDesc = "Each time anyone rolls a 2, you may recharge an item."
+const cheeseGraterDesc = "Each time anyone rolls a 6, reveal the top card of any deck. You may discard it or put it back on top."
+const curseOfTheTowerDesc = "Each time you take damage, roll:\n" +
+	"1-3: Each other player takes 1 damage.\n" +
+	"4-6: Deal 1 damage to an active monster."
+const dadsLostCoinDesc = "Each time anyone rolls a 1, you may force them to reroll it."
+const daddyHauntDesc = "Each time you take damage, take an additional 1 damage.\n" +
+	"When you die, before paying penalties, give this to another player."
+const darkBumDesc = "At the start of your turn, roll:\n" +
+	"1-2: Gain 3¢.\n" +
+	"3-4: Loot 1.\n" +
+	"5-6: Take 1 damage."
+const deadBirdDesc = "Each time anyone rolls a 3, you may look at their hand and steal a loot card."
+const dinnerDesc = "+1 health."
+const dryBabyDesc = "All damage dealt to you is reduced to 1."
+const edensBlessingDesc = "If you have 0¢ at the end of your turn, gain 6¢."
+const emptyVesselDesc = "While you have no loot cards in your hand, +1 attack.\n" +
+	"While you have 0¢, add 1 to all your attack rolls."
+const eyeOfGreedDesc = "Each time anyone rolls a 5, gain 3¢."
+const fannyPackDesc = "Each time you take damage, loot 1."
+const fingerDesc = "Each time anyone rolls a 2, you may steal an item from them. If you do, give them one of your items."
+const greedsGulletDesc = "Each time you die, gain 8¢."
+const goatHeadDesc = "At the end of your turn, you may discard any number of loot cards, then loot that many."
+const guppysCollarDesc = "Each time you die, roll:\n" +
+	"1-3: Prevent death. If it's your turn, end it.\n" +
+	"4-6: Nothing."
+const ipecacDesc = "+1 attack.\n" +
+	"Each time you roll a 6 while attacking, deal 1 damage to each other player."
+const meatDesc = "Add 1 to all your attack rolls."
+const momsBoxDesc = "Each time anyone rolls a 4, you may loot 1, then discard a loot card."
+const momsCoinPurseDesc = "At the start of your turn, loot 1."
+const momsPurseDesc = "At the start of your turn, loot 1."
+const momsRazorDesc = "Each time anyone rolls a 6, you may deal 1 damage to them."
+const monstrosToothDesc = "At the start of your turn, choose a player at random. They destroy an item they control of their choice."
+const polydactylyDesc = "You may play an additional loot card on your turn.\n" +
+	"+1 attack for the first attack roll of your turn."
+const restockDesc = "At the start of your turn, you may put any shop items into discard and replace them with the top cards of the treasure deck."
+const sacredHeartDesc = "Each time you roll a 1, you may change it to a 6."
+const shadowDesc = "Each time another player dies, you choose which items they destroy, and you gain any loot and cents they lose."
+const shinyRockDesc = "Each time you activate an item, gain 1¢."
+const spiderModDesc = "Each time anyone rolls a 5, put an active monster that isn't being attacked into discard and replace it with the top card of the monster deck."
+const starterDeckDesc = "If you have 8 or more loot cards in your hand at the end of your turn, loot 2."
+const steamySaleDesc = "Shop items cost 5¢ for you."
+const suicideKingDesc = "Each time you die, loot 3."
+const synthoilDesc = "Add 1 to all your attack rolls."
+const tarotClothDesc = "Each time anyone rolls a 4, they give you a loot card of their choice from their hand."
+const theresOptionsDesc = "You may look at the top card of the treasure deck at any time during your turn.\n" +
+	"You may buy an additional item each turn."
+const theBlueMapDesc = "At the end of your turn, look at the top 4 cards of the treasure deck. You may put them back in any order."
+const theChestDesc = "If this is destroyed, it becomes a soul for the player who controlled it."
+const theCompassDesc = "At the end of your turn, look at the top 4 cards of the loot deck. You may put them back in any order."
+const theD10Desc = "Each time anyone rolls a 3, you may put the top card of the monster deck into an active slot that isn't being attacked."
+const theDeadCatDesc = "This enters play with 9 counters.\n" +
+	"Each time you take damage, remove that many counters and prevent that damage.\n" +
+	"This counts as a Guppy item."
+const theHabitDesc = "The first time you take damage each turn, you may recharge an item."
+const theMapDesc = "At the end of your turn, look at the top 4 cards of the monster deck. You may put them back in any order."
+const theMidasTouchDesc = "Each time a monster is killed, gain 3¢."
+const thePolaroidDesc = "If you have no loot cards in your hand at the end of your turn, loot 2."
+const theRelicDesc = "Each time anyone rolls a 1, loot 1."
+const trinityShieldDesc = "Other players can't play loot cards or activate items on your turn."
+const crookedPennyDesc = "Roll:\n" +
+	"1-3: Lose all your cents.\n" +
+	"4-6: Double your cents."
+const fruitCakeDesc = "Roll: Gain the effect of a random loot card from the top of the loot deck, then discard it."
+const iCantBelieveItsNotButterBeanDesc = "Cancel the effect of a loot card being played."
+const lemonMishapDesc = "Deal 1 damage to each monster and each other player."
+const libraryCardDesc = "Loot 1."
+const ouijaBoardDesc = "Look at the top 3 cards of the monster deck. Put them back in any order."
+const planCDesc = "Destroy this: Kill each monster in play, then you die."
+const theBibleDesc = "Prevent up to 2 damage dealt to a player."
+const theButterBeanDesc = "Cancel the effect of an active item or loot card being played."
+const dadsKeyDesc = "Pay 6¢: Recharge each item you control."
+const succubusDesc = "Pay 3¢: Deal 1 damage to a monster or player."
+const nineVoltDesc = "Each time you activate an item, you may recharge it at the end of the turn."
+const guppysTailDesc = "At the start of your turn, roll:\n" +
+	"1-3: Gain 3¢.\n" +
+	"4-6: Loot 1.\n" +
+	"This counts as a Guppy item."
+const infamyDesc = "Prevent the first damage dealt to you each turn."
+const momsKnifeDesc = "+1 attack.\n" +
+	"Each time you deal damage to a monster, gain 1¢."
+const moreOptionsDesc = "There are 2 additional shop slots on your turn."
+const placentaDesc = "At the start of your turn, heal 1 HP.\n" +
+	"+1 health."
+const skeletonKeyDesc = "Each time you buy an item, gain 2¢."
+const soyMilkDesc = "+1 attack for each loot card in your hand, up to 3."
+const theMissingPageDesc = "Each time you deal damage, deal an additional 1 damage."
+const twentyTwentyDesc = "Double the next damage you deal this turn."
+const blackCandleDesc = "Destroy a curse. If you do, gain +1 treasure."
+const distantAdmirationDesc = "Deal 1 damage to a monster that isn't being attacked."
+const divorcePapersDesc = "Destroy this: Steal a soul card from a player, then give them one of your items."
+const forgetMeNowDesc = "Destroy this: End the turn. All monsters and players heal to full."
+const headOfKrampusDesc = "Roll:\n" +
+	"1-3: Deal 1 damage to each player.\n" +
+	"4-6: Deal 1 damage to each monster."
+const libraDesc = "Choose one:\n" +
+	"- Set your cents to 5.\n" +
+	"- Set your hand to 5 loot cards."
+const mutantSpiderDesc = "Look at the top 4 cards of any deck. Put one into your hand if it's loot, the rest on the bottom."
+const rainbowBabyDesc = "Gain the effect of a random passive item in the treasure deck till the end of the turn."
+const redCandleDesc = "Deal 2 damage to a monster."
+const smartFlyDesc = "Look at the top card of any deck. You may discard it or put it back on top."
+const athameDesc = "Pay 2¢: Deal 1 damage to a player or monster that was already damaged this turn."
+const oneUpDesc = "The first time you would die, prevent it and destroy this."
+const abaddonDesc = "+1 attack for each soul you control."
+const cursedEyeDesc = "Each time you attack, roll before declaring: on a 1 or 2, the attack targets the monster deck instead."
+const daddyLongLegsTreasureDesc = "At the start of your turn, roll:\n" +
+	"1-2: Deal 1 damage to a monster.\n" +
+	"3-4: Deal 1 damage to a player.\n" +
+	"5-6: Gain 2¢."
+const euthanasiaDesc = "Each time a player deals damage to you, they take 1 damage."
+const gameBreakingBugDesc = "At the start of your turn, roll and do the effect of a random loot card in the discard pile."
+const guppysEyeDesc = "You may look at the top card of the loot deck at any time.\n" +
+	"This counts as a Guppy item."
+const headOfTheKeeperDesc = "Each time anyone rolls a 6, gain 1¢."
+const hourGlassDesc = "Each time you take damage on your turn, roll:\n" +
+	"1-2: Prevent that damage."
+const lardDesc = "+2 health.\n" +
+	"-1 to all your attack rolls."
+const magnetDesc = "Each time another player gains cents, gain 1¢."
+const mamaHauntDesc = "Each time you are attacked, the attacking player's rolls get -1.\n" +
+	"When you die, before paying penalties, give this to another player."
+const momsEyeShadowDesc = "You may look at the top card of the monster deck at any time."
+const phdDesc = "Each time you play a pill, you choose the result."
+const polyphemusDesc = "+1 attack.\n" +
+	"Your first attack each turn deals double damage."
+const rubberCementDesc = "Each time you take damage, roll:\n" +
+	"6: Prevent it and deal that damage to a monster instead."
+const telepathyForDummiesDesc = "At the start of your turn, look at another player's hand."
+const theWizDesc = "Each time you roll, you may add or subtract 1 from the result."
@@ -0,0 +1,97 @@
+package four_souls
+
+// A curse is a monster deck card that is given to a player instead of being fought.
+// Its lifecycle:
+// 1) It is revealed from the monster deck, either while refilling a monster zone or by attacking the deck.
+// 2) The player who revealed it chooses any player, themselves included, to receive it.
+// 3) While on a player's board, its triggered effects are checked with the other passives,
+// and its continuous effects apply through the modifiers or the curse checks below.
+// 4) It leaves when its player dies, or when a card like Dagaz or Black Candle destroys it.
+// A death prevented by Holy Card, Broken Ankh and the like is not a death, so the curses stay.
+// 5) Once it leaves, it goes into the monster discard pile.
+
+// Reveal a curse: the player who revealed it chooses who receives it.
+func (b *Board) revealCurse(p *player, mc monsterCard) (cardEffect, error) {
+	if !mc.isCurse {
+		return nil, wrapError(ErrInvalidCard, "%s is not a curse", mc.name)
+	}
+	players := b.getPlayers(false)
+	b.ui.Printf("%s revealed %s. Give it to whom?\n", p.Character.name, mc.name)
+	b.ui.showPlayers(players, 0)
+	target := players[b.ui.readInput(0, len(players)-1)]
+	var f cardEffect = func(roll uint8) {
+		_ = b.giveCurse(target, mc)
+	}
+	return f, nil
+}
+
+// Put the curse on the player's board.
+func (b *Board) giveCurse(p *player, mc monsterCard) error {
+	err := p.addCardToBoard(mc)
+	if err == nil {
+		b.bus.publishCard(CurseGiven, p, mc, 1)
+	}
+	return err
+}
+
+// Take one of the player's curses off their board and into the monster discard pile.
+func (b *Board) removeCurse(p *player, idx uint8) error {
+	c, err := p.popCurse(idx)
+	if err == nil {
+		b.monster.discard(&c)
+		b.bus.publishCard(CurseRemoved, p, c, 1)
+	}
+	return err
+}
+
+// Discard every curse the player has, as when they die.
+func (b *Board) discardCurses(p *player) {
+	for l := len(p.Curses); l > 0; l = len(p.Curses) {
+		_ = b.removeCurse(p, uint8(l-1))
+	}
+}
+
+// Every player with at least one curse.
+func (b *Board) getCursedPlayers() []*player {
+	cursed := make([]*player, 0, len(b.players))
+	for _, p := range b.getPlayers(false) {
+		if len(p.Curses) > 0 {
+			cursed = append(cursed, p)
+		}
+	}
+	return cursed
+}
+
+func (p player) hasCurse(id uint16) bool {
+	_, err := p.getCurseIndex(id)
+	return err == nil
+}
+
+// Choose a curse among the players to destroy.
+// Return: a function that destroys the chosen curse once the effect resolves,
+// and reports if it was destroyed.
+func (b *Board) destroyCurseHelper(players []*player) (func() bool, error) {
+	if len(players) == 0 {
+		return nil, wrapError(ErrInvalidTarget, "no player has a curse")
+	}
+	var i uint8
+	if l := len(players); l > 1 {
+		b.ui.showPlayers(players, 0)
+		b.ui.Println("Destroy a curse of which player?")
+		i = uint8(b.ui.readInput(0, l-1))
+	}
+	target := players[i]
+	var j uint8
+	if l := len(target.Curses); l > 1 {
+		b.ui.showMonsterCards(target.Curses, 0)
+		b.ui.Println("Destroy which curse?")
+		j = uint8(b.ui.readInput(0, l-1))
+	}
+	curseId := target.Curses[j].id
+	return func() bool {
+		if k, err := target.getCurseIndex(curseId); err == nil {
+			return b.removeCurse(target, k) == nil
+		}
+		return false
+	}, nil
+}
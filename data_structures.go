@@ -37,15 +37,15 @@ func (d deck) len() uint8 {
 // If we have two decks of cards, merge them together
 // Make sure they are the same type!
 // if onTop, append, else, prepend
-// if shuffle, shuffle the deck after merging
-func (d *deck) merge(d2 deck, onTop bool, shuffle bool) {
+// if rng is not nil, shuffle the deck with it after merging
+func (d *deck) merge(d2 deck, onTop bool, rng *rand.Rand) {
 	if onTop {
 		*d = append(*d, d2...)
 	} else {
 		*d = append(d2, *d...)
 	}
-	if shuffle {
-		d.shuffle()
+	if rng != nil {
+		d.shuffle(rng)
 	}
 }
 
@@ -92,7 +92,7 @@ func (d *deck) popByIndex(i uint8) (card, error) {
 	var c card
 	err, l := errors.New("index out of bounds"), d.len()
 	if l > 0 {
-		if i > 0 && i < l {
+		if i < l {
 			c, err = (*d)[i], nil
 			d.delete(i)
 		}
@@ -123,7 +123,6 @@ func (d deck) scan(ids ...uint16) ([]card, map[uint16]uint8) {
 		}
 	}
 	return foundCards, idMaps
-	return foundCards, idMaps
 }
 
 // Find a card in the slice based off its id
@@ -136,14 +135,30 @@ func (d deck) search(cardId uint16) (card, uint8, error) {
 		card := d[i]
 		if card.getId() == cardId {
 			c, err = card, nil
+			break
 		}
 	}
 	return c, i, err
 }
 
+// Shuffle the discard pile into this (empty) deck and empty the discard pile.
+// This is how a deck that runs out gets refilled.
+// return: the number of cards shuffled in. 0 if the discard pile was empty too.
+func (d *deck) reshuffleFrom(discardPile *deck, rng *rand.Rand) int {
+	n := len(*discardPile)
+	if n > 0 {
+		d.merge(*discardPile, true, rng)
+		for i := range *discardPile {
+			(*discardPile)[i] = nil
+		}
+		*discardPile = (*discardPile)[:0]
+	}
+	return n
+}
+
 // Shuffle the deck in place
-func (d *deck) shuffle() {
-	rand.Shuffle(len(*d), func(i, j int) { (*d)[i], (*d)[j] = (*d)[j], (*d)[i] })
+func (d *deck) shuffle(rng *rand.Rand) {
+	rng.Shuffle(len(*d), func(i, j int) { (*d)[i], (*d)[j] = (*d)[j], (*d)[i] })
 }
 
 // Necessary only for the monster card.
@@ -151,6 +166,8 @@ func (d *deck) shuffle() {
 // and if that attacked card is a monster, it will be overlayed on
 // on of the monster zones. The sole purpose of this structure is to provide this
 // functionality.
+// Only the monster on top is active. The monsters beneath it are covered, and
+// re-emerge one at a time as the monsters above them leave the zone.
 type activeSlot []monsterCard
 
 func (as activeSlot) isEmpty() bool {
@@ -159,14 +176,14 @@ func (as activeSlot) isEmpty() bool {
 
 // Check the top of the stack
 // Will be used either for effects targeting the monster
-// or for attack.
+// or for attack. Changes to the returned monster stay in the zone.
+// An empty zone returns an empty monster.
 func (as activeSlot) peek() *monsterCard {
-	var monster monsterCard
 	length := len(as)
 	if length > 0 {
-		monster = as[length-1]
+		return &as[length-1]
 	}
-	return &monster
+	return &monsterCard{}
 }
 
 // Put a new monster in a monster zone, either
@@ -176,12 +193,17 @@ func (as *activeSlot) push(item monsterCard) {
 }
 
 // pop a monster (destroy it)
+// The monster it covered re-emerges as the active monster, free of any damage
+// or changes it had before it was covered.
 func (as *activeSlot) pop() monsterCard {
 	var item monsterCard
 	length := len(*as)
 	if length > 0 {
 		idx := length - 1
 		item, *as = (*as)[idx], (*as)[:idx]
+		if idx > 0 {
+			(*as)[idx-1].resetStats()
+		}
 	}
 	return item
 }
@@ -374,7 +396,8 @@ type eventStack struct {
 // Add or subtract a value from a diceroll on the event stack
 // n int8: The positive or negative value to apply to the diceroll
 // rollNode *eventNode: The deckNode containing the dice roll event.
-func (es *eventStack) addToDiceRoll(n int8, rollNode *eventNode) {
+func (es *eventStack) addToDiceRoll(n int8, rollNode *eventNode) error {
+	var err error
 	if oldEvent, ok := rollNode.event.e.(diceRollEvent); ok {
 		x := int8(oldEvent.n) + n
 		if x <= 0 {
@@ -384,8 +407,9 @@ func (es *eventStack) addToDiceRoll(n int8, rollNode *eventNode) {
 		}
 		rollNode.event.e = diceRollEvent{n: uint8(x)}
 	} else {
-		panic("not a node that contains a dice roll.")
+		err = wrapError(ErrInvalidTarget, "node %d does not contain a dice roll", rollNode.id)
 	}
+	return err
 }
 
 // Although we have deletion, fizzling should be called by any card
@@ -459,11 +483,11 @@ func (es *eventStack) push(event event) {
 func (es *eventStack) preventDamage(i uint8, damageNode *eventNode) error {
 	var err = errors.New("not a damage node")
 	if oldEvent, ok := damageNode.event.e.(damageEvent); ok {
-		x := oldEvent.n - i
-		if x == 0 {
+		if oldEvent.n <= i {
 			err = es.fizzle(damageNode)
-		} else {
-			damageNode.event = event{p: damageNode.event.p, e: damageEvent{n: x}}
+		} else { // The damage keeps its target
+			oldEvent.n -= i
+			damageNode.event.e = oldEvent
 			err = nil
 		}
 	}
@@ -471,7 +495,11 @@ func (es *eventStack) preventDamage(i uint8, damageNode *eventNode) error {
 }
 
 func (es *eventStack) search(id uint) (*eventNode, error) {
-	curr, err := es.head.top, errors.New("node not found")
+	var curr *eventNode
+	err := errors.New("node not found")
+	if es.head != nil {
+		curr = es.head.top
+	}
 	for curr != nil {
 		if curr.id == id {
 			err = nil
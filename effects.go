@@ -0,0 +1,181 @@
+package four_souls
+
+// How long a temporary effect stays in play.
+type duration uint8
+
+const (
+	untilEndOfTurn duration = iota // Expires when the current turn ends
+	untilNextTurn                  // Expires when its owner's next turn starts
+	nextTime                       // Stays until it is used by the next event it waits on
+	permanent                      // Stays until a card removes it
+)
+
+func (d duration) String() string {
+	switch d {
+	case untilEndOfTurn:
+		return "Until end of turn"
+	case untilNextTurn:
+		return "Until your next turn"
+	case nextTime:
+		return "Next time"
+	default:
+		return "Permanent"
+	}
+}
+
+// A temporary effect put into play by a card: The Sun's extra turn, Compost's
+// next loot, Two of Clubs' extra draws...
+// Any effect can also be used up before it expires, like Blank Card or The Habit.
+type effect struct {
+	id     uint16 // Identifies the effect. The id of the card that created it
+	owner  uint16 // The character id of the player the effect applies to. 0 if it applies to the whole board
+	source card   // The card that created the effect
+	target card   // The card the effect was applied to, if any
+	d      duration
+}
+
+// Every temporary effect in play.
+// The board shares one registry with its players and the loot area.
+type effectRegistry struct {
+	effects []effect
+}
+
+func newEffectRegistry() *effectRegistry {
+	return &effectRegistry{effects: make([]effect, 0, 4)}
+}
+
+// Put an effect into play. An effect with the same id and owner is replaced,
+// so playing a card twice doesn't stack its effect.
+func (r *effectRegistry) add(e effect) {
+	if i := r.find(e.id, e.owner); i >= 0 {
+		r.effects[i] = e
+	} else {
+		r.effects = append(r.effects, e)
+	}
+}
+
+// The index of the effect, or -1 if it's not in play.
+func (r *effectRegistry) find(id uint16, owner uint16) int {
+	if r != nil {
+		for i := range r.effects {
+			if r.effects[i].id == id && r.effects[i].owner == owner {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+func (r *effectRegistry) has(id uint16, owner uint16) bool {
+	return r.find(id, owner) >= 0
+}
+
+// Remove the effect from play.
+// Return: if the effect was in play.
+func (r *effectRegistry) use(id uint16, owner uint16) bool {
+	i := r.find(id, owner)
+	if i >= 0 {
+		r.effects = append(r.effects[:i], r.effects[i+1:]...)
+	}
+	return i >= 0
+}
+
+// Remove every effect matching the filter from play.
+// Return: the removed effects, in the order they were put into play.
+func (r *effectRegistry) expire(filter func(e effect) bool) []effect {
+	expired := make([]effect, 0)
+	if r == nil {
+		return expired
+	}
+	kept := r.effects[:0]
+	for _, e := range r.effects {
+		if filter(e) {
+			expired = append(expired, e)
+		} else {
+			kept = append(kept, e)
+		}
+	}
+	r.effects = kept
+	return expired
+}
+
+// Remove the effects that last until the end of the turn.
+func (r *effectRegistry) endOfTurn() []effect {
+	return r.expire(func(e effect) bool { return e.d == untilEndOfTurn })
+}
+
+// Remove the effects that last until the player's turn starts again.
+func (r *effectRegistry) startOfTurn(owner uint16) []effect {
+	return r.expire(func(e effect) bool { return e.d == untilNextTurn && e.owner == owner })
+}
+
+// Remove every effect a card put into play.
+func (r *effectRegistry) removeBySource(id uint16) []effect {
+	return r.expire(func(e effect) bool { return e.source != nil && e.source.getId() == id })
+}
+
+// A copy of the effects in play.
+func (r *effectRegistry) list() []effect {
+	if r == nil {
+		return []effect{}
+	}
+	effects := make([]effect, len(r.effects))
+	copy(effects, r.effects)
+	return effects
+}
+
+func (p *player) addEffect(id uint16, source card, d duration) {
+	p.effects.add(effect{id: id, owner: p.Character.id, source: source, d: d})
+}
+
+func (p *player) hasEffect(id uint16) bool {
+	return p.effects.has(id, p.Character.id)
+}
+
+// Use up one of the player's effects.
+// Return: if the player had the effect.
+func (p *player) useEffect(id uint16) bool {
+	return p.effects.use(id, p.Character.id)
+}
+
+// Share the registry with everything on the board that puts effects into play.
+func (b *Board) setEffectRegistry(r *effectRegistry) {
+	b.effects = r
+	for i := range b.players {
+		b.players[i].effects = r
+	}
+	if b.loot != nil {
+		b.loot.effects = r
+	}
+}
+
+// A read-only description of an effect in play, for clients to list.
+type EffectView struct {
+	Id       uint16 `json:"id"`
+	Source   string `json:"source"` // The name of the card that created the effect
+	Owner    string `json:"owner"`  // The character name of the player the effect applies to. Empty if it applies to the whole board
+	Duration string `json:"duration"`
+	Text     string `json:"text"` // The text of the card that created the effect
+}
+
+// Every temporary effect in play, in the order they were put into play.
+func (b *Board) Effects() []EffectView {
+	effects := b.effects.list()
+	views := make([]EffectView, 0, len(effects))
+	for _, e := range effects {
+		v := EffectView{Id: e.id, Duration: e.d.String()}
+		if e.source != nil {
+			ci := cardInfoFor(e.source)
+			v.Source, v.Text = ci.Name, ci.Text
+		} else if ci, err := LookUpCard(e.id); err == nil { // Loot cards don't hand themselves to their effects
+			v.Source, v.Text = ci.Name, ci.Text
+		}
+		for i := range b.players {
+			if e.owner != 0 && b.players[i].Character.id == e.owner {
+				v.Owner = b.players[i].Character.name
+			}
+		}
+		views = append(views, v)
+	}
+	return views
+}
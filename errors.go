@@ -0,0 +1,50 @@
+package four_souls
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Errors returned by the engine on recoverable conditions.
+// Use errors.Is to test for them; most are wrapped with details about what went wrong.
+var (
+	ErrEmptyDeck     = errors.New("empty deck")                // A card was drawn from a deck that has no cards left
+	ErrIllegalAction = errors.New("illegal action")            // The action is not allowed in the current state of the game
+	ErrInvalidTarget = errors.New("invalid target")            // The chosen card, player or event cannot be targeted
+	ErrInvalidInput  = errors.New("invalid input")             // A player's input could not be read
+	ErrInvalidCard   = errors.New("invalid card")              // The card cannot be used the way it was asked to be
+	ErrNoEvent       = errors.New("no event on the stack")     // Something required an event on the stack that wasn't there
+	ErrEngine        = errors.New("unexpected engine failure") // A panic that was recovered at the public API
+	ErrUnfinished    = errors.New("game unfinished")           // The game was stopped before anyone won, ex: it took too many decisions
+)
+
+// Wrap one of the engine errors with a detail message.
+// Ex: wrapError(ErrInvalidTarget, "monster %d is not in play", id)
+func wrapError(err error, format string, a ...interface{}) error {
+	return fmt.Errorf("%w: %s", err, fmt.Sprintf(format, a...))
+}
+
+// Errors the game can't carry on from: a player who can't answer anymore, a game
+// that was stopped, or a board left half-updated by a recovered panic.
+func isFatal(err error) bool {
+	return errors.Is(err, ErrInvalidInput) || errors.Is(err, ErrUnfinished) || errors.Is(err, ErrEngine)
+}
+
+// Last resort against a panic raised while resolving an effect, so a single bad move
+// does not take the whole process down with it. Deferred by the public entry points
+// into the engine; the recovered value is returned through err.
+// Engine errors keep their identity. Anything else is wrapped in ErrEngine, which ends the game.
+func recoverEngineError(err *error) {
+	if r := recover(); r != nil {
+		if e, ok := r.(error); ok {
+			if errors.Is(e, ErrEmptyDeck) || errors.Is(e, ErrIllegalAction) || errors.Is(e, ErrInvalidTarget) ||
+				errors.Is(e, ErrInvalidInput) || errors.Is(e, ErrInvalidCard) || errors.Is(e, ErrNoEvent) {
+				*err = e
+				return
+			}
+			*err = fmt.Errorf("%w: %v", ErrEngine, e)
+		} else {
+			*err = fmt.Errorf("%w: %v", ErrEngine, r)
+		}
+	}
+}
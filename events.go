@@ -1,10 +1,5 @@
 package four_souls
 
-import (
-	"errors"
-	"fmt"
-)
-
 type eventHolder interface {
 	eHolder()
 }
@@ -156,13 +151,89 @@ func (b *Board) checkPlayerPassives(en *eventNode, startOrEndOfTurn bool) []even
 	return events
 }
 
-func (b *Board) resolveNextEvent() error {
-	err := errors.New("no eventNode on stack")
+// Split simultaneous triggered events into the abilities that produced them.
+// A triggered effect that requires a roll is immediately followed by its dice roll,
+// and the two stay together. Empty events (conditions that were not met) are dropped.
+func groupTriggeredEvents(events []event) [][]event {
+	groups := make([][]event, 0, len(events))
+	for _, e := range events {
+		if e.e == nil {
+			continue
+		}
+		if _, ok := e.e.(diceRollEvent); ok && len(groups) > 0 {
+			last := len(groups) - 1
+			groups[last] = append(groups[last], e)
+		} else {
+			groups = append(groups, []event{e})
+		}
+	}
+	return groups
+}
+
+// Put simultaneous triggered events in the order they will be pushed to the stack.
+// Triggers are grouped by player, starting with the active player and going in turn order,
+// so the active player's triggers go on the stack first and resolve last.
+// A player with several triggers chooses the order in which their own triggers resolve.
+func (b *Board) orderTriggeredEvents(events []event) []event {
+	groups := groupTriggeredEvents(events)
+	ordered := make([]event, 0, len(events))
+	claimed := make([]bool, len(groups))
+	for _, p := range b.getPlayers(false) {
+		owned := make([][]event, 0, len(groups))
+		for i, g := range groups {
+			if !claimed[i] && g[0].p != nil && g[0].p.Character.id == p.Character.id {
+				owned = append(owned, g)
+				claimed[i] = true
+			}
+		}
+		for _, g := range p.orderTriggers(owned) {
+			ordered = append(ordered, g...)
+		}
+	}
+	for i, g := range groups { // Triggers without a controller keep their original order
+		if !claimed[i] {
+			ordered = append(ordered, g...)
+		}
+	}
+	return ordered
+}
+
+// The player picks which of their simultaneous triggers resolves first, then second, and so on.
+// Ex: Guppy's Hairball and The Dead Cat both trigger on the same damage event.
+// Return: the triggers in the order they should be pushed to the stack.
+func (p *player) orderTriggers(triggers [][]event) [][]event {
+	p.ui.ask(p)
+	l := len(triggers)
+	pushOrder := make([][]event, l)
+	for i := l - 1; i > 0; i-- {
+		nodes := make([]*eventNode, len(triggers))
+		for j := range triggers {
+			nodes[j] = &eventNode{event: triggers[j][0]}
+		}
+		p.ui.showEvents(nodes)
+		p.ui.Println(p.Character.name, "has several triggered effects. Which one resolves next?")
+		ans := p.ui.readInput(0, len(triggers)-1)
+		pushOrder[i] = triggers[ans]
+		triggers = append(triggers[:ans], triggers[ans+1:]...)
+	}
+	if l > 0 {
+		pushOrder[0] = triggers[0]
+	}
+	return pushOrder
+}
+
+// Pop the top of the event stack and resolve it.
+// Any engine error raised by a card effect while resolving is returned instead of crashing the game.
+func (b *Board) resolveNextEvent() (err error) {
+	defer recoverEngineError(&err)
+	err = ErrNoEvent
 	es := &b.eventStack
 	triggeredEvents := make([]event, 0)
 	node := es.pop()
 	if node != nil {
+		err = nil
 		p, ev, roll := node.event.p, node.event.e, node.event.roll
+		b.ui.ask(p) // Effects ask their controller to make their choices
 		switch ev.(type) {
 		case activateEvent: // Regardless of Treasure card or character
 			eh := ev.(activateEvent)
@@ -177,6 +248,7 @@ func (b *Board) resolveNextEvent() error {
 			if _, ok := e.target.(*monsterCard); ok {
 				if _, monster := b.monster.getActiveMonster(e.target.getId()); monster != nil && !e.target.isDead() {
 					monster.decreaseHP(e.n)
+					b.bus.publish(Notification{Topic: DamageDealt, Player: p.Character.name, Target: e.target.getName(), Amount: int(e.n)})
 					if monster.isDead() {
 						b.killMonster(p, monster.id)
 					}
@@ -185,77 +257,84 @@ func (b *Board) resolveNextEvent() error {
 				if !e.target.isDead() {
 					if !dryBabyFunc(p) { // if not dry baby, proceed with normal calculation
 						e.target.decreaseHP(e.n)
+						b.bus.publish(Notification{Topic: DamageDealt, Player: p.Character.name, Target: e.target.getName(), Amount: int(e.n)})
 					}
 					if !e.target.isDead() {
 						p.checkDamageRequiredEffects(node.next)
-					} else {
-						b.killPlayer(p)
+					} else if target, ok := e.target.(*player); ok { // The damage killed them
+						b.pushDeath(target)
 					}
 				}
 			}
 			triggeredEvents = append(triggeredEvents, b.checkActiveMonsterPassives(node)...)
 			triggeredEvents = append(triggeredEvents, b.checkPlayerPassives(node, false)...)
+			triggeredEvents = append(triggeredEvents, b.checkCursePassives(node)...)
 		case deathOfCharacterEvent:
+			b.bus.publishCard(PlayerDied, p, nil, 1)
 			p.deathPenalty(b)
 			triggeredEvents = append(triggeredEvents, b.checkPlayerPassives(node, false)...)
 		case declareAttackEvent:
-			b.battle(p, ev.(declareAttackEvent).m, roll)
+			b.attackRound(p, ev.(declareAttackEvent).m, roll)
 			triggeredEvents = append(triggeredEvents, b.checkActiveMonsterPassives(node)...)
 			triggeredEvents = append(triggeredEvents, b.checkPlayerPassives(node, false)...)
 		case declarePurchaseEvent:
-			showTreasureCards(b.treasure.zones, "shop", 0)
-			err = b.treasure.buyFromShop(p, uint8(readInput(0, len(b.treasure.zones)-1)))
+			b.treasure.showShop(p)
+			err = b.treasure.buyFromShop(p, uint8(b.ui.readInput(0, len(b.treasure.zones))))
 		case diceRollEvent:
 			e := ev.(diceRollEvent)
-			b.eventStack.peek().event.roll = e.n // safe to do this. dice rolls are not isolated events
+			if !es.isEmpty() { // dice rolls are not isolated events, something is waiting on the result
+				es.peek().event.roll = e.n
+			}
 			if len(b.treasure.crystalBallGuess) > 0 {
 				b.treasure.checkCrystalBall(e.n, b.loot)
 			}
 			triggeredEvents = append(triggeredEvents, b.checkActiveMonsterPassives(node)...)
 			triggeredEvents = append(triggeredEvents, b.checkPlayerPassives(node, false)...)
 		case endTurnEvent:
-			for _, p := range b.getPlayers(true) {
-				p.resetStats(p.isActivePlayer(b))
+			for _, p := range b.getPlayers(false) { // The dead come back too
+				p.resetStats()
 			}
+			b.endCombat()
+			b.endPhase()
 			triggeredEvents = append(triggeredEvents, b.checkPlayerPassives(node, true)...)
 			triggeredEvents = append(triggeredEvents, b.checkCursePassives(node)...)
-			if !checkActiveEffects(p.activeEffects, theSun, true) { // Player gains extra turn
+			triggeredEvents = append(triggeredEvents, b.checkActiveMonsterPassives(node)...)
+			if !p.useEffect(theSun) { // Player gains extra turn
+				b.api = (b.api + 1) % uint8(len(b.players))
+			}
+			if b.players[b.api].useEffect(famine) { // Skip that player's turn
 				b.api = (b.api + 1) % uint8(len(b.players))
-			} else {
-				if checkActiveEffects(b.players[b.api].activeEffects, famine, true) { // Skip that player's turn
-					b.api = (b.api + 1) % uint8(len(b.players))
-				}
 			}
 		case intentionToAttackEvent:
 			e := ev.(intentionToAttackEvent)
 			p.numAttacks -= 1
 			if e.m != nil { // Attack an actual monster
-				p.inBattle, e.m.inBattle = true, true
+				b.startCombat(p, e.m)
 				triggeredEvents = append(triggeredEvents, b.checkActiveMonsterPassives(node)...)
 				if i, err := p.getItemIndex(babyHaunt, true); err == nil {
 					triggeredEvents = append(triggeredEvents, p.PassiveItems[i].trigger(p, b, node)...)
 				}
 			} else { // Attack the monster deck. May or may not be a monster
-				m := b.monster.draw()
-				m.showCard(0)
+				m, dErr := b.monster.draw()
+				if dErr != nil {
+					return dErr
+				}
+				b.ui.Print(m.showCard(0))
 				if !m.isBonusCard() {
-					p.inBattle, m.inBattle = true, true
-					showMonsterCards(b.monster.getActiveMonsters(), 0)
-					fmt.Print("Overlay over which monster?")
-					b.monster.zones[readInput(0, len(b.monster.zones))].push(m)
-				} else {
-					err = m.activate(&b.players[b.api], b)
+					m.resetStats()
+					zone := &b.monster.zones[b.monster.chooseZoneHelper(b, "Overlay over which monster?")]
+					zone.push(m)
+					b.startCombat(p, zone.peek())
+				} else { // The attack is used up on the bonus card
+					err = m.activate(p, b)
 				}
 			}
+			triggeredEvents = append(triggeredEvents, b.checkCursePassives(node)...)
 		case intentionToPurchaseEvent:
 			triggeredEvents = append(triggeredEvents, event{p: p, e: declarePurchaseEvent{}})
 		case lootCardEvent:
 			e := ev.(lootCardEvent)
-			_, usedBC := p.activeEffects[blankCard]
-			e.f(roll, usedBC)
-			if usedBC {
-				delete(p.activeEffects, blankCard)
-			}
+			e.f(roll, p.useEffect(blankCard))
 		case monsterRewardEvent:
 			e := ev.(monsterRewardEvent)
 			e.r(roll)
@@ -263,19 +342,15 @@ func (b *Board) resolveNextEvent() error {
 			e := ev.(paidItemEvent)
 			e.f(roll)
 		case startOfTurnEvent:
+			b.bus.publishCard(TurnStarted, p, nil, 1)
 			p.Character.tapped = false
 			for _, ai := range p.getActiveItems(true) {
 				ai.recharge()
 			}
 			p.loot(b.loot)
-			firstTimeIds := map[uint16]struct{}{curvedHorn: {}, bumbo: {}, championBelt: {}, theHabit: {}, polydactyly: {}}
-			for _, c := range p.PassiveItems {
-				id := c.getId()
-				if _, ok := firstTimeIds[id]; ok {
-					if (id == bumbo && c.getCounters() > 0) || id != bumbo {
-						p.activeEffects[id] = struct{}{}
-					}
-				}
+			b.effects.startOfTurn(p.Character.id)
+			if i, err := p.getItemIndex(theHabit, true); err == nil { // The first damage this turn
+				p.addEffect(theHabit, p.PassiveItems[i], untilEndOfTurn)
 			}
 			triggeredEvents = append(triggeredEvents, b.checkPlayerPassives(node, true)...)
 			triggeredEvents = append(triggeredEvents, b.checkCursePassives(node)...)
@@ -283,6 +358,7 @@ func (b *Board) resolveNextEvent() error {
 			e := ev.(triggeredEffectEvent)
 			e.f(roll)
 		}
+		triggeredEvents = b.orderTriggeredEvents(triggeredEvents)
 		var i, max uint8 = 0, uint8(len(triggeredEvents))
 		for i = 0; i < max; i++ {
 			b.eventStack.push(triggeredEvents[i])
@@ -294,6 +370,7 @@ func (b *Board) resolveNextEvent() error {
 
 func (t *tArea) checkCrystalBall(roll uint8, l *lArea) {
 	for player, guess := range t.crystalBallGuess {
+		t.ui.Printf("%s guessed %d with the Crystal Ball.\n", player.Character.name, guess)
 		if guess == roll {
 			player.loot(l)
 			player.loot(l)
@@ -1,7 +1,1172 @@
 package four_souls
 
-import "testing"
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// Answers the game's decisions from a script, then with each decision's default.
+type scripted struct {
+	answers []int
+	asked   []Decision
+}
+
+func (s *scripted) Show(b *Board, str string) {}
+
+func (s *scripted) Decide(b *Board, d Decision) (int, error) {
+	s.asked = append(s.asked, d)
+	if len(s.answers) == 0 {
+		return d.Default, nil
+	}
+	ans := s.answers[0]
+	s.answers = s.answers[1:]
+	return ans, nil
+}
+
+// Deal a game of two players with a fixed seed, its decisions answered from the script.
+func dealGame(t *testing.T, seed int64, answers ...int) (*Board, *scripted) {
+	t.Helper()
+	b, err := NewGameWithOptions(GameOptions{Players: 2, Seed: seed})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &scripted{answers: answers}
+	b.SetDecider(s)
+	return b, s
+}
 
 func TestLootCards(t *testing.T) {
 
 }
+
+func TestEdenChoosesStartingItem(t *testing.T) {
+	b, s := dealGame(t, 2, 1) // Seed 2 deals Eden and Isaac
+	p := &b.players[0]
+	if p.Character.id != eden {
+		t.Fatalf("seed 2 should deal Eden first, not %s", p.Character.name)
+	}
+	if len(p.ActiveItems)+len(p.PassiveItems) != 0 {
+		t.Fatal("Eden shouldn't have an item before choosing one")
+	}
+	l := len(b.treasure.deck)
+	top := append(deck{}, b.treasure.deck[l-3:]...) // From the bottom to the top
+	if err := b.chooseStartingItems(); err != nil {
+		t.Fatal(err)
+	}
+	if len(s.asked) != 1 || s.asked[0].Player != "Eden" || s.asked[0].Max != 2 {
+		t.Fatalf("Eden should choose between 3 items once: %+v", s.asked)
+	}
+	chosen := top[1].(treasureCard)
+	var items []treasureCard
+	for _, ic := range p.PassiveItems {
+		if tc, ok := ic.(*treasureCard); ok {
+			items = append(items, *tc)
+		}
+	}
+	items = append(items, p.ActiveItems...)
+	if len(items) != 1 || items[0].id != chosen.id || !items[0].eternal {
+		t.Errorf("Eden should start with an eternal %s, has %v", chosen.name, items)
+	}
+	if len(b.treasure.deck) != l-1 || b.treasure.deck[0].getId() != top[0].getId() || b.treasure.deck[1].getId() != top[2].getId() {
+		t.Error("the items Eden passed on should go to the bottom of the treasure deck")
+	}
+	if err := b.chooseStartingItems(); err != nil || len(s.asked) != 1 {
+		t.Error("Eden chooses their starting item once")
+	}
+}
+
+// Find a loot card by id among every loot card.
+func lootCardFor(t *testing.T, id uint16) lootCard {
+	t.Helper()
+	c, _, err := getLootCards(true, true).search(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c.(lootCard)
+}
+
+func TestTrinketIsPlayedOnce(t *testing.T) {
+	b, _ := dealGame(t, 18, 0, 0) // Play a loot card: the first in their hand
+	p := &b.players[b.api]
+	p.Hand = []lootCard{lootCardFor(t, brokenAnkh), lootCardFor(t, swallowedPenny)}
+	p.numLootPlayed = 1
+	if !p.makeChoice(b) {
+		t.Fatal("playing a trinket is an action")
+	}
+	if len(p.Hand) != 1 || p.Hand[0].id != swallowedPenny {
+		t.Fatalf("the trinket should leave the hand: %v", p.Hand)
+	}
+	if b.eventStack.size != 1 || p.lootPlaysLeft() != 0 {
+		t.Fatalf("the trinket should go on the stack and use up the loot play: stack of %d, %d plays left", b.eventStack.size, p.lootPlaysLeft())
+	}
+	if _, err := p.getItemIndex(brokenAnkh, true); err == nil {
+		t.Error("the trinket shouldn't be an item before it resolves")
+	}
+	if err := (lootCard{baseCard: baseCard{id: brokenAnkh}, trinket: true}).activate(p, b); err == nil {
+		t.Error("a trinket that left the hand can't be played again")
+	}
+	if err := b.resolveNextEvent(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.getItemIndex(brokenAnkh, true); err != nil {
+		t.Error("the trinket should be an item once it resolves")
+	}
+	for _, a := range p.getPlayerActions(true, true, nil) {
+		if a.value == playLootCard {
+			t.Error("the player shouldn't have a loot play left")
+		}
+	}
+}
+
+func TestCancelAttackRefundsSpentAttacks(t *testing.T) {
+	b, _ := dealGame(t, 18)
+	p := &b.players[b.api]
+	m := b.monster.getActiveMonsters()[0]
+	attacks := p.attacksLeft()
+	b.eventStack.push(event{p: p, e: intentionToAttackEvent{m: m}})
+	if err := b.cancelAttack(true); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := b.eventStack.peek().event.e.(fizzledEvent); !ok {
+		t.Error("the attack on the stack should fizzle")
+	}
+	if p.attacksLeft() != attacks {
+		t.Errorf("an attack cancelled on the stack was never spent: %d attacks left, not %d", p.attacksLeft(), attacks)
+	}
+	b.eventStack.push(event{p: p, e: intentionToAttackEvent{m: m}})
+	if err := b.resolveNextEvent(); err != nil {
+		t.Fatal(err)
+	}
+	if b.combat == nil || p.attacksLeft() != attacks-1 {
+		t.Fatalf("the attack should start a battle and be spent: %d attacks left", p.attacksLeft())
+	}
+	if err := b.cancelAttack(true); err != nil {
+		t.Fatal(err)
+	}
+	if b.combat != nil || p.inBattle || m.inBattle || p.attacksLeft() != attacks {
+		t.Errorf("a cancelled battle should end and give its attack back: %d attacks left, not %d", p.attacksLeft(), attacks)
+	}
+	if err := b.cancelAttack(false); err == nil {
+		t.Error("there is no attack left to cancel")
+	}
+}
+
+func TestRollReactions(t *testing.T) {
+	monster := func(id uint16) monsterCard { return monsterCard{baseCard: baseCard{id: id}, ap: 1} }
+	for _, tt := range []struct {
+		id            uint16
+		roll          uint8
+		missed, dealt uint8 // The damage the monster deals on a miss, and takes from 2 damage on a hit
+	}{
+		{gaper, 1, 1, 2},
+		{leaper, 1, 2, 2},
+		{horf, 2, 2, 2},
+		{momsHeart, 2, 2, 2},
+		{polycephalus, 6, 1, 3},
+		{polycephalus, 5, 1, 2},
+		{pin, 6, 1, 0},
+		{flaminHopper, 5, 1, 0},
+		{carrionQueen, 5, 1, 0},
+		{carrionQueen, 6, 1, 2},
+	} {
+		m := monster(tt.id)
+		if n := m.missDamage(tt.roll); n != tt.missed {
+			t.Errorf("monster %d should deal %d damage when missed on a %d, not %d", tt.id, tt.missed, tt.roll, n)
+		}
+		if n := m.hitDamage(2, tt.roll); n != tt.dealt {
+			t.Errorf("monster %d should take %d damage when hit on a %d, not %d", tt.id, tt.dealt, tt.roll, n)
+		}
+	}
+	if n := monster(polycephalus).hitDamage(1, 0); n != 1 {
+		t.Errorf("damage that isn't an attack roll shouldn't get a bonus, got %d", n)
+	}
+}
+
+func TestDamageIsPublishedOnceDealt(t *testing.T) {
+	b, _ := dealGame(t, 18)
+	p := &b.players[b.api]
+	var topics []Topic
+	b.Subscribe(func(n Notification) { topics = append(topics, n.Topic) }, DamageDealt, MonsterKilled)
+	m := b.monster.getActiveMonsters()[0]
+	b.eventStack.push(event{p: p, e: damageEvent{target: m, n: m.hp}})
+	if err := b.resolveNextEvent(); err != nil {
+		t.Fatal(err)
+	}
+	if len(topics) < 2 || topics[0] != DamageDealt || topics[1] != MonsterKilled {
+		t.Fatalf("the damage should be published before the monster is killed: %v", topics)
+	}
+	topics = nil
+	b.eventStack.push(event{p: p, e: damageEvent{target: m, n: 1}})
+	if err := b.resolveNextEvent(); err != nil {
+		t.Fatal(err)
+	}
+	for _, topic := range topics {
+		if topic == DamageDealt {
+			t.Error("a monster that left play takes no damage")
+		}
+	}
+}
+
+func TestNewGameConsoleIsTheBoards(t *testing.T) {
+	b := NewGame(2, false, false)
+	if b.ui == nil || b.ui.b != b {
+		t.Fatal("the console should print and ask for the board that is returned")
+	}
+}
+
+func TestTUIShowsWhatTheHolderMaySee(t *testing.T) {
+	b, _ := dealGame(t, 18)
+	me, other := &b.players[0], &b.players[1]
+	tui := NewTUI(nil, nil, 120, 40)
+	tui.current = me.Character.name
+	tui.ShowTo(b, me.Character.name, "You peeked at the loot deck.")
+	tui.ShowTo(b, other.Character.name, "Their secret.")
+	tui.Show(b, "Everyone sees this.")
+	if lines := tui.logLines(me.Character.name); len(lines) != 2 || lines[0] != "You peeked at the loot deck." {
+		t.Errorf("the holder should see what is for them and everyone: %v", lines)
+	}
+	b.revealTop(zone{kind: lootDeckZone}, 1, me)
+	view := b.ViewFor(tui.current)
+	board := strings.Join(tui.boardLines(b, view), "\n")
+	if top := b.loot.deck[len(b.loot.deck)-1].getName(); !strings.Contains(board, "On top: "+top) {
+		t.Errorf("the card the holder peeked at should be shown: %s", board)
+	}
+	players := strings.Join(tui.playerLines(view), "\n")
+	if !strings.Contains(players, "Hand: "+me.Hand[0].name) || strings.Contains(players, "Hand: "+other.Hand[0].name) {
+		t.Errorf("only the holder's hand should be shown: %s", players)
+	}
+}
+
+func TestHotSeatHidesWhatIsPrivateUntilTheHandoff(t *testing.T) {
+	b, err := NewGameWithOptions(GameOptions{Players: 2, Seed: 2}) // Seed 2 deals Eden and Isaac
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out bytes.Buffer
+	hs := NewHotSeat(strings.NewReader("\n1\n"), &out) // Take the terminal, then choose an item
+	b.SetDecider(hs)
+	top := b.treasure.deck[len(b.treasure.deck)-1].getName()
+	if err := b.chooseStartingItems(); err != nil {
+		t.Fatal(err)
+	}
+	handoff, item := strings.Index(out.String(), "Pass the terminal to Eden."), strings.Index(out.String(), top)
+	if handoff < 0 || item < handoff {
+		t.Fatalf("the items Eden chooses from should only be shown once they took the terminal: %q", out.String())
+	}
+	if hs.current != "Eden" {
+		t.Fatalf("Eden should hold the terminal, not %q", hs.current)
+	}
+	out.Reset()
+	hs.ShowTo(b, "Isaac", "Isaac's hand.")
+	hs.ShowTo(b, "Eden", "Eden's hand.")
+	if out.String() != "Eden's hand." {
+		t.Errorf("only what is for the holder should be shown: %q", out.String())
+	}
+}
+
+func TestEventTargetsAreFoundById(t *testing.T) {
+	b, _ := dealGame(t, 18, 1) // Soul Heart prevents the second damage listed
+	p, other := &b.players[0], &b.players[1]
+	b.eventStack.push(event{p: p, e: damageEvent{target: p, n: 2}})
+	b.eventStack.push(event{p: p, e: damageEvent{target: other, n: 2}})
+	f, _, err := soulHeartFunc(p, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.eventStack.push(event{p: p, e: diceRollEvent{n: 3}}) // Responding doesn't move the target
+	f(0, false)
+	var prevented *eventNode
+	for curr := b.eventStack.head.top; curr != nil; curr = curr.next {
+		if e, ok := curr.event.e.(damageEvent); ok && e.target == p {
+			prevented = curr
+		}
+	}
+	if e, ok := prevented.event.e.(damageEvent); !ok || e.n != 1 || e.target != p {
+		t.Errorf("Soul Heart should prevent 1 of the damage to %s, and keep its target: %+v", p.Character.name, prevented.event.e)
+	}
+
+	b, _ = dealGame(t, 18)
+	p = &b.players[0]
+	b.eventStack.push(event{p: p, e: lootCardEvent{l: lootCardFor(t, swallowedPenny), f: func(uint8, bool) {}}})
+	g, _, err := butterBeanFunc(p, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	g(0, false) // The loot card is alone on the stack: nothing spawned from it
+	if _, ok := b.eventStack.peek().event.e.(fizzledEvent); !ok {
+		t.Error("Butter Bean should cancel the loot card")
+	}
+
+	b.eventStack.push(event{p: p, e: diceRollEvent{n: 3}})
+	h, _, err := diceShardFunc(p, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := b.resolveNextEvent(); err != nil {
+		t.Fatal(err)
+	}
+	h(0, false) // The roll resolved before it could be rerolled
+}
+
+func TestTriggersAreOrderedByPlayer(t *testing.T) {
+	b, s := dealGame(t, 18, 1) // The active player resolves their second trigger first
+	ap, other := &b.players[b.api], &b.players[(b.api+1)%2]
+	triggers := []event{
+		{p: other, e: damageEvent{n: 1}},
+		{p: ap, e: damageEvent{n: 2}},
+		{p: ap, e: diceRollEvent{n: 5}}, // The roll of the trigger before it
+		{p: nil, e: damageEvent{n: 3}},
+		{p: ap, e: nil}, // A trigger whose condition wasn't met
+		{p: ap, e: damageEvent{n: 4}},
+	}
+	var order []uint8
+	for _, e := range b.orderTriggeredEvents(triggers) {
+		switch ev := e.e.(type) {
+		case damageEvent:
+			order = append(order, ev.n)
+		case diceRollEvent:
+			order = append(order, 10*ev.n)
+		}
+	}
+	if want := []uint8{2, 50, 4, 1, 3}; fmt.Sprint(order) != fmt.Sprint(want) {
+		t.Errorf("the active player's triggers should be pushed first, in the order they chose, then the others': %v, not %v", order, want)
+	}
+	if len(s.asked) != 1 || s.asked[0].Player != ap.Character.name {
+		t.Errorf("only a player with several triggers chooses their order: %+v", s.asked)
+	}
+}
+
+func TestEmptyDecksAreReshuffled(t *testing.T) {
+	b, _ := dealGame(t, 18)
+	var shuffled []Notification
+	b.Subscribe(func(n Notification) { shuffled = append(shuffled, n) }, DeckReshuffled)
+	for i, pair := range []struct{ deck, discard *deck }{
+		{&b.loot.deck, &b.loot.discardPile},
+		{&b.monster.deck, &b.monster.discardPile},
+		{&b.treasure.deck, &b.treasure.discardPile},
+	} {
+		*pair.discard = append((*pair.discard)[:0], (*pair.deck)[:3]...)
+		*pair.deck = (*pair.deck)[:0]
+		if _, err := b.drawTopCard(i + 1); err != nil {
+			t.Fatalf("deck %d should be reshuffled from its discard pile: %v", i+1, err)
+		}
+		if len(*pair.deck) != 2 || len(*pair.discard) != 0 {
+			t.Errorf("deck %d should hold what is left of its discard pile: %d in the deck, %d discarded", i+1, len(*pair.deck), len(*pair.discard))
+		}
+		*pair.deck = (*pair.deck)[:0]
+		if _, err := b.drawTopCard(i + 1); !errors.Is(err, ErrEmptyDeck) {
+			t.Errorf("deck %d has nothing left to draw: %v", i+1, err)
+		}
+	}
+	if len(shuffled) != 3 || shuffled[0].Deck != "Loot" || shuffled[1].Deck != "Monster" || shuffled[2].Deck != "Treasure" || shuffled[0].Amount != 3 {
+		t.Errorf("each reshuffle should be published once, with its deck and its number of cards: %v", shuffled)
+	}
+}
+
+func TestReadingACardIsFree(t *testing.T) {
+	b, s := dealGame(t, 18)
+	p := &b.players[(b.api+1)%2]
+	p.addEffect(theEmpress, nil, untilEndOfTurn)
+	read, pass, effects := -1, -1, -1
+	for i, a := range p.getPlayerActions(false, true, nil) {
+		switch a.value {
+		case readCard:
+			read = i
+		case viewEffects:
+			effects = i
+		case doNothing:
+			pass = i
+		}
+	}
+	if read < 0 || pass < 0 || effects < 0 {
+		t.Fatal("a player with priority may read a card, look at the effects or pass")
+	}
+	s.answers = []int{read, 0, effects, pass}
+	if p.makeChoice(b) {
+		t.Error("reading a card and looking at the effects isn't an action: the player passed")
+	}
+	if len(s.asked) != 4 || !strings.Contains(s.asked[1].Text, "Read which card?") {
+		t.Fatalf("the player should be asked again after reading a card: %+v", s.asked)
+	}
+	for _, o := range s.asked[0].Options {
+		if o.Inert != (o.Value == read || o.Value == effects) {
+			t.Errorf("only reading a card and looking at the effects are inert: %+v", o)
+		}
+	}
+	bot := NewBot(1)
+	d := Decision{Min: 0, Max: 2, Options: []Option{{Value: 0, Inert: true}, {Value: 1}, {Value: 2, Inert: true}}}
+	for i := 0; i < 20; i++ {
+		if n, _ := bot.Decide(b, d); n != 1 {
+			t.Fatalf("a bot should never pick an inert answer, picked %d", n)
+		}
+	}
+}
+
+// Find a treasure card by id among every treasure card.
+func treasureCardFor(t *testing.T, id uint16) treasureCard {
+	t.Helper()
+	c, _, err := getTreasureCards(true, true).search(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c.(treasureCard)
+}
+
+func TestChaosPassesItemsOnly(t *testing.T) {
+	b, _ := dealGame(t, 18)
+	p, next := &b.players[0], &b.players[1]
+	starting := p.getAllItems(true)
+	if len(starting) != 1 || !starting[0].isEternal() {
+		t.Fatalf("%s should start with one eternal item: %v", p.Character.name, starting)
+	}
+	for _, id := range []uint16{boomerang, breakfast} {
+		if err := p.addCardToBoard(treasureCardFor(t, id)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	p.gainSoul(p.Character)
+	p.gainSoul(b.monster.getActiveMonsters()[0])
+	health, nextHealth := p.getMaxHealth(), next.getMaxHealth()
+	if err := b.chaos(); err != nil {
+		t.Fatal(err)
+	}
+	for _, id := range []uint16{boomerang, breakfast} {
+		if _, err := next.getItemIndex(id, id == breakfast); err != nil {
+			t.Errorf("%s should get item %d from %s", next.Character.name, id, p.Character.name)
+		}
+	}
+	if _, err := p.getItemIndex(starting[0].getId(), starting[0].isPassive()); err != nil {
+		t.Error("an eternal item never changes hands")
+	}
+	if p.getMaxHealth() != health-1 || next.getMaxHealth() != nextHealth+1 || p.Character.hp > p.getMaxHealth() {
+		t.Errorf("Breakfast's health should follow it: %d and %d", p.getMaxHealth(), next.getMaxHealth())
+	}
+	if len(p.Souls) != 2 {
+		t.Errorf("Chaos doesn't move souls: %s has %d", p.Character.name, len(p.Souls))
+	}
+
+	err := b.exchangeCards([]cardExchange{{from: p, to: next, souls: append([]soul{}, p.Souls...)}})
+	if !errors.Is(err, ErrInvalidTarget) {
+		t.Errorf("a character's own soul can't change hands: %v", err)
+	}
+	if len(p.Souls) != 1 || p.Souls[0].origin != characterSoul || len(next.Souls) != 1 {
+		t.Errorf("the other souls should change hands anyway: %v and %v", p.Souls, next.Souls)
+	}
+}
+
+func TestStatsAreDerivedFromTheCardsInPlay(t *testing.T) {
+	b, _ := dealGame(t, 18)
+	p := &b.players[0]
+	p.ActiveItems, p.PassiveItems, p.Curses = nil, nil, nil
+	p.Pennies, p.numAttackRolls = 3, 0
+	ap, health := p.Character.ap, p.Character.baseHealth
+	for _, c := range []card{lootCardFor(t, curvedHorn), treasureCardFor(t, brimstone), treasureCardFor(t, lard), treasureCardFor(t, steamySale)} {
+		if err := p.addCardToBoard(c); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if p.getAttack() != ap+2 || p.getMaxHealth() != health+2 || p.getShopCost() != shopCost-5 {
+		t.Errorf("the items should add to the stats: attack %d, health %d, cost %d", p.getAttack(), p.getMaxHealth(), p.getShopCost())
+	}
+	if n := p.modifyRoll(4, true); n != 3 {
+		t.Errorf("Lard should lower an attack roll of 4 to 3, not %d", n)
+	}
+	if n := p.modifyRoll(4, false); n != 4 {
+		t.Errorf("Lard only lowers attack rolls: 4 became %d", n)
+	}
+	p.numAttackRolls = 1
+	if p.getAttack() != ap+1 {
+		t.Errorf("Curved Horn only adds to the first attack of the turn: attack %d", p.getAttack())
+	}
+	p.numAttackRolls = 0
+	if i, err := p.getItemIndex(brimstone, true); err != nil {
+		t.Fatal(err)
+	} else if _, err := p.popItemByIndex(uint8(i), true); err != nil {
+		t.Fatal(err)
+	}
+	if p.getAttack() != ap+1 {
+		t.Errorf("an item that leaves play stops adding to the stats: attack %d", p.getAttack())
+	}
+	p.addEffect(creditCard, nil, untilEndOfTurn)
+	if p.getShopCost() != 0 {
+		t.Errorf("a Credit Card makes the next item free, not %d", p.getShopCost())
+	}
+
+	if err := p.addCardToBoard(treasureCardFor(t, emptyVessel)); err != nil {
+		t.Fatal(err)
+	}
+	p.Hand, p.Pennies = nil, 0
+	if p.getAttack() != ap+2 || p.modifyRoll(4, true) != 4 {
+		t.Errorf("Empty Vessel adds to the attack and its roll while its conditions hold: attack %d", p.getAttack())
+	}
+}
+
+func TestEffectsExpireAtTurnBoundaries(t *testing.T) {
+	b, _ := dealGame(t, 18)
+	ap, other := &b.players[b.api], &b.players[(b.api+1)%2]
+	api := b.api
+	ap.addEffect(theEmpress, nil, untilEndOfTurn)
+	ap.addEffect(theSun, nil, nextTime)
+	other.addEffect(theHaunt, nil, untilNextTurn)
+	b.loot.effects.add(effect{id: compost, source: treasureCardFor(t, compost), d: nextTime})
+	discarded := lootCardFor(t, swallowedPenny)
+	b.loot.discard(discarded)
+	if views := b.Effects(); len(views) != 4 || views[0].Source != "III. The Empress" || views[0].Owner != ap.Character.name || views[3].Owner != "" {
+		t.Fatalf("every effect in play should be listed with its owner: %+v", views)
+	}
+
+	b.eventStack.push(event{p: ap, e: endTurnEvent{}})
+	if err := b.resolveNextEvent(); err != nil {
+		t.Fatal(err)
+	}
+	if ap.hasEffect(theEmpress) || ap.hasEffect(theSun) || !other.hasEffect(theHaunt) {
+		t.Error("only the effects until the end of the turn, and The Sun it used, should be gone")
+	}
+	if b.api != api {
+		t.Fatal("The Sun gives its player another turn")
+	}
+
+	b.eventStack.push(event{p: other, e: startOfTurnEvent{}})
+	if err := b.resolveNextEvent(); err != nil {
+		t.Fatal(err)
+	}
+	if other.hasEffect(theHaunt) {
+		t.Error("an effect until its owner's next turn should expire when it starts")
+	}
+	if hand := other.Hand; hand[len(hand)-1].id != swallowedPenny || len(b.Effects()) != 0 {
+		t.Errorf("Compost should be used up by the next loot, which takes the discarded card: %v", b.Effects())
+	}
+}
+
+// Draw monster cards until one is a monster, not a bonus card.
+func drawMonster(t *testing.T, b *Board) monsterCard {
+	t.Helper()
+	for {
+		m, err := b.monster.draw()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !m.isBonusCard() {
+			m.resetStats()
+			return m
+		}
+	}
+}
+
+func TestCoveredMonstersReemerge(t *testing.T) {
+	b, _ := dealGame(t, 18)
+	p := &b.players[b.api]
+	zone := &b.monster.zones[0]
+	covered := zone.peek().id
+	zone.peek().hp -= 1
+	overlay := drawMonster(t, b)
+	zone.push(overlay)
+	for _, m := range b.monster.getActiveMonsters() {
+		if m.id == covered {
+			t.Fatal("a covered monster isn't active")
+		}
+	}
+	b.killMonster(p, overlay.id)
+	if zone.Size() != 1 || zone.peek().id != covered || zone.peek().hp != zone.peek().baseHealth {
+		t.Errorf("the covered monster should re-emerge unharmed: %+v", *zone)
+	}
+	for b.eventStack.size > 0 { // The rewards
+		b.eventStack.pop()
+	}
+
+	b.killMonster(p, zone.peek().id)
+	b.monster.addZone()
+	zones := len(b.monster.zones)
+	if !zone.isEmpty() || !b.monster.zones[zones-1].isEmpty() {
+		t.Fatal("a zone stays empty until the field is checked")
+	}
+	b.eventStack.push(event{p: p, e: diceRollEvent{n: 3}})
+	b.checkTheField()
+	if !zone.isEmpty() {
+		t.Error("the field isn't refilled while the stack isn't empty")
+	}
+	for i := 0; i < zones && b.eventStack.size > 0; i++ { // A bonus card revealed by the refill pauses it
+		for b.eventStack.size > 0 {
+			b.eventStack.pop()
+		}
+		b.checkTheField()
+	}
+	for i, z := range b.monster.zones {
+		if z.isEmpty() {
+			t.Errorf("zone %d of %d should be refilled once the stack is empty", i, zones)
+		}
+	}
+}
+
+func TestCardsArePlacedOnTopOrAtTheBottom(t *testing.T) {
+	b, _ := dealGame(t, 18)
+	for _, tc := range []struct {
+		c     card
+		deck  *deck
+		onTop bool
+	}{
+		{lootCardFor(t, swallowedPenny), &b.loot.deck, true},
+		{lootCardFor(t, swallowedPenny), &b.loot.deck, false},
+		{monsterCardFor(t, chest), &b.monster.deck, true},
+		{monsterCardFor(t, chest), &b.monster.deck, false},
+		{treasureCardFor(t, breakfast), &b.treasure.deck, true},
+		{treasureCardFor(t, breakfast), &b.treasure.deck, false},
+	} {
+		l := len(*tc.deck)
+		b.placeInDeck(tc.c, tc.onTop)
+		i := 0
+		if tc.onTop { // The top of a deck is the end of its slice
+			i = l
+		}
+		if len(*tc.deck) != l+1 || (*tc.deck)[i].getId() != tc.c.getId() {
+			t.Errorf("%s should be placed at index %d of %d, on top: %t", tc.c.getName(), i, l+1, tc.onTop)
+		}
+		if c, err := tc.deck.popByIndex(uint8(i)); err != nil || c.getId() != tc.c.getId() {
+			t.Fatalf("%s should be taken back out: %v", tc.c.getName(), err)
+		}
+	}
+}
+
+func TestCardsArePoppedByIndex(t *testing.T) {
+	ids := []uint16{swallowedPenny, brokenAnkh, cainsEye, goldenHorseShoe}
+	for _, tc := range []struct {
+		name string
+		i    uint8
+		left []uint16
+	}{
+		{"bottom", 0, []uint16{brokenAnkh, cainsEye, goldenHorseShoe}},
+		{"middle", 2, []uint16{swallowedPenny, brokenAnkh, goldenHorseShoe}},
+		{"top", 3, []uint16{swallowedPenny, brokenAnkh, cainsEye}},
+	} {
+		d := make(deck, 0, len(ids))
+		for _, id := range ids {
+			d.append(lootCardFor(t, id))
+		}
+		c, err := d.popByIndex(tc.i)
+		if err != nil || c.getId() != ids[tc.i] {
+			t.Errorf("%s: card %d should be popped: %v, %v", tc.name, ids[tc.i], c, err)
+			continue
+		}
+		left := make([]uint16, len(d))
+		for i, c := range d {
+			left[i] = c.getId()
+		}
+		if fmt.Sprint(left) != fmt.Sprint(tc.left) {
+			t.Errorf("%s: the others should keep their order: %v, not %v", tc.name, left, tc.left)
+		}
+	}
+	d := deck{lootCardFor(t, swallowedPenny)}
+	if _, err := d.popByIndex(1); err == nil || len(d) != 1 {
+		t.Error("there is no card past the top of the deck")
+	}
+}
+
+func TestBonusCardsAreDiscardedOnceResolved(t *testing.T) {
+	for _, id := range []uint16{chest, goldChest, darkChest} {
+		b, _ := dealGame(t, 18)
+		p := &b.players[b.api]
+		bonus := monsterCardFor(t, id)
+		discarded := len(b.monster.discardPile)
+		if err := bonus.activate(p, b); err != nil {
+			t.Fatal(err)
+		}
+		if len(b.monster.discardPile) != discarded || b.eventStack.isEmpty() {
+			t.Fatalf("%s should be on the stack, not discarded yet", bonus.name)
+		}
+		for !b.eventStack.isEmpty() {
+			if err := b.resolveNextEvent(); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if top, err := b.monster.discardPile.peek(); err != nil || top.getId() != id || len(b.monster.discardPile) != discarded+1 {
+			t.Errorf("%s should be discarded once it resolved: %v", bonus.name, top)
+		}
+	}
+}
+
+func TestOverlaysListEveryZone(t *testing.T) {
+	b, s := dealGame(t, 18, 0)
+	b.monster.zones[0].pop()
+	if zone := b.monster.chooseZoneHelper(b, "Overlay over which monster?"); zone != 0 {
+		t.Errorf("the empty zone should be chosen, not zone %d", zone)
+	}
+	if len(s.asked) != 1 || len(s.asked[0].Options) != len(b.monster.zones) || s.asked[0].Options[0].Label != "(empty)" {
+		t.Errorf("every zone should be listed, empty ones too: %+v", s.asked)
+	}
+}
+
+func TestDeathsHeadWithersAtTheEndOfEachTurn(t *testing.T) {
+	b, _ := dealGame(t, 18)
+	zone := &b.monster.zones[0]
+	dh := monsterCardFor(t, deathsHead)
+	dh.resetStats()
+	zone.push(dh)
+	cents := func() (n int8) {
+		for _, p := range b.players {
+			n += p.Pennies
+		}
+		return n
+	}
+	before := cents()
+	for turn := 1; turn <= 2; turn++ {
+		p := b.getActivePlayer()
+		b.eventStack.push(event{p: p, e: endTurnEvent{}})
+		if _, err := b.resolveStack(p); err != nil {
+			t.Fatal(err)
+		}
+		if turn == 1 && (zone.peek().id != deathsHead || zone.peek().hp != 1) {
+			t.Fatalf("Death's Head should take 1 damage at the end of the turn: %+v", *zone.peek())
+		}
+	}
+	if _, m := b.monster.getActiveMonster(deathsHead); m != nil {
+		t.Fatal("Death's Head should die at the end of its second turn")
+	}
+	if top, err := b.monster.discardPile.peek(); err != nil || top.getId() != deathsHead || cents() != before+5 {
+		t.Errorf("Death's Head should be discarded and give its 5¢: %v, %d¢ gained", top, cents()-before)
+	}
+}
+
+func TestShopPurchases(t *testing.T) {
+	b, _ := dealGame(t, 18)
+	p := &b.players[b.api]
+	shop := b.treasure
+	zones, deck := len(shop.zones), len(shop.deck)
+	canBuy := func() bool {
+		for _, a := range p.getPlayerActions(true, true, nil) {
+			if a.value == buyItem {
+				return true
+			}
+		}
+		return false
+	}
+	p.Pennies, p.numPurchases = shopCost-1, purchasesPerTurn
+	first := shop.zones[0]
+	if err := shop.buyFromShop(p, 0); !errors.Is(err, ErrIllegalAction) || canBuy() {
+		t.Errorf("an item costs %d¢: %v", shopCost, err)
+	}
+	if shop.zones[0].id != first.id || p.Pennies != shopCost-1 {
+		t.Error("a failed purchase leaves the shop and the player untouched")
+	}
+
+	p.Pennies = shopCost
+	if !canBuy() {
+		t.Error("a player with just enough cents may buy an item")
+	}
+	if err := shop.buyFromShop(p, 0); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.getItemIndex(first.id, first.passive); err != nil || p.Pennies != 0 || shop.zones[0].id != 0 {
+		t.Errorf("%s should be bought, and its zone left empty until the field is checked", first.name)
+	}
+	if err := shop.buyFromShop(p, 0); !errors.Is(err, ErrInvalidTarget) {
+		t.Errorf("an empty zone has nothing to buy: %v", err)
+	}
+
+	if err := p.addCardToBoard(treasureCardFor(t, steamySale)); err != nil {
+		t.Fatal(err)
+	}
+	p.Pennies = shopCost / 2
+	if err := shop.buyFromShop(p, uint8(zones)); err != nil {
+		t.Fatal(err)
+	}
+	if len(shop.deck) != deck-1 || p.Pennies != 0 {
+		t.Errorf("the top of the deck should be bought at Steamy Sale's price: %d¢ left", p.Pennies)
+	}
+
+	shop.addZones(1)
+	shop.refill()
+	for i, tc := range shop.zones {
+		if tc.id == 0 {
+			t.Errorf("shop zone %d of %d should be refilled", i, len(shop.zones))
+		}
+	}
+}
+
+// Find a monster card by id among every monster, bonus and curse card.
+func monsterCardFor(t *testing.T, id uint16) monsterCard {
+	t.Helper()
+	c, _, err := getMonsterCards(true, true).search(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c.(monsterCard)
+}
+
+func TestSoulsCarryTheirValue(t *testing.T) {
+	b, _ := dealGame(t, 18)
+	p, other := &b.players[0], &b.players[1]
+	p.gainSoul(monsterCardFor(t, mom))
+	p.gainSoul(lootCardFor(t, lostSoul))
+	p.gainSoul(p.Character)
+	for i, want := range []soul{{value: 2, origin: bossSoul}, {value: 1, origin: lootSoul}, {value: 1, origin: characterSoul}} {
+		if s := p.Souls[i]; s.value != want.value || s.origin != want.origin {
+			t.Errorf("%s should be a %s soul worth %d, not a %s soul worth %d", s.getName(), want.origin, want.value, s.origin, s.value)
+		}
+	}
+	if p.soulValue() != 4 || len(checkVictory(b.players)) != 1 {
+		t.Fatalf("Mom counts as 2 souls: %s has %d", p.Character.name, p.soulValue())
+	}
+
+	if won, err := other.stealSoul(p, 0); err != nil || won || other.soulValue() != 2 || p.soulValue() != 2 {
+		t.Errorf("a stolen soul keeps its value: %d and %d", other.soulValue(), p.soulValue())
+	}
+	if _, err := other.stealSoul(p, 9); !errors.Is(err, ErrInvalidTarget) {
+		t.Errorf("there is no soul to steal: %v", err)
+	}
+	discarded := len(b.loot.discardPile)
+	if err := b.destroySoul(p, 0); err != nil || len(b.loot.discardPile) != discarded+1 {
+		t.Errorf("a destroyed soul goes to the discard pile it came from: %v", err)
+	}
+
+	p.Curses = append(p.Curses, monsterCardFor(t, curseOfLoss))
+	if p.soulsToWin() != soulsToWin+1 {
+		t.Errorf("Curse of Loss takes one more soul to win, not %d", p.soulsToWin())
+	}
+}
+
+func TestCurseLifecycle(t *testing.T) {
+	b, _ := dealGame(t, 18, 1, 1) // Give the curse to the next player, then destroy their second curse
+	p, target := &b.players[b.api], &b.players[(b.api+1)%2]
+	var topics []Topic
+	b.Subscribe(func(n Notification) { topics = append(topics, n.Topic) }, CurseGiven, CurseRemoved)
+	if _, err := b.revealCurse(p, monsterCardFor(t, mom)); !errors.Is(err, ErrInvalidCard) {
+		t.Errorf("Mom is not a curse: %v", err)
+	}
+	f, err := b.revealCurse(p, monsterCardFor(t, curseOfGreed))
+	if err != nil {
+		t.Fatal(err)
+	}
+	f(0)
+	if !target.hasCurse(curseOfGreed) || p.hasCurse(curseOfGreed) {
+		t.Fatal("the player who revealed the curse chooses who receives it")
+	}
+	if err := b.giveCurse(target, monsterCardFor(t, curseOfLoss)); err != nil {
+		t.Fatal(err)
+	}
+
+	destroy, err := b.destroyCurseHelper(b.getCursedPlayers())
+	if err != nil {
+		t.Fatal(err)
+	}
+	discarded := len(b.monster.discardPile)
+	if !destroy() || target.hasCurse(curseOfLoss) || !target.hasCurse(curseOfGreed) || len(b.monster.discardPile) != discarded+1 {
+		t.Error("the chosen curse should be destroyed into the monster discard pile")
+	}
+	if destroy() {
+		t.Error("a curse can only be destroyed once")
+	}
+
+	target.beforePayingPenalties(b)
+	if len(target.Curses) != 0 || len(b.monster.discardPile) != discarded+2 {
+		t.Errorf("a player who dies discards their curses: %v", target.Curses)
+	}
+	if want := []Topic{CurseGiven, CurseGiven, CurseRemoved, CurseRemoved}; fmt.Sprint(topics) != fmt.Sprint(want) {
+		t.Errorf("each curse given and removed should be published: %v", topics)
+	}
+}
+
+func TestViewsShowOnlyWhatThePlayerMaySee(t *testing.T) {
+	b, _ := dealGame(t, 18)
+	me, other := &b.players[0], &b.players[1]
+	hands := func(v BoardView) (mine, theirs []CardView) {
+		return v.Players[0].Hand, v.Players[1].Hand
+	}
+	mine, theirs := hands(b.ViewFor(me.Character.name))
+	if len(mine) != len(me.Hand) || len(theirs) != 0 || b.ViewFor(me.Character.name).Players[1].HandSize != len(other.Hand) {
+		t.Errorf("a player sees their own hand, and only counts the others': %v, %v", mine, theirs)
+	}
+	spectator := b.ViewFor("")
+	if mine, theirs = hands(spectator); len(mine)+len(theirs) != 0 || spectator.Viewer != "" || len(spectator.Monsters) != len(b.monster.zones) {
+		t.Error("a spectator sees only what is public")
+	}
+
+	b.revealHand(other, me)
+	if _, theirs = hands(b.ViewFor(me.Character.name)); len(theirs) != len(other.Hand) {
+		t.Errorf("a hand shown to the player stays visible to them: %v", theirs)
+	}
+
+	treasure := zone{kind: treasureDeckZone}
+	b.revealTop(treasure, 2, me)
+	top, second := b.treasure.deck[len(b.treasure.deck)-1], b.treasure.deck[len(b.treasure.deck)-2]
+	known := b.ViewFor(me.Character.name).Decks[2].Known
+	if len(known) != 2 || known[0].Id != top.getId() || known[0].Position != 0 || known[1].Id != second.getId() {
+		t.Fatalf("the player should know the 2 cards on top of the treasure deck: %+v", known)
+	}
+	if len(b.ViewFor(other.Character.name).Decks[2].Known) != 0 {
+		t.Error("the others weren't shown the deck")
+	}
+	if _, err := b.treasure.draw(); err != nil {
+		t.Fatal(err)
+	}
+	if known = b.ViewFor(me.Character.name).Decks[2].Known; len(known) != 1 || known[0].Id != second.getId() || known[0].Position != 0 {
+		t.Errorf("the player still knows the card left on top: %+v", known)
+	}
+	b.treasure.deck.shuffle(b.treasure.rng)
+	for _, cv := range b.ViewFor(me.Character.name).Decks[2].Known {
+		if b.treasure.deck[len(b.treasure.deck)-1-cv.Position].getId() != cv.Id {
+			t.Errorf("a shuffled deck can only show what is really there: %+v", cv)
+		}
+	}
+}
+
+func TestLobbyTables(t *testing.T) {
+	l := NewLobby()
+	o := GameOptions{Players: 2, Seed: 18}
+	if _, err := l.CreateTable("", o); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("a table needs a name: %v", err)
+	}
+	if _, err := l.CreateTable("empty", o); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := l.CreateTable("empty", o); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("table names are unique: %v", err)
+	}
+	if _, err := l.Join("empty", "ann", NewBot(1)); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.Leave("empty", "ann"); err != nil || len(l.Tables()) != 0 {
+		t.Errorf("a table everyone left is closed: %v", l.Tables())
+	}
+
+	// Two tables dealt with the same seed play the same game, side by side
+	tables := make([]*Table, 2)
+	for i, name := range []string{"first", "second"} {
+		var err error
+		if tables[i], err = l.CreateTable(name, o); err != nil {
+			t.Fatal(err)
+		}
+		if _, err = l.Join(name, "ann", NewBot(o.Seed)); err != nil {
+			t.Fatal(err)
+		}
+		if _, err = l.Join(name, "ann", NewBot(o.Seed)); !errors.Is(err, ErrInvalidInput) {
+			t.Errorf("a user sits at a table once: %v", err)
+		}
+		if err = l.AddBot(name); err != nil {
+			t.Fatal(err)
+		}
+		if _, err = l.Join(name, "bob", NewBot(o.Seed)); !errors.Is(err, ErrInvalidInput) {
+			t.Errorf("the table is full: %v", err)
+		}
+	}
+	if infos := l.Tables(); len(infos) != 2 || infos[0].Name != "first" || fmt.Sprint(infos[0].Seated) != "[ann Bot 1]" || infos[0].Open != 0 {
+		t.Fatalf("the tables should be listed by name, with who sits at them: %+v", infos)
+	}
+	for _, table := range tables {
+		if started, err := l.Ready(table.Name(), "ann"); err != nil || !started {
+			t.Fatalf("the game starts once everyone is ready: %v", err)
+		}
+		if _, err := l.Join(table.Name(), "bob", NewBot(o.Seed)); !errors.Is(err, ErrInvalidInput) {
+			t.Errorf("no one joins a game that started: %v", err)
+		}
+	}
+	first, err := tables[0].Wait()
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := tables[1].Wait()
+	if err != nil || fmt.Sprint(first) != fmt.Sprint(second) || len(first) == 0 {
+		t.Errorf("the games shouldn't share any state: %v won one, %v the other (%v)", first, second, err)
+	}
+}
+
+func TestRemoteSeatPolicies(t *testing.T) {
+	d := Decision{Text: "Pass?\n", Min: 0, Max: 2, Default: 2,
+		Options: []Option{{Value: 0, Inert: true}, {Value: 1}, {Value: 2, Inert: true}}}
+	for _, tt := range []struct {
+		policy DisconnectPolicy
+		want   int
+	}{
+		{AutoPass, d.Default},
+		{BotTakesOver, 1}, // The only answer a bot would pick
+	} {
+		rs, err := NewRemoteSeat(tt.policy, 10*time.Millisecond, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n, err := rs.Decide(nil, d); err != nil || n != tt.want {
+			t.Errorf("with %s, a seat no one came back to should answer %d, not %d (%v)", tt.policy, tt.want, n, err)
+		}
+	}
+
+	rs, err := NewRemoteSeat(WaitForPlayer, 10*time.Millisecond, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rs.sitAs("Isaac")
+	rs.Attach(strings.NewReader(""), io.Discard) // The connection drops at once
+	var out strings.Builder
+	go func() {
+		time.Sleep(30 * time.Millisecond) // Past the timeout: the seat still waits
+		rs.Attach(strings.NewReader("1\n"), &out)
+	}()
+	if n, err := rs.Decide(nil, d); err != nil || n != 1 {
+		t.Fatalf("the seat should wait for its player to come back and answer: %d (%v)", n, err)
+	}
+	if s := out.String(); !strings.Contains(s, "Welcome back, Isaac.") || !strings.Contains(s, "Pass?") {
+		t.Errorf("a player who comes back should be shown the decision they missed: %q", s)
+	}
+}
+
+func TestDecisionTimeouts(t *testing.T) {
+	b, err := NewGameWithOptions(GameOptions{Players: 2, Seed: 18, PriorityTimeout: 5 * time.Millisecond, ChoiceTimeout: 7 * time.Millisecond})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &scripted{}
+	b.SetDecider(s)
+	b.ui.readPriority(3, 4)
+	b.ui.readChoice(1, 0, 4)
+	n := b.ui.readDiscard(4)
+	if len(s.asked) != 3 {
+		t.Fatalf("every decision should be asked: %+v", s.asked)
+	}
+	if d := s.asked[0]; d.Kind != Priority || d.Timeout != 5*time.Millisecond || d.Default != 3 {
+		t.Errorf("priority should pass once its timeout runs out: %+v", d)
+	}
+	if d := s.asked[1]; d.Kind != Choice || d.Timeout != 7*time.Millisecond || d.Default != 1 {
+		t.Errorf("a choice should fall back to its default once its timeout runs out: %+v", d)
+	}
+	if d := s.asked[2]; d.Kind != Discard || d.Default != n || n < 0 || n > 3 {
+		t.Errorf("a discard should fall back to a card of the hand: %+v", d)
+	}
+
+	pr, pw := io.Pipe() // No answer ever comes
+	defer pw.Close()
+	if n, err := NewPromptDecider(pr, io.Discard).Decide(b, s.asked[0]); err != nil || n != 3 {
+		t.Errorf("an unanswered decision should be answered with its default: %d (%v)", n, err)
+	}
+
+	s.asked = nil
+	other := &b.players[(b.api+1)%2]
+	other.Character.tapped, other.ActiveItems = true, nil
+	if err := b.SetAutoPass(other.Character.name, true); err != nil {
+		t.Fatal(err)
+	}
+	if other.makeChoice(b) || len(s.asked) != 0 {
+		t.Errorf("a player on auto-pass who holds no response passes without being asked: %+v", s.asked)
+	}
+	if err := other.addCardToBoard(treasureCardFor(t, boomerang)); err != nil {
+		t.Fatal(err)
+	}
+	if other.makeChoice(b); len(s.asked) == 0 {
+		t.Error("a player on auto-pass who may respond is asked")
+	}
+}
+
+// A writer that can be read while a spectator writes to it.
+type syncBuffer struct {
+	mu sync.Mutex
+	sb strings.Builder
+}
+
+func (sb *syncBuffer) Write(p []byte) (int, error) {
+	sb.mu.Lock()
+	defer sb.mu.Unlock()
+	return sb.sb.Write(p)
+}
+
+func (sb *syncBuffer) String() string {
+	sb.mu.Lock()
+	defer sb.mu.Unlock()
+	return sb.sb.String()
+}
+
+func TestSpectatorsSeeThePublicFeedLater(t *testing.T) {
+	b, _ := dealGame(t, 18)
+	me := &b.players[0]
+	seats := NewSeats()
+	for i := range b.players {
+		seats.Sit(b.players[i].Character.name, &scripted{})
+	}
+	var out syncBuffer
+	delay := 50 * time.Millisecond
+	sp := NewSpectator(&out, delay)
+	seats.Watch(sp)
+	start := time.Now()
+	seats.Show(b, "Everyone sees this.\n")
+	seats.ShowTo(b, me.Character.name, "Only "+me.Character.name+" sees this.\n")
+	if _, err := seats.Decide(b, Decision{Player: me.Character.name, Max: 1}); err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != "" {
+		t.Error("a spectator is shown nothing before its delay runs out")
+	}
+	sp.Close()
+	<-sp.Gone()
+	s := out.String()
+	if time.Since(start) < delay || !strings.Contains(s, "Everyone sees this.") || !strings.Contains(s, "Turn: ") {
+		t.Errorf("a spectator should be shown what is public and the board, once its delay ran out: %q", s)
+	}
+	if strings.Contains(s, "Only ") || strings.Contains(s, me.Hand[0].name) {
+		t.Errorf("a spectator shouldn't see what is for a player: %q", s)
+	}
+	seats.Show(b, "After they left.\n")
+	if strings.Contains(out.String(), "After they left.") {
+		t.Error("a spectator who left is shown nothing more")
+	}
+}
+
+func TestEngineErrorsKeepTheirSentinel(t *testing.T) {
+	b, _ := dealGame(t, 18)
+	p := &b.players[b.api]
+	panics := func(v interface{}) error {
+		b.eventStack.push(event{p: p, e: activateEvent{f: func(uint8) { panic(v) }}})
+		return b.resolveNextEvent()
+	}
+	for _, tc := range []struct {
+		name string
+		err  func() error
+		want error
+	}{
+		{"empty deck", func() error {
+			b.loot.deck, b.loot.discardPile = b.loot.deck[:0], b.loot.discardPile[:0]
+			_, err := b.drawTopCard(1)
+			return err
+		}, ErrEmptyDeck},
+		{"illegal action", func() error {
+			p.Pennies = shopCost - 1
+			return b.treasure.buyFromShop(p, 0)
+		}, ErrIllegalAction},
+		{"invalid target", func() error {
+			_, err := b.drawTopCard(4)
+			return err
+		}, ErrInvalidTarget},
+		{"invalid input", func() error {
+			_, err := NewGameWithOptions(GameOptions{Players: 2, ChoiceTimeout: -1})
+			return err
+		}, ErrInvalidInput},
+		{"invalid card", func() error {
+			_, err := b.peekTrinketHelper(p, brokenAnkh)
+			return err
+		}, ErrInvalidCard},
+		{"no event", b.resolveNextEvent, ErrNoEvent},
+		{"recovered value", func() error {
+			var d deck
+			return panics(d)
+		}, ErrEngine},
+		{"recovered error", func() error {
+			return panics(fmt.Errorf("out of range"))
+		}, ErrEngine},
+		{"recovered engine error", func() error {
+			return panics(wrapError(ErrInvalidCard, "panicked"))
+		}, ErrInvalidCard},
+	} {
+		err := tc.err()
+		if !errors.Is(err, tc.want) {
+			t.Errorf("%s: %v should be %v", tc.name, err, tc.want)
+		}
+		if isFatal(err) != (tc.want == ErrInvalidInput || tc.want == ErrEngine) {
+			t.Errorf("%s: only invalid input and engine failures end the game: %v", tc.name, err)
+		}
+	}
+	b.eventStack.push(event{p: p, e: activateEvent{f: func(uint8) { var d deck; _ = d[len(d)] }}})
+	if _, err := b.resolveStack(p); !errors.Is(err, ErrEngine) {
+		t.Errorf("a panic in the middle of an effect should end the game: %v", err)
+	}
+}
@@ -3,19 +3,28 @@ package four_souls
 import (
 	"errors"
 	"fmt"
+	"math"
 	"math/rand"
 	"sort"
+	"strings"
 	"time"
 )
 
 // The main type that the game revolves around. Holds all major variables in one struct
 type Board struct {
-	players    []player   // All the players for the game.
-	loot       *lArea     // Loot deck and discard pile
-	monster    *mArea     // Monster deck, discard pile, and zones
-	treasure   *tArea     // Treasure deck, discard pile, and zones
-	eventStack eventStack // stack that will hold most state changing events, except monster deaths and rewards.
-	api        uint8      // Active Player Index: the index of the active player in players
+	players    []player        // All the players for the game.
+	loot       *lArea          // Loot deck and discard pile
+	monster    *mArea          // Monster deck, discard pile, and zones
+	treasure   *tArea          // Treasure deck, discard pile, and zones
+	eventStack eventStack      // stack that will hold most state changing events, except monster deaths and rewards.
+	api        uint8           // Active Player Index: the index of the active player in players
+	bus        *eventBus       // Publishes notifications to anything subscribed to the board
+	effects    *effectRegistry // Temporary effects in play
+	combat     *combat         // The battle in progress. nil if no one is attacking
+	ui         *console        // Shows the game to the players and asks them for their decisions
+	options    GameOptions     // How the game was set up
+	sightings  []sighting      // The cards of hidden zones that some players were shown
+	rng        *rand.Rand      // Shuffles the decks and rolls the dice of this game only
 }
 
 type actionReaction struct {
@@ -26,7 +35,10 @@ type actionReaction struct {
 // The area of the board designate for loot cards
 type lArea struct {
 	deck, discardPile deck
-	activeEffects     map[uint16]struct{}
+	effects           *effectRegistry // The board's temporary effects
+	bus               *eventBus
+	ui                *console
+	rng               *rand.Rand
 }
 
 // The area of the board designated for battle / monster cards and their zones.
@@ -34,6 +46,9 @@ type mArea struct {
 	deck, discardPile deck
 	zones             []activeSlot // active monsters will be on top of the stack. Overlayed monsters beneath them
 	theMidasTouch     map[*player]struct{}
+	bus               *eventBus
+	ui                *console
+	rng               *rand.Rand
 }
 
 // Type representing the player's board: their character, all items they control, money, souls, and their hand
@@ -42,34 +57,38 @@ type player struct {
 	ActiveItems          []treasureCard
 	PassiveItems         []passiveItem
 	Pennies              int8
-	Souls                []card // Character value (The Lost), tArea Cards, mArea Cards, Lost Soul loot value
+	Souls                []soul // Character value (The Lost), tArea Cards, mArea Cards, Lost Soul loot value
 	Curses               []monsterCard
 	Hand                 []lootCard
-	baseNumLootPlayed    int8
-	baseNumPurchases     int8
-	baseNumAttacks       int8
-	numLootPlayed        int8 // The number of times we could play a loot card.
-	numPurchases         int8 // The number of time we could numPurchases an itemCard
-	numAttacks           int8 // The number of times a new monster can be attacked.
-	inBattle             bool // Is the unit in combat or not?
-	forceAttackOnAny     bool // Determines if a player must attack SOME target
-	numForcedDeckAttacks int8 // If > 0, the player must attack the deck this many more times.
-	forceEnd             bool // Death, effects like Holy Card and The Beginning, can force an end to a turn.
-	activeEffects        map[uint16]struct{}
+	numLootPlayed        int8            // The number of times we could play a loot card, before any modifiers.
+	numPurchases         int8            // The number of time we could numPurchases an itemCard, before any modifiers.
+	numAttacks           int8            // The number of times a new monster can be attacked, before any modifiers.
+	numAttackRolls       int8            // The number of attack rolls made this turn.
+	inBattle             bool            // Is the unit in combat or not?
+	forceAttackOnAny     bool            // Determines if a player must attack SOME target
+	numForcedDeckAttacks int8            // If > 0, the player must attack the deck this many more times.
+	forceEnd             bool            // Death, effects like Holy Card and The Beginning, can force an end to a turn.
+	autoPass             bool            // Pass priority without being asked, while holding no response
+	effects              *effectRegistry // The board's temporary effects
+	bus                  *eventBus       // The board's event bus
+	ui                   *console        // The board's console
 }
 
 // The area of the board designated for the shop / treasure cards
 type tArea struct {
 	deck, discardPile deck
 	zones             []treasureCard
-	activeEffects     map[uint16]struct{}
 	crystalBallGuess  map[*player]uint8 // The guess of someone who used the Crystal Ball .
+	bus               *eventBus
+	ui                *console
+	rng               *rand.Rand
 }
 
 // Add a loot card (trinket), treasure card (active / passive) or a monster card (curse)
 // on the player's board.
 // TODO pass pointers to treasure cards all around to ensure pointer receivers
-func (p *player) addCardToBoard(c card) {
+func (p *player) addCardToBoard(c card) error {
+	var err error
 	switch c.(type) {
 	case treasureCard:
 		t := c.(treasureCard)
@@ -88,32 +107,31 @@ func (p *player) addCardToBoard(c card) {
 	case lootCard: // A trinket derived from the loot value deck.
 		lc, _ := c.(lootCard)
 		if lc.trinket == false {
-			panic("not a value that can be added to the board")
+			err = wrapError(ErrInvalidCard, "%s is not a trinket", lc.name)
 		} else {
-			p.addPassiveItem(lc, p.PassiveItems)
+			p.PassiveItems = p.addPassiveItem(lc, p.PassiveItems)
 		}
 	case monsterCard:
-		curses := map[uint16]struct{}{curseOfAmnesia: {}, curseOfGreed: {}, curseOfLoss: {}, curseOfPain: {},
-			curseOfTheBlind: {}, curseOfFatigue: {}, curseOfTinyHands: {}, curseOfBloodLust: {}, curseOfImpulse: {}}
 		mc := c.(monsterCard)
-		if _, ok := curses[mc.id]; ok {
+		if mc.isCurse {
 			p.Curses = append(p.Curses, mc)
+		} else {
+			err = wrapError(ErrInvalidCard, "%s is not a curse", mc.name)
 		}
 	default:
-		panic("not a valid card to add to the board")
+		err = wrapError(ErrInvalidCard, "%s cannot be added to the board", c.getName())
 	}
+	return err
 }
 
 func (b *Board) addMonsterToZone(i uint8) {
 	ap, m := &b.players[b.api], b.monster
-	card := m.draw()
+	card, err := m.draw()
+	if err != nil {
+		return
+	}
 	if card.isBonusCard() {
-		if f, special, err := card.f(ap, b, card); err == nil && f != nil {
-			b.eventStack.push(event{p: ap, e: triggeredEffectEvent{c: card, f: f}})
-			if special {
-				b.rollDiceAndPush()
-			}
-		}
+		_ = card.activate(ap, b)
 	} else {
 		card.resetStats()
 		m.zones[i].push(card)
@@ -142,82 +160,24 @@ func (p *player) addPassiveItem(c passiveItem, dest []passiveItem) []passiveItem
 	return dest
 }
 
-// Adds a card to the player's soul slice. If the player reached the number of souls to win,
-// return true. Else, return false
-func (p *player) addSoulToBoard(c card) bool {
-	p.Souls = append(p.Souls, c)
-	var victory bool
-	if len(p.Souls) == 4 {
-		victory = true
-	}
-	return victory
-}
-
-func (b *Board) battle(p *player, m *monsterCard, roll uint8) {
-	if roll >= m.roll { // successful hit
-		attack := p.Character.ap
-		if checkActiveEffects(p.activeEffects, curvedHorn, true) {
-			attack += 1
-		}
-		if checkActiveEffects(p.activeEffects, championBelt, true) {
-			attack += 1
-		}
-		if checkActiveEffects(p.activeEffects, polydactyly, true) {
-			attack += 1
-		}
-		if emptyVesselChecker(p, true) {
-			attack += 1
-		}
-		b.damagePlayerToMonster(p, m, attack, 0)
-	} else { // missed
-		damage := m.ap
-		if horfChecker(m.id, roll) {
-			damage += 1
-		} else if leaperChecker(m.id, roll) || momChecker(m.id, roll) {
-			damage *= 2
-		}
-		b.damageMonsterToPlayer(m, p, damage, roll)
-	}
-}
-
 func (p *player) beforePayingPenalties(b *Board) {
-	for i := range p.Curses {
-		b.discard(p.popCurse(uint8(i)))
-	}
+	b.discardCurses(p)
 	hauntIds := [3]uint16{babyHaunt, daddyHaunt, mamaHaunt}
 	if len(p.PassiveItems) > 0 {
 		others := b.getOtherPlayers(p, false)
 		for _, id := range hauntIds {
 			if i, err := p.getItemIndex(id, true); err == nil {
-				showPlayers(others, 0)
-				fmt.Println("Who to give", p.PassiveItems[i].getName(), "to?")
-				target := others[i]
-				target.addCardToBoard(p.popPassiveItem(i))
+				b.ui.showPlayers(others, 0)
+				b.ui.Println("Who to give", p.PassiveItems[i].getName(), "to?")
+				target := others[b.ui.readInput(0, len(others)-1)]
+				if c, err := p.popPassiveItem(i); err == nil {
+					target.addCardToBoard(c)
+				}
 			}
 		}
 	}
 }
 
-// Buy an itemCard from either the treasure zone or the top of the deck.
-// There are several items that will influence the purchasing process
-// Credit Card (Loot Item): Makes the cost of a single itemCard purchased 0
-func (t *tArea) buyFromShop(p *player, idx uint8) error {
-	var err = errors.New("not enough money to buy")
-	tCard := t.zones[idx]
-	var cost = steamySaleFunc(p)
-	if _, ok := t.activeEffects[creditCard]; ok {
-		cost = 0
-		delete(t.activeEffects, creditCard)
-	}
-	if p.Pennies >= cost {
-		p.loseCents(cost)
-		p.addCardToBoard(&tCard)
-		err = nil
-	}
-	t.zones[idx] = treasureCard{}
-	return err
-}
-
 // Add an itemCard's id to the player's active effects map to
 // instruct any card that requires an activation cost of n damage to a player
 // that the cost was met successfully.
@@ -231,12 +191,12 @@ func (p *player) checkDamageRequiredEffects(nextToResolve *eventNode) {
 			case lootCardEvent:
 				e := nextToResolve.event.e.(lootCardEvent)
 				if e.l.id == temperance {
-					p.activeEffects[temperance] = struct{}{}
+					p.addEffect(temperance, e.l, nextTime)
 				}
 			case activateEvent:
 				e := nextToResolve.event.e.(activateEvent)
 				if e.c.getId() == guppysPaw {
-					p.activeEffects[guppysPaw] = struct{}{}
+					p.addEffect(guppysPaw, e.c, nextTime)
 				}
 			}
 		}
@@ -250,20 +210,22 @@ func (p *player) checkDamageRequiredEffects(nextToResolve *eventNode) {
 // 1) If there are unfilled shop zones, fill them up with items from the
 // top of the treasure deck
 // 2) If there are unfilled monster zones, draw cards until the zone is filled.
+// This is the only place empty monster zones are refilled. Cards that remove
+// monsters leave the zone empty, or the covered monster active, until then.
 func (b *Board) checkTheField() []player {
 	if b.eventStack.size == 0 {
 		victors := checkVictory(b.players)
 		if len(victors) > 0 {
 			return victors
 		}
-		for i := range b.treasure.zones {
-			if b.treasure.zones[i].id == 0 { // No treasure value here
-				b.treasure.zones[i] = b.treasure.draw()
-			}
-		}
+		b.treasure.refill()
 		for i, _ := range b.monster.zones {
-			for len(b.monster.zones[i]) == 0 {
+			// A deck of nothing but bonus cards must not refill forever. Leave the zone empty instead.
+			// A revealed bonus card resolves before the refill goes on: the field is checked again once the stack is empty.
+			attempts := len(b.monster.deck) + len(b.monster.discardPile)
+			for len(b.monster.zones[i]) == 0 && attempts > 0 && b.eventStack.size == 0 {
 				b.addMonsterToZone(uint8(i))
+				attempts -= 1
 			}
 		}
 	}
@@ -283,9 +245,7 @@ func (b *Board) damageMonsterToPlayer(m *monsterCard, target *player, n uint8, c
 // Search the zone to make sure the monster is in play, then push damage to the monster.
 func (b *Board) damagePlayerToMonster(p *player, target *monsterCard, n uint8, combatRoll uint8) {
 	if !target.isDead() {
-		if (target.id == carrionQueen && combatRoll != 6) || (target.id == pin && combatRoll == 6) {
-			n = 0
-		}
+		n = target.hitDamage(n, combatRoll)
 		b.eventStack.push(event{p: p, e: damageEvent{target: target, n: n}, roll: combatRoll})
 		if target.id == theDukeOfFlies {
 			b.eventStack.push(event{p: p, e: triggeredEffectEvent{c: target, f: theDukeOfFliesEvent(b.eventStack.peek())}})
@@ -312,12 +272,17 @@ func (b *Board) damagePlayerToPlayer(p, target *player, n uint8) {
 // 3) Destroy one item
 // 4) Deactivate all items and character card
 func (p *player) deathPenalty(b *Board) {
+	if p.inBattle { // Dying ends the battle
+		b.endCombat()
+	}
 	p.beforePayingPenalties(b)
 	shadowActivated := shadowFunc(p, b)
 	if !shadowActivated { // The shadow is not in play. Resume deathPenalty normally
-		showLootCards(p.Hand, p.Character.name, 0)
-		fmt.Println("Discard one card.")
-		b.discard(p.popHandCard(uint8(readInput(0, len(p.Hand)-1))))
+		if len(p.Hand) > 0 { // an empty hand has nothing to discard
+			b.ui.showHand(p, 0)
+			b.ui.Println("Discard one card.")
+			b.discard(p.popHandCard(uint8(b.ui.readDiscard(len(p.Hand)))))
+		}
 		p.loseCents(1)
 	}
 	for _, c := range p.getActiveItems(true) {
@@ -355,16 +320,20 @@ func (p *player) destroyItem(b *Board, ic itemCard) {
 }
 
 func (p *player) destroyItemByIndex(b *Board, i uint8, isPassive bool) {
-	ic := p.popItemByIndex(i, isPassive)
+	ic, err := p.popItemByIndex(i, isPassive)
+	if err != nil {
+		return
+	}
 	if f := ic.getContinuousPassive(); f != nil {
 		f(p, b, ic, true)
-		if ic.getId() != theChest { // Does not go in the discard pile
-			b.discard(ic)
-		}
+	}
+	if ic.getId() != theChest { // Does not go in the discard pile
+		b.discard(ic)
 	}
 }
 
 func (b *Board) discard(c card) {
+	b.bus.publishCard(CardDiscarded, nil, c, 1)
 	switch c.(type) {
 	case lootCard:
 		b.loot.discard(c.(lootCard))
@@ -382,50 +351,130 @@ func (b *Board) discard(c card) {
 	}
 }
 
+// Shuffle the discard pile to become the new deck.
+func (l *lArea) reshuffle() {
+	if n := l.deck.reshuffleFrom(&l.discardPile, l.rng); n > 0 {
+		l.bus.publish(Notification{Topic: DeckReshuffled, Deck: "Loot", Amount: n})
+	}
+}
+
 func (l *lArea) discard(lc lootCard) {
 	l.discardPile = append(l.discardPile, lc)
 }
 
+// Shuffle the discard pile to become the new deck.
+func (m *mArea) reshuffle() {
+	if n := m.deck.reshuffleFrom(&m.discardPile, m.rng); n > 0 {
+		m.bus.publish(Notification{Topic: DeckReshuffled, Deck: "Monster", Amount: n})
+	}
+}
+
+// Add an empty monster zone. It is filled from the monster deck when the field is next checked.
+func (m *mArea) addZone() {
+	m.zones = append(m.zones, activeSlot{})
+}
+
 func (m *mArea) discard(mc *monsterCard) {
 	mc.resetStats()
 	m.discardPile = append(m.discardPile, *mc)
 }
 
+// Shuffle the discard pile to become the new deck.
+func (t *tArea) reshuffle() {
+	if n := t.deck.reshuffleFrom(&t.discardPile, t.rng); n > 0 {
+		t.bus.publish(Notification{Topic: DeckReshuffled, Deck: "Treasure", Amount: n})
+	}
+}
+
 func (t *tArea) discard(tc *treasureCard) {
 	tc.counters = 0
 	tc.tapped = false
 	t.discardPile = append(t.discardPile, *tc)
 }
 
-func (l *lArea) draw() lootCard {
+// Draw the top card of one of the three decks.
+// deckChoice int: 1) Loot deck 2) Monster deck 3) Treasure deck
+func (b *Board) drawTopCard(deckChoice int) (card, error) {
+	var c card
+	var err error
+	switch deckChoice {
+	case 1:
+		c, err = b.loot.draw()
+	case 2:
+		c, err = b.monster.draw()
+	case 3:
+		c, err = b.treasure.draw()
+	default:
+		err = wrapError(ErrInvalidTarget, "no deck %d", deckChoice)
+	}
+	if err != nil {
+		c = nil
+	}
+	return c, err
+}
+
+// If the deck is empty, the discard pile is shuffled to form a new deck first.
+// Fails with ErrEmptyDeck only if both the deck and the discard pile are empty.
+func (l *lArea) draw() (lootCard, error) {
+	var c lootCard
+	if len(l.deck) == 0 {
+		l.reshuffle()
+	}
 	card, err := l.deck.pop()
-	check(err)
-	return card.(lootCard)
+	if err == nil {
+		c = card.(lootCard)
+	} else {
+		err = wrapError(ErrEmptyDeck, "cannot draw a loot card")
+	}
+	return c, err
 }
 
-func (m *mArea) draw() monsterCard {
+// If the deck is empty, the discard pile is shuffled to form a new deck first.
+// Fails with ErrEmptyDeck only if both the deck and the discard pile are empty.
+func (m *mArea) draw() (monsterCard, error) {
+	var c monsterCard
+	if len(m.deck) == 0 {
+		m.reshuffle()
+	}
 	card, err := m.deck.pop()
-	check(err)
-	return card.(monsterCard)
+	if err == nil {
+		c = card.(monsterCard)
+	} else {
+		err = wrapError(ErrEmptyDeck, "cannot draw a monster card")
+	}
+	return c, err
 }
 
-func (t *tArea) draw() treasureCard {
+// If the deck is empty, the discard pile is shuffled to form a new deck first.
+// Fails with ErrEmptyDeck only if both the deck and the discard pile are empty.
+func (t *tArea) draw() (treasureCard, error) {
+	var c treasureCard
+	if len(t.deck) == 0 {
+		t.reshuffle()
+	}
 	card, err := t.deck.pop()
-	check(err)
-	return card.(treasureCard)
+	if err == nil {
+		c = card.(treasureCard)
+	} else {
+		err = wrapError(ErrEmptyDeck, "cannot draw a treasure card")
+	}
+	return c, err
 }
 
-// Resolve end of turn passive effects, be rid of any "until end of turn" effects
-// that would otherwise not be resolved by the reset method.
+// Expire the "until end of turn" effects, undoing those that changed the board.
 func (b *Board) endPhase() {
-	for _, p := range b.players {
-		checkActiveEffects(p.activeEffects, twoOfClubs, true)
-		if checkActiveEffects(p.activeEffects, diplopia, true) {
-			j, err := p.getItemIndex(diplopia, true)
-			if err == nil {
-				tc := treasureCard{baseCard: baseCard{name: "Diplopia", id: diplopia}, active: true, f: diplopiaFunc}
-				p.popPassiveItem(j)
-				p.addCardToBoard(&tc)
+	for _, e := range b.effects.endOfTurn() {
+		if e.id == diplopia && e.target != nil { // Diplopia turns back from the item it copied
+			for i := range b.players {
+				p := &b.players[i]
+				if p.Character.id != e.owner {
+					continue
+				}
+				if j, err := p.getItemIndex(e.target.getId(), true); err == nil {
+					tc := treasureCard{baseCard: baseCard{name: "Diplopia", effect: diplopiaDesc, id: diplopia}, active: true, f: diplopiaFunc}
+					p.popPassiveItem(j)
+					p.addCardToBoard(&tc)
+				}
 			}
 		}
 	}
@@ -439,17 +488,31 @@ func (b *Board) forceEndOfTurn() {
 	for b.eventStack.head != nil {
 		_ = b.eventStack.pop()
 	}
+	b.endCombat()
 }
 
 func (p *player) gainCents(n int8) {
 	if i, err := p.getItemIndex(bumbo, true); err == nil { // Do not gain cents! Put counters instead
 		p.bumboAddCounterHelper(p.PassiveItems[i].(*treasureCard), n)
 	} else {
+		if n > math.MaxInt8-p.Pennies { // The cents are counted in an int8
+			n = math.MaxInt8 - p.Pennies
+		}
 		p.Pennies += n
+		p.bus.publishCard(CentsChanged, p, nil, int(n))
 		counterfeitPennyChecker(p)
 	}
 }
 
+// Gain the top card of the treasure deck.
+func (p *player) gainTreasure(t *tArea) error {
+	tc, err := t.draw()
+	if err == nil {
+		err = p.addCardToBoard(tc)
+	}
+	return err
+}
+
 func (p player) isActivePlayer(b *Board) bool {
 	var ok bool
 	if p.Character.id == b.players[b.api].Character.id {
@@ -459,9 +522,13 @@ func (p player) isActivePlayer(b *Board) bool {
 }
 
 func (b *Board) killMonster(p *player, mId uint16) {
-	if i, err := b.monster.getActiveMonster(mId); err == nil {
+	if i, active := b.monster.getActiveMonster(mId); active != nil {
+		if active.inBattle { // The battle is won. The player may attack again if they have attacks left
+			b.endCombat()
+		}
 		m := b.monster.zones[i].pop()
 		m.resetStats()
+		b.bus.publishCard(MonsterKilled, p, m, 1)
 		if m.f != nil {
 			if f, _, err := m.f(p, b, m); err == nil { // on deathPenalty trigger
 				b.eventStack.push(event{p: p, e: triggeredEffectEvent{c: m, f: f}})
@@ -471,9 +538,9 @@ func (b *Board) killMonster(p *player, mId uint16) {
 			}
 		}
 		if m.isBoss {
-			b.players[b.api].addSoulToBoard(m)
+			b.players[b.api].gainSoul(m)
 			if mId == theHaunt {
-				checkActiveEffects(b.players[b.api].activeEffects, theHaunt, true)
+				b.effects.removeBySource(theHaunt)
 			}
 		} else if m.isBoss && mId == delirium {
 			deliriumDeathHandler(m, b.monster)
@@ -481,13 +548,16 @@ func (b *Board) killMonster(p *player, mId uint16) {
 			b.discard(m)
 		}
 		theMidasTouchHelper(b.monster)
-		rf, rollRequired := m.rf(b)
-		b.eventStack.push(event{p: p, e: monsterRewardEvent{r: rf}})
-		if rollRequired {
-			b.rollDiceAndPush()
+		if m.rf != nil { // curses and bonuses have no reward
+			rf, rollRequired := m.rf(b)
+			b.eventStack.push(event{p: p, e: monsterRewardEvent{r: rf}})
+			if rollRequired {
+				b.rollDiceAndPush()
+			}
+		}
+		if mId != stoney { // When another active monster dies, Stoney dies
+			b.killMonster(p, stoney)
 		}
-		b.killMonster(p, stoney)
-		b.killMonster(p, deathsHead)
 	}
 }
 
@@ -495,71 +565,122 @@ func (b *Board) killMonster(p *player, mId uint16) {
 // Here's the player's passive opportunity to prevent deathPenalty and end his / her turn
 func (b *Board) killPlayer(target *player) {
 	if !target.isDead() {
-		b.eventStack.push(event{p: target, e: deathOfCharacterEvent{}})
-		deathNode := b.eventStack.peek()
-		deathPrevention := [2]uint16{brokenAnkh, guppysCollar}
-		for _, id := range deathPrevention {
-			deathPlayerPrevention(id, target, b, deathNode)
-		}
+		b.pushDeath(target)
+	}
+}
+
+// Push the death of the player, who may still be saved from it.
+// Damage pushes it once it took the player's last health.
+func (b *Board) pushDeath(target *player) {
+	b.eventStack.push(event{p: target, e: deathOfCharacterEvent{}})
+	deathNode := b.eventStack.peek()
+	deathPrevention := [2]uint16{brokenAnkh, guppysCollar}
+	for _, id := range deathPrevention {
+		deathPlayerPrevention(id, target, b, deathNode)
 	}
 }
 
 func (p *player) loot(l *lArea) {
-	if checkActiveEffects(l.activeEffects, compost, true) { // Draw from top of discard pile.
+	if l.effects.use(compost, 0) { // Draw from top of discard pile.
 		if dC, err := l.discardPile.pop(); err == nil {
 			p.Hand = append(p.Hand, dC.(lootCard))
+			p.bus.publishCard(CardDrawn, p, dC, 1)
 		}
-	} else { // Standard draw
-		p.Hand = append(p.Hand, l.draw())
-
+	} else if lc, err := l.draw(); err == nil { // Standard draw
+		p.Hand = append(p.Hand, lc)
+		p.bus.publishCard(CardDrawn, p, lc, 1)
 	}
-	if checkActiveEffects(p.activeEffects, twoOfClubs, false) { // Draw an additional card while active.
-		p.Hand = append(p.Hand, l.draw())
+	if p.hasEffect(twoOfClubs) { // Draw an additional card while active.
+		if lc, err := l.draw(); err == nil {
+			p.Hand = append(p.Hand, lc)
+			p.bus.publishCard(CardDrawn, p, lc, 1)
+		}
 	}
 }
 
 func (p *player) loseCents(n int8) {
+	before := p.Pennies
 	x := p.Pennies - n
 	if x < 0 {
 		p.Pennies = 0
 	} else {
 		p.Pennies = x
 	}
+	if lost := int(before) - int(p.Pennies); lost > 0 {
+		p.bus.publishCard(CentsChanged, p, nil, -lost)
+	}
 }
 
+// Prompt the player for an action until they choose one that isn't free, like reading a card.
 // return: Whether the player made an action or decided to pass
 func (p *player) makeChoice(b *Board) bool {
-	didSomething := true
-	actions := p.getPlayerActions(p.isActivePlayer(b), b.eventStack.isEmpty())
+	for {
+		if didSomething, again := p.chooseAction(b); !again {
+			return didSomething
+		}
+	}
+}
+
+// Prompt the player for one action.
+// return: Whether the player made an action or decided to pass, and whether the action was free, so they choose again
+func (p *player) chooseAction(b *Board) (didSomething bool, again bool) {
+	didSomething = true
+	b.ui.ask(p)
+	actions := p.getPlayerActions(p.isActivePlayer(b), b.eventStack.isEmpty(), b.combat)
+	pass, act := -1, -1 // What passes, and what acts if they can't pass
 	for i, a := range actions {
-		fmt.Println(i, ") ", a.msg)
+		switch a.value {
+		case doNothing, endActivePlayerTurn:
+			pass = i
+		case attackMonster:
+			act = i
+		case readCard, viewEffects:
+		default:
+			if act < 0 {
+				act = i
+			}
+		}
 	}
-	fmt.Println("What would", p.Character.name, "like to do?")
-	switch actions[readInput(0, len(actions))].value {
+	if pass < 0 && act < 0 { // They must act, but there is nothing they can do
+		return false, false
+	}
+	if p.autoPass && pass >= 0 && actions[pass].value == doNothing && !holdsResponse(actions) {
+		return false, false
+	}
+	for i, a := range actions {
+		b.ui.offer(Option{Value: i, Label: a.msg, Inert: a.value == readCard || a.value == viewEffects})
+	}
+	b.ui.Println("What would", p.Character.name, "like to do?")
+	var choice int
+	if pass >= 0 {
+		choice = b.ui.readPriority(pass, len(actions)-1)
+	} else { // They must act: attack, most likely
+		choice = b.ui.readChoice(act, 0, len(actions)-1)
+	}
+	switch actions[choice].value {
 	case playLootCard:
-		showLootCards(p.Hand, p.Character.name, 0)
-		fmt.Println("Play which card?")
-		handCard := p.Hand[readInput(0, len(p.Hand)-1)]
+		b.ui.showHand(p, 0)
+		b.ui.Println("Play which card?")
+		handCard := p.Hand[b.ui.readInput(0, len(p.Hand)-1)]
 		err := handCard.activate(p, b)
 		if err != nil {
-			fmt.Println(fmt.Sprintf("Could not activate %s:\n%s.", handCard.name, err))
-		} else {
-			p.numLootPlayed -= 1
+			b.ui.Println(fmt.Sprintf("Could not activate %s:\n%s.", handCard.name, err))
+			return false, false // Nothing happened: it counts as passing
 		}
+		p.useLootPlay()
 	case buyItem:
 		b.eventStack.push(event{p: p, e: intentionToPurchaseEvent{}})
-		p.numPurchases -= 1
 	case attackMonster:
 		if !p.inBattle && p.isActivePlayer(b) && p.numForcedDeckAttacks > 0 {
 			p.numForcedDeckAttacks -= 1
 			b.eventStack.push(event{p: p, e: intentionToAttackEvent{m: nil}}) // nil = attack monster deck
-			p.numAttacks -= 1
 		} else if !p.inBattle && p.isActivePlayer(b) {
 			monsters := b.monster.getActiveMonsters()
 			l := len(monsters)
-			showMonsterCards(monsters, 0)
-			fmt.Println(fmt.Sprintf("%d) Monster Deck\nWhich target to attack?", l))
-			i := readInput(0, l)
+			b.ui.showMonsterCards(monsters, 0)
+			b.ui.menu(l, "Monster Deck")
+			b.ui.Println("Which target to attack?")
+			i := b.ui.readInput(0, l)
 			var m *monsterCard
 			if i < l {
 				m = monsters[i]
@@ -568,31 +689,45 @@ func (p *player) makeChoice(b *Board) bool {
 			}
 			b.eventStack.push(event{p: p, e: intentionToAttackEvent{m: m}})
 			p.forceAttackOnAny = false
-			p.numAttacks -= 1
 		} else {
-			b.eventStack.push(event{p: p, e: declareAttackEvent{m: b.monster.getActiveMonsterInBattle()}})
+			b.eventStack.push(event{p: p, e: declareAttackEvent{m: b.combat.m}})
 			b.rollDiceAndPush()
 		}
 	case activateCharacter:
 		err := p.Character.activate(p, b)
 		if err != nil {
-			fmt.Println(err)
+			b.ui.Println(err)
+			return false, false
 		}
 	case activateItem:
 		items := p.getUsableActiveItems()
 		l := len(items)
 		if l > 0 {
-			showTreasureCards(items, p.Character.name, 0)
-			fmt.Println("Which card to activate?")
-			err := items[readInput(0, l-1)].activate(p, b)
+			b.ui.showTreasureCards(items, p.Character.name, 0)
+			b.ui.Println("Which card to activate?")
+			err := items[b.ui.readInput(0, l-1)].activate(p, b)
 			if err != nil {
-				fmt.Println(err)
+				b.ui.Println(err)
+				return false, false
 			}
 		}
-	case doNothing:
+	case readCard: // Reading a card is free, so choose again afterwards
+		done := b.ui.privately(p) // Their hand is among the cards they may read
+		cards := b.getVisibleCards(p)
+		for i, c := range cards {
+			b.ui.offer(Option{Value: i, Label: c.getName()})
+		}
+		b.ui.Println("Read which card?")
+		b.ui.Print(cardInfoFor(cards[b.ui.readInput(0, len(cards)-1)]))
+		done()
+		return false, true
+	case viewEffects: // So is looking at the effects in play
+		b.ui.showEffects(b.Effects())
+		return false, true
+	case doNothing, endActivePlayerTurn:
 		didSomething = false
 	}
-	return didSomething
+	return didSomething, false
 }
 
 func (b *Board) placeInDeck(c card, onTop bool) {
@@ -608,74 +743,86 @@ func (b *Board) placeInDeck(c card, onTop bool) {
 
 func (l *lArea) placeInDeck(lc lootCard, onTop bool) {
 	if onTop {
-		l.deck.prepend(lc)
-	} else {
 		l.deck.append(lc)
+	} else {
+		l.deck.prepend(lc)
 	}
 }
 
 func (m *mArea) placeInDeck(mc monsterCard, onTop bool) {
 	if onTop {
-		m.deck.prepend(mc)
-	} else {
 		m.deck.append(mc)
+	} else {
+		m.deck.prepend(mc)
 	}
 }
 
 func (t *tArea) placeInDeck(tc treasureCard, onTop bool) {
 	if onTop {
-		t.deck.prepend(tc)
-	} else {
 		t.deck.append(tc)
+	} else {
+		t.deck.prepend(tc)
 	}
 }
 
-func (p *player) popActiveItem(idx uint8) itemCard {
+func (p *player) popActiveItem(idx uint8) (itemCard, error) {
 	length := len(p.ActiveItems)
-	var card itemCard = &p.ActiveItems[idx]
-	if length == 0 {
-		panic("No active items to destroy!")
-	} else if length == 1 && idx == 0 { // deleting only or last element in slice
+	if int(idx) >= length {
+		return nil, wrapError(ErrInvalidTarget, "%s has no active item at index %d", p.Character.name, idx)
+	}
+	c := p.ActiveItems[idx] // copy before the slice shifts underneath it
+	var card itemCard = &c
+	if length == 1 && idx == 0 { // deleting only or last element in slice
 		p.ActiveItems = p.ActiveItems[:idx]
 	} else { // Must preserve order of these slices so middle deletion doesn't screw up order of elements proceeding it
 		copy(p.ActiveItems[idx:], p.ActiveItems[idx+1:])
 		p.ActiveItems[length-1] = treasureCard{}
 		p.ActiveItems = p.ActiveItems[:length-1]
 	}
-	return card
+	return card, nil
 }
 
-func (l *lArea) popCardFromDiscardPile(idx uint8) lootCard {
+func (l *lArea) popCardFromDiscardPile(idx uint8) (lootCard, error) {
+	var c lootCard
 	card, err := l.discardPile.popByIndex(idx)
-	check(err)
-	return card.(lootCard)
+	if err == nil {
+		c = card.(lootCard)
+	}
+	return c, err
 }
 
-func (m *mArea) popCardFromDiscardPile(idx uint8) monsterCard {
+func (m *mArea) popCardFromDiscardPile(idx uint8) (monsterCard, error) {
+	var c monsterCard
 	card, err := m.discardPile.popByIndex(idx)
-	check(err)
-	return card.(monsterCard)
+	if err == nil {
+		c = card.(monsterCard)
+	}
+	return c, err
 }
 
-func (t *tArea) popCardFromDiscardPile(idx uint8) treasureCard {
+func (t *tArea) popCardFromDiscardPile(idx uint8) (treasureCard, error) {
+	var c treasureCard
 	card, err := t.discardPile.popByIndex(idx)
-	check(err)
-	return card.(treasureCard)
+	if err == nil {
+		c = card.(treasureCard)
+	}
+	return c, err
 }
 
-func (p *player) popCurse(idx uint8) monsterCard {
+func (p *player) popCurse(idx uint8) (monsterCard, error) {
 	length := len(p.Curses)
+	if int(idx) >= length {
+		return monsterCard{}, wrapError(ErrInvalidTarget, "%s has no curse at index %d", p.Character.name, idx)
+	}
 	card := p.Curses[idx]
-	if length == 0 {
-		panic("No curses to destroy!")
-	} else if length == 1 && idx == 0 { // deleting only or last element in slice
+	if length == 1 && idx == 0 { // deleting only or last element in slice
 		p.Curses = p.Curses[:idx]
 	} else { // Must preserve order of these slices so middle deletion doesn't screw up order of elements proceeding it
 		copy(p.Curses[idx:], p.Curses[idx+1:])
 		p.Curses[length-1] = monsterCard{}
 		p.Curses = p.Curses[:length-1]
 	}
-	return card
+	return card, nil
 }
 
 func (p *player) popHandCard(idx uint8) lootCard {
@@ -696,44 +843,46 @@ func (p *player) popItem(ic itemCard) (itemCard, error) {
 	var i uint8
 	var err error
 	if i, err = p.getItemIndex(ic.getId(), ic.isPassive()); err == nil {
-		c = p.popItemByIndex(i, ic.isPassive())
+		c, err = p.popItemByIndex(i, ic.isPassive())
 	}
 	return c, err
 }
 
-func (p *player) popItemByIndex(idx uint8, isPassive bool) itemCard {
+func (p *player) popItemByIndex(idx uint8, isPassive bool) (itemCard, error) {
 	var c itemCard
+	var err error
 	if !isPassive {
-		c = p.popActiveItem(idx)
+		c, err = p.popActiveItem(idx)
 	} else {
-		c = p.popPassiveItem(idx)
+		c, err = p.popPassiveItem(idx)
 	}
-	return c
+	return c, err
 }
 
-func (p *player) popPassiveItem(idx uint8) passiveItem {
+func (p *player) popPassiveItem(idx uint8) (passiveItem, error) {
 	length := len(p.PassiveItems)
+	if int(idx) >= length {
+		return nil, wrapError(ErrInvalidTarget, "%s has no passive item at index %d", p.Character.name, idx)
+	}
 	card := p.PassiveItems[idx]
-	if length == 0 {
-		panic("No passive Items to destroy!")
-	} else if length == 1 && idx == 0 { // deleting only or last element in slice
+	if length == 1 && idx == 0 { // deleting only or last element in slice
 		p.PassiveItems = p.PassiveItems[:idx]
 	} else { // Must preserve order of these slices so middle deletion doesn't screw up order of elements proceeding it
 		copy(p.PassiveItems[idx:], p.PassiveItems[idx+1:])
 		p.PassiveItems[length-1] = nil
 		p.PassiveItems = p.PassiveItems[:length-1]
 	}
-	return card
+	return card, nil
 }
 
-func (p *player) popSoul(idx uint8) card {
+func (p *player) popSoul(idx uint8) soul {
 	length := len(p.Souls)
 	card := p.Souls[idx]
 	if length == 1 && idx == 0 { // deleting only or last element in slice
 		p.Souls = p.Souls[:idx]
 	} else if length > 1 { // Must preserve order of these slices so middle deletion doesn't screw up order of elements proceeding it
 		copy(p.Souls[idx:], p.Souls[idx+1:])
-		p.Souls[length-1] = nil
+		p.Souls[length-1] = soul{}
 		p.Souls = p.Souls[:length-1]
 	}
 	return card
@@ -750,70 +899,108 @@ func (mc *monsterCard) resetStats() {
 }
 
 // Set the player's and character's values back to their base values,
-// free of any buffs or nerfs to any stats.
-func (p *player) resetStats(isActivePlayer bool) {
+// free of any temporary buffs or nerfs. Cards still in play keep modifying
+// the stats through their modifiers.
+func (p *player) resetStats() {
 	p.forceEnd, p.forceAttackOnAny = false, false
-	p.numAttacks, p.numPurchases, p.numLootPlayed = p.baseNumAttacks, p.baseNumPurchases, p.baseNumLootPlayed
-	p.numForcedDeckAttacks = 0
+	p.numAttacks, p.numPurchases, p.numLootPlayed = attacksPerTurn, purchasesPerTurn, lootPlaysPerTurn
+	p.numForcedDeckAttacks, p.numAttackRolls = 0, 0
 	c := &p.Character
 	c.tapped = false
-	c.hp, c.ap = c.baseHealth, c.baseAttack
-	delete(p.activeEffects, theEmpress)
-	if isActivePlayer {
-		delete(p.activeEffects, larryJr)
-	}
+	c.hp, c.ap = p.getMaxHealth(), c.baseAttack
 }
 
-func (b *Board) rollDice() (diceRollEvent, *player) {
+func (b *Board) rollDice() (diceRollEvent, *player, error) {
 	if node := b.eventStack.peek(); node != nil {
-		nextEvent := node.next.event.e
-		var p *player = node.event.p
-		roll := uint8(rand.Intn(6) + 1)
-		if checkActiveEffects(p.activeEffects, theEmpress, false) {
-			modifyDiceRoll(&roll, 1)
+		var nextEvent eventHolder
+		if node.next != nil {
+			nextEvent = node.next.event.e
 		}
-		if checkActiveEffects(p.activeEffects, theHaunt, false) {
-			modifyDiceRoll(&roll, -1)
-		}
-		if _, ok := nextEvent.(declareAttackEvent); ok {
-			if checkActiveEffects(p.activeEffects, bumbo, true) {
-				modifyDiceRoll(&roll, 2)
-			}
-			if emptyVesselChecker(p, false) {
-				modifyDiceRoll(&roll, 1)
-			}
-			if _, err := p.getItemIndex(meat, true); err == nil {
-				modifyDiceRoll(&roll, 1)
-			}
-			if _, err := p.getItemIndex(synthoil, true); err == nil {
-				modifyDiceRoll(&roll, 1)
-			}
-		}
-		return diceRollEvent{n: roll}, p
+		var p *player = node.event.p
+		_, isAttackRoll := nextEvent.(declareAttackEvent)
+		roll := p.modifyRoll(uint8(b.rng.Intn(6)+1), isAttackRoll)
+		return diceRollEvent{n: roll}, p, nil
 	} else {
-		panic("dice rolls do not happen in isolation!")
+		return diceRollEvent{}, nil, wrapError(ErrNoEvent, "dice rolls do not happen in isolation")
 	}
 }
 
 // Player does not need to be explicitly passed to this receiver.
 // Dice rolls do not occur in an isolated state. They always follow some action.
-func (b *Board) rollDiceAndPush() {
-	roll, p := b.rollDice()
-	event := event{p: p, e: roll}
-	b.eventStack.push(event)
+func (b *Board) rollDiceAndPush() error {
+	roll, p, err := b.rollDice()
+	if err == nil {
+		b.eventStack.push(event{p: p, e: roll})
+	}
+	return err
 }
 
 func (p *player) stealItem(id uint16, isPassive bool, p2 *player) {
 	j, err := p2.getItemIndex(id, isPassive)
 	if err == nil {
-		p.addCardToBoard(p2.popItemByIndex(j, isPassive))
+		if c, err := p2.popItemByIndex(j, isPassive); err == nil {
+			p.addCardToBoard(c)
+		}
 	}
 }
 
-func check(err error) {
-	if err != nil {
-		panic(err)
+// Cards that one player hands over to another as part of an exchange.
+type cardExchange struct {
+	from, to *player
+	items    []itemCard
+	souls    []soul
+}
+
+// Move items and souls between players all at once, so a card handed over
+// in one exchange can't be passed on again by another (Chaos rotates every board).
+// Every card is taken off its owner's board before any is given,
+// so continuous effects leave the old owner before they enter the new one.
+// Eternal items and a character's own soul (The Lost) never change hands; the rest of the exchange goes ahead without them.
+func (b *Board) exchangeCards(exchanges []cardExchange) error {
+	var err error
+	items, souls := make([][]itemCard, len(exchanges)), make([][]soul, len(exchanges))
+	for i, ex := range exchanges {
+		for _, ic := range ex.items {
+			if ic.isEternal() {
+				err = wrapError(ErrInvalidTarget, "%s is eternal and can't change hands", ic.getName())
+				continue
+			}
+			c, pErr := ex.from.popItem(ic)
+			if pErr != nil {
+				err = pErr
+				continue
+			}
+			if f := c.getContinuousPassive(); f != nil {
+				f(ex.from, b, c, true)
+			}
+			items[i] = append(items[i], c)
+		}
+		for _, s := range ex.souls {
+			if s.origin == characterSoul {
+				err = wrapError(ErrInvalidTarget, "%s is %s's character and can't change hands", s.getName(), ex.from.Character.name)
+				continue
+			}
+			for j := range ex.from.Souls {
+				if ex.from.Souls[j].getId() == s.getId() {
+					souls[i] = append(souls[i], ex.from.popSoul(uint8(j)))
+					break
+				}
+			}
+		}
 	}
+	for i, ex := range exchanges {
+		for _, c := range items[i] {
+			if aErr := ex.to.addCardToBoard(c); aErr != nil {
+				err = aErr
+			} else if f := c.getContinuousPassive(); f != nil {
+				f(ex.to, b, c, false)
+			}
+		}
+		for _, s := range souls[i] {
+			ex.to.gainSoul(s)
+		}
+	}
+	return err
 }
 
 // This function is originally called either when the active player
@@ -837,34 +1024,10 @@ func actionReactionChecker(initiatingPlayer *player, b *Board) {
 	}
 }
 
-func checkActiveEffects(activeEffects map[uint16]struct{}, key uint16, deleteIfExists bool) bool {
-	var activeEffect bool
-	if _, ok := activeEffects[key]; ok {
-		activeEffect = true
-		if deleteIfExists { // Usually true for "just once" effects (Cancer, Pestilence, Credit Card)
-			delete(activeEffects, key)
-		}
-	}
-	return activeEffect
-}
-
 func checkVictory(players []player) []player {
 	victors := make([]player, 0, len(players))
-	twoSoulCards := map[uint16]struct{}{mom: {}, satan: {}, theLamb: {}, hush: {}, isaacMonster: {}, momsHeart: {}}
 	for _, p := range players {
-		var numSoulsToWin uint8 = 4
-		if curseOfLossChecker(p) {
-			numSoulsToWin += 1
-		}
-		var souls uint8
-		for _, s := range p.Souls {
-			if _, ok := twoSoulCards[s.getId()]; ok {
-				souls += 2
-			} else {
-				souls += 1
-			}
-		}
-		if souls == numSoulsToWin {
+		if p.soulValue() >= p.soulsToWin() {
 			victors = append(victors, p)
 		}
 	}
@@ -874,20 +1037,19 @@ func checkVictory(players []player) []player {
 // Set up the players by distributing characters and their respective
 // starting items (with exception to Eden that gets a choice between the
 // top three cards in the treasure deck).
-func setPlayerBoards(numPlayers uint8, useKickstarterExpansion bool, useFourSoulsExpansion bool) []player {
-	var characterDeck = getCharacters(numPlayers, useKickstarterExpansion, useFourSoulsExpansion)
+func setPlayerBoards(rng *rand.Rand, numPlayers uint8, useKickstarterExpansion bool, useFourSoulsExpansion bool) []player {
+	var characterDeck = getCharacters(rng, numPlayers, useKickstarterExpansion, useFourSoulsExpansion)
 	var startingItems = getStartingItems()
 	var players = make([]player, numPlayers)
 	var i uint8
 	for i = 0; i < numPlayers; i++ {
 		c := characterDeck[i]
-		if c.name == "Eden" {
-			panic("not yet implemented")
-		}
 		player := player{Character: c, Pennies: 3, Hand: make([]lootCard, 0, 10)}
-		player.addCardToBoard(startingItems[c.name])
+		if c.id != eden { // Eden chooses their item once the game starts
+			player.addCardToBoard(startingItems[c.name])
+		}
 		if player.Character.name == "The Lost" {
-			_ = player.addSoulToBoard(player.Character) // Impossible for a victory here. No need to check.
+			_ = player.gainSoul(player.Character) // Impossible for a victory here. No need to check.
 		}
 
 		players[i] = player
@@ -895,18 +1057,148 @@ func setPlayerBoards(numPlayers uint8, useKickstarterExpansion bool, useFourSoul
 	return players
 }
 
-func (b *Board) DebugGame() {
-	numPlayers := uint8(len(b.players))
-	victors := make([]player, 0, numPlayers)
-	for len(victors) == 0 {
-		ap := &b.players[b.api]
-		_ = ap.makeChoice(b)
-		for !b.eventStack.isEmpty() {
-			err := b.resolveNextEvent()
+// Eden looks at the top 3 cards of the treasure deck and chooses one of them to be their
+// starting item. It becomes eternal, and the others go to the bottom of the deck.
+// Nothing happens if Eden already has an item, ex: the game was started before.
+func (b *Board) chooseStartingItems() (err error) {
+	defer recoverEngineError(&err)
+	for i := range b.players {
+		p := &b.players[i]
+		if p.Character.id != eden || len(p.ActiveItems)+len(p.PassiveItems) > 0 {
+			continue
+		}
+		cards := make([]treasureCard, 0, 3)
+		for len(cards) < 3 {
+			c, err := b.treasure.draw()
 			if err != nil {
-				fmt.Println(fmt.Errorf("error resolving event: %s", err))
+				break
+			}
+			cards = append(cards, c)
+		}
+		if len(cards) == 0 {
+			continue
+		}
+		b.ui.ask(p)
+		done := b.ui.privately(p) // The others don't see the cards Eden passes on
+		b.ui.showTreasureCards(cards, "deck", 0)
+		b.ui.Println("Which item will", p.Character.name, "start with?")
+		j := b.ui.readInput(0, len(cards)-1)
+		done()
+		item := cards[j]
+		item.eternal = true
+		if err := p.addCardToBoard(item); err != nil {
+			return err
+		}
+		b.ui.Println(p.Character.name, "starts with", item.name+".")
+		for k := range cards {
+			if k != j {
+				b.treasure.placeInDeck(cards[k], false)
+			}
+		}
+	}
+	return b.ui.failed()
+}
+
+// Prompt the player for an action. An engine error raised while the action is
+// chosen or put on the stack rejects that action instead of crashing the game.
+// Fails with ErrInvalidInput if a player couldn't answer anymore, or ErrUnfinished if the game was stopped.
+// return: Whether the player made an action or decided to pass
+func (b *Board) takeAction(p *player) (didSomething bool, err error) {
+	defer recoverEngineError(&err)
+	didSomething = p.makeChoice(b)
+	return didSomething, b.ui.failed()
+}
+
+// Give every player the chance to respond to what the player just did.
+// An engine error raised while responding rejects that response instead of crashing the game.
+// Fails with ErrInvalidInput if a player couldn't answer anymore, or ErrUnfinished if the game was stopped.
+func (b *Board) reactTo(p *player) (err error) {
+	defer recoverEngineError(&err)
+	actionReactionChecker(p, b)
+	return b.ui.failed()
+}
+
+// Resolve the event stack one event at a time, giving every player priority before each event resolves.
+// Once the stack is empty, the field is checked, which may put revealed bonus cards on the stack again.
+// Only an error from a player who can't answer anymore stops the resolution; other errors are shown to the players.
+// return: the players who won, if any.
+func (b *Board) resolveStack(p *player) ([]player, error) {
+	for {
+		for !b.eventStack.isEmpty() {
+			if err := b.reactTo(p); isFatal(err) {
+				return nil, err
+			} else if err != nil {
+				b.ui.Println(fmt.Errorf("error responding: %w", err))
+			}
+			if err := b.resolveNextEvent(); isFatal(err) {
+				return nil, err
+			} else if err != nil && !errors.Is(err, ErrNoEvent) {
+				b.ui.Println(fmt.Errorf("error resolving event: %w", err))
+			}
+			if err := b.ui.failed(); err != nil {
+				return nil, err
+			}
+			if victors := checkVictory(b.players); len(victors) > 0 { // Winning doesn't wait for the stack
+				return victors, nil
 			}
 		}
+		if victors := b.checkTheField(); len(victors) > 0 || b.eventStack.isEmpty() {
+			return victors, nil
+		}
+	}
+}
+
+// Play the game until someone wins.
+// Each turn starts and ends with its event on the stack. In between, the active player acts until
+// they pass with nothing left on the stack, or until their turn is forced to end.
+// The game stops early only if a player can't answer anymore, ex: their input closed, if it was
+// stopped (ErrUnfinished), ex: it took too many decisions, or if the engine failed in the middle of an effect.
+// return: the character names of the winners.
+func (b *Board) Play() ([]string, error) {
+	if err := b.chooseStartingItems(); err != nil {
+		return nil, err
+	}
+	for {
+		ap := &b.players[b.api]
+		b.eventStack.push(event{p: ap, e: startOfTurnEvent{}})
+		victors, err := b.resolveStack(ap)
+		for err == nil && len(victors) == 0 && !ap.forceEnd {
+			var didSomething bool
+			asked := b.ui.decisions()
+			if didSomething, err = b.takeAction(ap); isFatal(err) {
+				break
+			} else if err != nil {
+				b.ui.Println(fmt.Errorf("error taking action: %w", err))
+				// An error before the player was even asked would happen again: pass instead
+				didSomething, err = b.ui.decisions() > asked, nil
+			}
+			if !didSomething && b.eventStack.isEmpty() {
+				break
+			}
+			victors, err = b.resolveStack(ap)
+		}
+		if err == nil && len(victors) == 0 {
+			ap.forceEnd = false
+			b.eventStack.push(event{p: ap, e: endTurnEvent{}})
+			victors, err = b.resolveStack(ap)
+		}
+		if err != nil {
+			return nil, err
+		} else if len(victors) > 0 {
+			winners := make([]string, len(victors))
+			for i := range victors {
+				winners[i] = victors[i].Character.name
+			}
+			return winners, nil
+		}
+	}
+}
+
+func (b *Board) DebugGame() {
+	if winners, err := b.Play(); err != nil {
+		b.ui.Println(fmt.Errorf("game stopped: %w", err))
+	} else {
+		b.ui.Println("Winners:", strings.Join(winners, ", "))
 	}
 }
 
@@ -916,30 +1208,45 @@ func (b *Board) DebugGame() {
 // 3) Give the players three loot cards for their hand
 // 4) Place two monsters on the board's monster zone. All other cards will go on the bottom of the deck.
 // 5) Place two treasure items on the board's treasure zone.
-func NewGame(numPlayers uint8, useKickStarterExpansion bool, useFourSoulsExpansion bool) Board {
-	rand.Seed(time.Now().UnixNano())
-	lootDeck := getLootDeck(useKickStarterExpansion, useFourSoulsExpansion)
-	monsterDeck := getMonsterDeck(useKickStarterExpansion, useFourSoulsExpansion)
-	treasureDeck := getTreasureDeck(useKickStarterExpansion, useFourSoulsExpansion)
-	board := Board{
+func NewGame(numPlayers uint8, useKickStarterExpansion bool, useFourSoulsExpansion bool) *Board {
+	seed := time.Now().UnixNano()
+	board := newBoard(rand.New(rand.NewSource(seed)), numPlayers, useKickStarterExpansion, useFourSoulsExpansion)
+	board.options = GameOptions{Players: numPlayers, Kickstarter: useKickStarterExpansion, FourSoulsPlus: useFourSoulsExpansion, Seed: seed}
+	return board
+}
+
+// Deal a game with the shuffles and dice of its own random source. Games dealt at the same time
+// don't share any state.
+func newBoard(rng *rand.Rand, numPlayers uint8, useKickStarterExpansion bool, useFourSoulsExpansion bool) *Board {
+	lootDeck := getLootDeck(rng, useKickStarterExpansion, useFourSoulsExpansion)
+	monsterDeck := getMonsterDeck(rng, useKickStarterExpansion, useFourSoulsExpansion)
+	treasureDeck := getTreasureDeck(rng, useKickStarterExpansion, useFourSoulsExpansion)
+	board := &Board{
 		loot: &lArea{deck: lootDeck, discardPile: make(deck, 0, lootDeck.len())},
 		monster: &mArea{deck: monsterDeck, discardPile: make(deck, 0, monsterDeck.len()),
 			zones: make([]activeSlot, 2, 6)},
 		treasure: &tArea{deck: treasureDeck, discardPile: make(deck, 0, treasureDeck.len()),
 			zones: make([]treasureCard, 2, 4), crystalBallGuess: make(map[*player]uint8, 3)},
 	}
-	players := setPlayerBoards(numPlayers, useKickStarterExpansion, useFourSoulsExpansion)
+	players := setPlayerBoards(rng, numPlayers, useKickStarterExpansion, useFourSoulsExpansion)
+	board.players = players
+	board.setEventBus(newEventBus())
+	board.setEffectRegistry(newEffectRegistry())
+	board.setConsole(newConsole(nil))
+	board.setRandom(rng)
 	for i := range players {
 		var j uint8
 		for j = 0; j < 3; j++ {
 			players[i].loot(board.loot)
 		}
 	}
-	board.players = players
 	var i uint8
 	for i < 2 {
-		m := board.monster.draw()
-		if m.isBonusCard() {
+		m, err := board.monster.draw()
+		if err != nil {
+			break
+		}
+		if m.isBonusCard() { // Setup is the one time a bonus card isn't resolved: it goes to the bottom of the deck
 			board.monster.placeInDeck(m, false)
 		} else {
 			m.resetStats()
@@ -948,7 +1255,21 @@ func NewGame(numPlayers uint8, useKickStarterExpansion bool, useFourSoulsExpansi
 		}
 	}
 	for i = 0; i < 2; i++ {
-		board.treasure.zones[i] = board.treasure.draw()
+		board.treasure.zones[i], _ = board.treasure.draw()
 	}
 	return board
 }
+
+// Give the board and its areas the random source of the game.
+func (b *Board) setRandom(rng *rand.Rand) {
+	b.rng = rng
+	if b.loot != nil {
+		b.loot.rng = rng
+	}
+	if b.monster != nil {
+		b.monster.rng = rng
+	}
+	if b.treasure != nil {
+		b.treasure.rng = rng
+	}
+}
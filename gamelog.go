@@ -0,0 +1,101 @@
+package four_souls
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// A saved game: how it was set up and every answer given, in order.
+// Since the options include the seed, playing the answers back deals and rolls the same game.
+type GameLog struct {
+	Options   GameOptions      `json:"options"`
+	Decisions []LoggedDecision `json:"decisions"`
+}
+
+// One answer given during a game.
+type LoggedDecision struct {
+	Player string `json:"player"`
+	Answer int    `json:"answer"`
+}
+
+func (gl GameLog) Save(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(gl)
+}
+
+func LoadGameLog(r io.Reader) (GameLog, error) {
+	var gl GameLog
+	err := json.NewDecoder(r).Decode(&gl)
+	return gl, err
+}
+
+// Records every answer of the decider it wraps.
+type Recorder struct {
+	d         Decider
+	decisions []LoggedDecision
+}
+
+func NewRecorder(d Decider) *Recorder {
+	return &Recorder{d: d, decisions: make([]LoggedDecision, 0, 256)}
+}
+
+func (r *Recorder) Show(b *Board, s string) {
+	r.d.Show(b, s)
+}
+
+func (r *Recorder) ShowTo(b *Board, player string, s string) {
+	showTo(r.d, b, player, s)
+}
+
+func (r *Recorder) Decide(b *Board, d Decision) (int, error) {
+	n, err := r.d.Decide(b, d)
+	if err == nil {
+		r.decisions = append(r.decisions, LoggedDecision{Player: d.Player, Answer: n})
+	}
+	return n, err
+}
+
+// The log of the game played on the board so far.
+func (r *Recorder) Log(b *Board) GameLog {
+	return GameLog{Options: b.Options(), Decisions: append([]LoggedDecision(nil), r.decisions...)}
+}
+
+// Plays a saved game back: each decision is answered as it was in the log.
+// Everything shown goes to the decider it wraps, which only watches.
+type Replayer struct {
+	log  GameLog
+	next int
+	out  Decider
+}
+
+func NewReplayer(gl GameLog, out Decider) *Replayer {
+	return &Replayer{log: gl, out: out}
+}
+
+func (r *Replayer) Show(b *Board, s string) {
+	r.out.Show(b, s)
+}
+
+func (r *Replayer) ShowTo(b *Board, player string, s string) {
+	showTo(r.out, b, player, s)
+}
+
+// Once the log runs out, or if the game asks someone else than the log did, the replay stops.
+func (r *Replayer) Decide(b *Board, d Decision) (int, error) {
+	if r.next >= len(r.log.Decisions) {
+		return 0, fmt.Errorf("the log ends after %d decisions", len(r.log.Decisions))
+	}
+	ld := r.log.Decisions[r.next]
+	if ld.Player != d.Player {
+		return 0, fmt.Errorf("decision %d was made by %q in the log, not %q", r.next, ld.Player, d.Player)
+	}
+	r.next += 1
+	return ld.Answer, nil
+}
+
+// The number of decisions played back so far, and in the log.
+func (r *Replayer) Progress() (int, int) {
+	return r.next, len(r.log.Decisions)
+}
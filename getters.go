@@ -3,6 +3,7 @@ package four_souls
 import (
 	"errors"
 	"fmt"
+	"sort"
 )
 
 // Get all of the events where a character card is activated.
@@ -41,8 +42,10 @@ func (es eventStack) getActivateItemEvents() []*eventNode {
 	if es.head != nil {
 		curr := es.head.top
 		for curr != nil {
-			if _, ok := curr.event.e.(activateEvent).c.(*treasureCard); ok {
-				nodes = append(nodes, curr)
+			if e, ok := curr.event.e.(activateEvent); ok {
+				if _, ok = e.c.(*treasureCard); ok {
+					nodes = append(nodes, curr)
+				}
 			}
 			curr = curr.next
 		}
@@ -54,16 +57,14 @@ func (es eventStack) getActivateItemEvents() []*eventNode {
 // Required for cards like Bomb! that require the monster to
 // be on the field for it to activate.
 // id uint16: the monster card's id
+// Return: the zone and the monster, or nil if no active monster has the id.
 func (m mArea) getActiveMonster(id uint16) (uint8, *monsterCard) {
-	var i uint8
-	var c *monsterCard
-	for i = range m.zones {
-		c = m.zones[i].peek()
-		if c.id == id {
-			break
+	for i := range m.zones {
+		if c := m.zones[i].peek(); c.id == id {
+			return uint8(i), c
 		}
 	}
-	return i, c
+	return 0, nil
 }
 
 // Get a pointer to all active monsters
@@ -75,18 +76,6 @@ func (m mArea) getActiveMonsters() []*monsterCard {
 	return monsters
 }
 
-func (m mArea) getActiveMonsterInBattle() *monsterCard {
-	var monster *monsterCard
-	for i := range m.zones {
-		c := m.zones[i].peek()
-		if c.inBattle {
-			monster = c
-			break
-		}
-	}
-	return monster
-}
-
 // Return the active player
 func (b Board) getActivePlayer() *player {
 	return &b.players[b.api]
@@ -114,16 +103,15 @@ func (b *Board) getAllItems(getEternal bool, excludePlayer *player) ([]itemCard,
 // Get all items in play by the player
 // getEternal bool: If true, get eternal items as well. Else, do not.
 func (p player) getAllItems(getEternal bool) []itemCard {
-	l := len(p.ActiveItems)
-	var items = make([]itemCard, l+len(p.PassiveItems))
-	for i, c := range p.ActiveItems {
-		if (!c.eternal && !getEternal) || getEternal {
-			items[i] = &c
+	var items = make([]itemCard, 0, len(p.ActiveItems)+len(p.PassiveItems))
+	for i := range p.ActiveItems {
+		if c := &p.ActiveItems[i]; !c.eternal || getEternal {
+			items = append(items, c)
 		}
 	}
-	for i, c := range p.PassiveItems {
-		if (!c.isEternal() && !getEternal) || getEternal {
-			items[i+l] = c
+	for _, c := range p.PassiveItems {
+		if !c.isEternal() || getEternal {
+			items = append(items, c)
 		}
 	}
 	return items
@@ -348,58 +336,21 @@ func (b Board) getItemIndex(itemId uint16, isPassive bool) (uint8, *player) {
 	return j, p
 }
 
+// Find an item on the player's board. Both item lists are sorted by id as the items are added.
 func (p player) getItemIndex(itemId uint16, isPassive bool) (uint8, error) {
-	var e = errors.New("item not found")
-	var median, low uint8
+	var i int
+	var found bool
 	if !isPassive {
-		high := uint8(len(p.ActiveItems))
-		for low <= high {
-			median = (low + high) / 2
-			id := p.ActiveItems[median].id
-			if id < itemId {
-				low = median + 1
-			} else if id > itemId {
-				high = median - 1
-			} else {
-				e = nil
-				break
-			}
-		}
+		i = sort.Search(len(p.ActiveItems), func(i int) bool { return p.ActiveItems[i].id >= itemId })
+		found = i < len(p.ActiveItems) && p.ActiveItems[i].id == itemId
 	} else {
-		high := uint8(len(p.PassiveItems))
-		for low <= high {
-			median = (low + high) / 2
-			id := p.PassiveItems[median].getId()
-			if id < itemId {
-				low = median + 1
-			} else if id > itemId {
-				high = median - 1
-			} else {
-				e = nil
-				break
-			}
-		}
+		i = sort.Search(len(p.PassiveItems), func(i int) bool { return p.PassiveItems[i].getId() >= itemId })
+		found = i < len(p.PassiveItems) && p.PassiveItems[i].getId() == itemId
+	}
+	if !found {
+		return 0, errors.New("item not found")
 	}
-	return median, e
-	//var i uint8
-	//if !isPassive {
-	//	var target treasureCard
-	//	for i, target = range p.ActiveItems {
-	//		if target.id == itemId {
-	//			e = nil
-	//			break
-	//		}
-	//	}
-	//} else {
-	//	var target passiveItem
-	//	for i, target = range p.PassiveItems {
-	//		if target.getId() == itemId {
-	//			e = nil
-	//			break
-	//		}
-	//	}
-	//}
-	//return i, e
+	return uint8(i), nil
 }
 
 func (es eventStack) getLootCardEvents() []*eventNode {
@@ -445,17 +396,11 @@ func (es eventStack) getNonAttackDiceRollEvents() []*eventNode {
 }
 
 func (b *Board) getOtherPlayers(excludePlayer *player, filterDead bool) []*player {
-	var l = uint8(len(b.players))
-	var players = make([]*player, l-1)
-	var i = b.api
-	var j uint8
-	for j = 0; j < l-1; j++ {
-		if b.players[i].Character.id != excludePlayer.Character.id {
-			if (filterDead && b.players[i].Character.hp > 0) || !filterDead {
-				players[i] = &b.players[i]
-			}
+	players := make([]*player, 0, len(b.players))
+	for _, p := range b.getPlayers(filterDead) {
+		if p.Character.id != excludePlayer.Character.id {
+			players = append(players, p)
 		}
-		i = (i + 1) % l
 	}
 	return players
 }
@@ -495,36 +440,37 @@ func (p player) getPassiveItems(getEternal bool) []passiveItem {
 	return cards
 }
 
-func (p player) getPlayerActions(isActivePlayer bool, emptyEs bool) []actionReaction {
-	actions := make([]actionReaction, 0, 6)
+// Get every action the player may take right now.
+// battle: the battle in progress, if any.
+func (p player) getPlayerActions(isActivePlayer bool, emptyEs bool, battle *combat) []actionReaction {
+	actions := make([]actionReaction, 0, 8)
 	if isActivePlayer {
-		if p.numLootPlayed > 0 {
+		if p.lootPlaysLeft() > 0 && len(p.Hand) > 0 {
 			actions = append(actions, actionReaction{msg: "Play a Loot Card from your hand", value: playLootCard})
 		}
-		var shopCost int8 = 10
-		if _, err := p.getItemIndex(steamySale, true); err == nil {
-			shopCost = 5
-		}
-		if _, ok := p.activeEffects[creditCard]; ok {
-			shopCost = 0
-		}
-		if p.Pennies > shopCost && emptyEs {
+		if p.purchasesLeft() > 0 && p.Pennies >= p.getShopCost() && emptyEs {
 			actions = append(actions, actionReaction{msg: "Buy an Item from the Shop", value: buyItem})
 		}
-		if p.numAttacks > 0 && emptyEs {
+		if p.inBattle && battle != nil && emptyEs {
+			actions = append(actions, actionReaction{msg: battle.String(), value: attackMonster})
+		} else if p.attacksLeft() > 0 && emptyEs {
 			actions = append(actions, actionReaction{msg: "Attack!", value: attackMonster})
 		}
 	}
-	if !p.Character.tapped {
+	if !p.Character.tapped && !p.hasCurse(curseOfFatigue) && len(p.Hand) > 0 { // It plays a card from their hand
 		m := fmt.Sprintf("Activate Character Card (%s)", p.Character.name)
 		actions = append(actions, actionReaction{msg: m, value: activateCharacter})
 	}
-	if len(p.getUsableActiveItems()) > 0 { // TODO check conditions for active items to activate
+	if len(p.getUsableActiveItems()) > 0 {
 		actions = append(actions, actionReaction{msg: "Activate an Item", value: activateItem})
 	}
 	if _, err := p.getItemIndex(theresOptions, true); err == nil {
 		actions = append(actions, actionReaction{msg: "Peek at the Treasure deck", value: peekTheresOptions})
 	}
+	actions = append(actions, actionReaction{msg: "Read a card in play", value: readCard})
+	if len(p.effects.list()) > 0 {
+		actions = append(actions, actionReaction{msg: "See the effects in play", value: viewEffects})
+	}
 	if isActivePlayer && !p.inBattle && p.numForcedDeckAttacks == 0 && !p.forceAttackOnAny && emptyEs {
 		actions = append(actions, actionReaction{msg: "End your turn", value: endActivePlayerTurn})
 	} else if !isActivePlayer || (isActivePlayer && !emptyEs) {
@@ -533,6 +479,39 @@ func (p player) getPlayerActions(isActivePlayer bool, emptyEs bool) []actionReac
 	return actions
 }
 
+// Get every card a player can read without peeking: their own hand, each player's
+// character, items, curses and souls, the active monsters and the shop.
+func (b *Board) getVisibleCards(p *player) []card {
+	cards := make([]card, 0, 16)
+	for i := range p.Hand {
+		cards = append(cards, p.Hand[i])
+	}
+	for _, pl := range b.getPlayers(false) {
+		cards = append(cards, pl.Character)
+		for i := range pl.ActiveItems {
+			cards = append(cards, pl.ActiveItems[i])
+		}
+		for i := range pl.PassiveItems {
+			cards = append(cards, pl.PassiveItems[i])
+		}
+		for i := range pl.Curses {
+			cards = append(cards, pl.Curses[i])
+		}
+		for i := range pl.Souls {
+			cards = append(cards, pl.Souls[i].card)
+		}
+	}
+	for _, m := range b.monster.getActiveMonsters() {
+		if m != nil {
+			cards = append(cards, *m)
+		}
+	}
+	for i := range b.treasure.zones {
+		cards = append(cards, b.treasure.zones[i])
+	}
+	return cards
+}
+
 // Get the player with the matching character.
 func (b *Board) getPlayerFromCharacterId(id uint16) (*player, error) {
 	var player *player
@@ -550,12 +529,12 @@ func (b *Board) getPlayerFromCharacterId(id uint16) (*player, error) {
 // element in the list being the current active player.
 func (b *Board) getPlayers(filterDead bool) []*player {
 	var l = uint8(len(b.players))
-	var players = make([]*player, l)
+	var players = make([]*player, 0, l)
 	var i = b.api
 	var j uint8
 	for j = 0; j < l; j++ {
 		if (filterDead && b.players[i].Character.hp > 0) || !filterDead {
-			players[i] = &b.players[i]
+			players = append(players, &b.players[i])
 		}
 		i = (i + 1) % l
 	}
@@ -569,36 +548,35 @@ func (b Board) getPreviousPlayer(cId uint16) *player {
 			break
 		}
 	}
-	next := (i - 1) % len(b.players)
+	next := (i - 1 + len(b.players)) % len(b.players) // the first player's previous is the last
 	return &b.players[next]
 }
 
-func (b *Board) getSouls() ([]card, map[uint16]*player) {
-	var souls []card
+// Every soul in play, along with who owns each one.
+func (b *Board) getSouls() ([]soul, map[uint16]*player) {
+	var souls []soul
 	playerMap := make(map[uint16]*player)
-	for _, p := range b.players {
+	for i := range b.players {
+		p := &b.players[i]
 		for _, s := range p.Souls {
 			souls = append(souls, s)
-			playerMap[s.getId()] = &p
+			playerMap[s.getId()] = p
 		}
 	}
 	return souls, playerMap
 }
 
-func (p player) getSouls() []card {
+func (p player) getSouls() []soul {
 	return p.Souls
 }
 
 func (p player) getSoulIndex(id uint16) (uint8, error) {
-	var i uint8
-	var err = errors.New("soul not found")
-	for i = range p.Souls {
+	for i := range p.Souls {
 		if p.Souls[i].getId() == id {
-			err = nil
-			break
+			return uint8(i), nil
 		}
 	}
-	return i, err
+	return 0, wrapError(ErrInvalidTarget, "%s has no soul %d", p.Character.name, id)
 }
 
 func (p player) getTappedActiveItems() []*treasureCard {
@@ -626,25 +604,30 @@ func (es eventStack) getTriggeredEffectEvents() []*eventNode {
 	return nodes
 }
 
-// TODO: Add a requirement function to each card to see if it can be activated
+// The items the player could activate: active items that are not tapped, and paid items.
+// TODO: Add a requirement function to each card to see if its cost can be paid
 func (p player) getUsableActiveItems() []*treasureCard {
 	var cards = make([]*treasureCard, 0, len(p.ActiveItems))
 	for i := range p.ActiveItems {
-		c := &p.ActiveItems[i]
-		if c.active && c.paid {
-			// TODO check if can be activated
-			cards = append(cards, c)
-		} else if c.active && !c.tapped {
-			// TODO check if can be activated
-			cards = append(cards, c)
-		} else { // if c.paid
-			// TODO check if can be activated
+		if c := &p.ActiveItems[i]; (c.active || c.paid) && !c.tapped {
 			cards = append(cards, c)
 		}
 	}
 	return cards
 }
 
+// Whether any of the actions responds to what is on the stack: playing a card, activating something...
+// Reading cards and passing don't.
+func holdsResponse(actions []actionReaction) bool {
+	for _, a := range actions {
+		switch a.value {
+		case playLootCard, buyItem, attackMonster, activateCharacter, activateItem, peekTheresOptions:
+			return true
+		}
+	}
+	return false
+}
+
 func getValidGuppyItems() map[uint16]struct{} {
 	return map[uint16]struct{}{
 		guppysCollar: {},
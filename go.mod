@@ -0,0 +1,3 @@
+module github.com/ZeDespo/four_souls
+
+go 1.21
@@ -2,9 +2,24 @@ package four_souls
 
 import (
 	"errors"
-	"fmt"
 )
 
+// Helper for effects that put a monster into an active slot.
+// Every zone is listed, empty ones included, so the answer is the index of the zone.
+func (m *mArea) chooseZoneHelper(b *Board, question string) int {
+	zones := make([]monsterCard, len(m.zones))
+	for i := range m.zones {
+		if m.zones[i].isEmpty() {
+			zones[i].name = "(empty)"
+		} else {
+			zones[i] = *m.zones[i].peek()
+		}
+	}
+	b.ui.showMonsterCards(zones, 0)
+	b.ui.Println(question)
+	return b.ui.readInput(0, len(zones)-1)
+}
+
 // Bomb / Gold Bomb loot card helper.
 // Check if the targeted monster is still on the field.
 // If it is, push a 1 damage event to the event stack.
@@ -40,51 +55,13 @@ func (p *player) bombHelper(ap *player, b *Board, n uint8) lootCardEffect {
 
 // Helper for the Bum-Bo passive value
 // Whenever Bum-Bo is on the field, the player who owns it puts counters
-// on the value whenever they gain cents. This helper determines which effects
-// are currently switched on
+// on the value whenever they gain cents. The effects switched on by the
+// counters are declared as Bum-Bo's modifiers.
 
-// p *player: the player who owns the card
 // bumboTC *treasureCard: the pointer to the Bum-Bo treasure card
 // n int8: The number of counters to add to the card
 func (p *player) bumboAddCounterHelper(bumboTC *treasureCard, n int8) {
-	beforeCounters := bumboTC.counters
 	bumboTC.counters += n
-	afterCounters := bumboTC.counters
-	if beforeCounters < 1 && afterCounters >= 1 && !p.inBattle { // Add two to first attack roll if not in battle
-		p.activeEffects[bumbo] = struct{}{}
-	}
-	if beforeCounters < 10 && afterCounters >= 10 {
-		p.increaseAP(1)
-		p.increaseBaseAttack(1)
-	}
-	if beforeCounters < 25 && afterCounters >= 25 {
-		p.numAttacks += 99
-		p.baseNumAttacks += 99
-	}
-}
-
-// Helper for Dagaz.
-// Activate the curse destruction card by selecting which
-// curse to destroy. Return a function that will destroy the selected
-// curse once called upon in the event stack.
-// Assumes the number of curses is greater than 0.
-// p *player: The player that has a curse.
-// m *mArea: The monster area of the board
-// l int: the number of curses
-func (p *player) dagazCurseHelper(m *mArea, l int) lootCardEffect {
-	var i uint8
-	if l > 1 {
-		showMonsterCards(p.Curses, 0)
-		i = uint8(readInput(0, l-1))
-	}
-	curseId := p.Curses[i].id
-	return func(roll uint8, blankCard bool) {
-		i, err := p.getCurseIndex(curseId)
-		if err == nil {
-			c := p.popCurse(i)
-			m.discard(&c)
-		}
-	}
 }
 
 func (b *Board) preventDamageHelper(p *player, damageNode *eventNode) {
@@ -112,25 +89,11 @@ func (b *Board) preventDamageHelper(p *player, damageNode *eventNode) {
 
 func (p *player) discardHandChoiceHelper(la *lArea, n uint8) {
 	var i uint8
-	for i = 0; i < n; i++ {
-		showLootCards(p.Hand, p.Character.name, 0)
-		fmt.Println("Choose what to discard")
-		la.discard(p.popHandCard(uint8(readInput(0, len(p.Hand)-1))))
-	}
-}
-
-func (m *mArea) fillMonsterZone(ap *player, b *Board, i uint8) {
-	card := m.draw()
-	for card.isBonusCard() {
-		if f, special, err := card.f(ap, b, card); err == nil {
-			b.eventStack.push(event{p: ap, e: triggeredEffectEvent{c: card, f: f}})
-			if special {
-				b.rollDiceAndPush()
-			}
-		}
-		card = m.draw()
+	for i = 0; i < n && len(p.Hand) > 0; i++ {
+		p.ui.showHand(p, 0)
+		p.ui.Println("Choose what to discard")
+		la.discard(p.popHandCard(uint8(p.ui.readDiscard(len(p.Hand)))))
 	}
-	m.zones[i].push(card)
 }
 
 // Helper for the "Baby/Daddy/Mama Haunt" card.
@@ -142,10 +105,12 @@ func (b *Board) hauntGiveAwayHelper(p *player, hauntCard itemCard) {
 		others := b.getOtherPlayers(p, false)
 		for _, id := range hauntIds {
 			if i, err := p.getItemIndex(id, true); err == nil {
-				showPlayers(others, 0)
-				fmt.Println("Who to give", p.PassiveItems[i].getName(), "to?")
-				target := others[i]
-				target.addCardToBoard(p.popPassiveItem(i))
+				b.ui.showPlayers(others, 0)
+				b.ui.Println("Who to give", p.PassiveItems[i].getName(), "to?")
+				target := others[b.ui.readInput(0, len(others)-1)]
+				if c, err := p.popPassiveItem(i); err == nil {
+					target.addCardToBoard(c)
+				}
 			}
 		}
 	}
@@ -153,14 +118,14 @@ func (b *Board) hauntGiveAwayHelper(p *player, hauntCard itemCard) {
 
 func (b *Board) incubus(p, p2 *player) cardEffect {
 	return func(roll uint8) {
-		fmt.Println("0) Do Nothing.")
-		showLootCards(p2.Hand, p2.Character.name, 1)
-		j := uint8(readInput(0, len(p2.Hand)))
-		if j > 0 {
+		b.ui.menu(0, "Do Nothing.")
+		b.ui.showHand(p2, 1)
+		j := uint8(b.ui.readChoice(0, 0, len(p2.Hand)))
+		if j > 0 && len(p.Hand) > 0 { // nothing to trade with an empty hand
 			j -= 1
-			showLootCards(p.Hand, p.Character.name, 0)
-			fmt.Println("Choose a value to give to your opponent.")
-			i := uint8(readInput(0, len(p.Hand)-1))
+			b.ui.showHand(p, 0)
+			b.ui.Println("Choose a value to give to your opponent.")
+			i := uint8(b.ui.readInput(0, len(p.Hand)-1))
 			p2Card := p2.Hand[j]
 			p2.Hand[j] = p.Hand[i]
 			p.Hand[i] = p2Card
@@ -171,16 +136,16 @@ func (b *Board) incubus(p, p2 *player) cardEffect {
 func (p *player) incubus(l *lArea) cardEffect {
 	return func(roll uint8) {
 		p.loot(l)
-		showLootCards(p.Hand, p.Character.name, 0)
-		fmt.Println("Place value on top of the loot deck.")
-		ans := readInput(0, len(p.Hand)-1)
+		p.ui.showHand(p, 0)
+		p.ui.Println("Place value on top of the loot deck.")
+		ans := p.ui.readInput(0, len(p.Hand)-1)
 		l.placeInDeck(p.popHandCard(uint8(ans)), true)
 	}
 }
 
 // Helper for judgement
 // Iterate through all players in the game, and collect
-// the number of souls each player has. Represent them in a
+// the value of the souls each player has. Represent them in a
 // map where key = number of souls and value = player(s) with
 // that number of souls.
 // Additionally, return the key that contains the player(s) with
@@ -191,7 +156,7 @@ func (b *Board) judgementHelper() (map[uint8][]*player, uint8) {
 	soulsMap := make(map[uint8][]*player)
 	var max uint8
 	for i := range players {
-		l := uint8(len(b.players[i].Souls))
+		l := players[i].soulValue()
 		if _, ok := soulsMap[l]; !ok {
 			soulsMap[l] = make([]*player, 0, numPlayers)
 		}
@@ -204,25 +169,37 @@ func (b *Board) judgementHelper() (map[uint8][]*player, uint8) {
 }
 
 // Helper for cains eye, golden horse Shoe, and Purple Heart
-func (b *Board) peekTrinketHelper(id uint16) cardEffect {
-	cardDeckMap := map[uint16]*deck{
-		cainsEye: &b.loot.deck, purpleHeart: &b.monster.deck, goldenHorseShoe: &b.treasure.deck}
+// Only the player sees the card. If it goes back on top, they keep knowing it; so they do at the bottom.
+func (b *Board) peekTrinketHelper(p *player, id uint16) (cardEffect, error) {
+	type peekedDeck struct {
+		d *deck
+		z zone
+	}
+	cardDeckMap := map[uint16]peekedDeck{
+		cainsEye:        {&b.loot.deck, zone{kind: lootDeckZone}},
+		purpleHeart:     {&b.monster.deck, zone{kind: monsterDeckZone}},
+		goldenHorseShoe: {&b.treasure.deck, zone{kind: treasureDeckZone}}}
 	var f cardEffect
-	if d, ok := cardDeckMap[id]; ok {
+	var err error
+	if pd, ok := cardDeckMap[id]; ok {
 		f = func(roll uint8) {
-			if c, err := d.peek(); err == nil {
-				c.showCard(0)
-				fmt.Println("1) Place this card on the bottom of the deck.\n2) Place it back on top.")
-				if readInput(1, 2) == 1 {
-					c, _ = d.pop()
+			defer b.ui.privately(p)()
+			if c, err := pd.d.peek(); err == nil {
+				b.ui.showDeck(deck{c}, false)
+				b.ui.menu(1, "Place this card on the bottom of the deck.", "Place it back on top.")
+				if b.ui.readChoice(2, 1, 2) == 1 {
+					c, _ = pd.d.pop()
 					b.placeInDeck(c, false)
+					b.reveal(pd.z, p, 0)
+				} else {
+					b.revealTop(pd.z, 1, p)
 				}
 			}
 		}
 	} else {
-		panic(fmt.Errorf("card with id %d should not have called this function", id))
+		err = wrapError(ErrInvalidCard, "card with id %d does not peek at a deck", id)
 	}
-	return f
+	return f, err
 }
 
 // Helper for the "Remote Detonator" treasure card
@@ -236,10 +213,13 @@ func (b *Board) remoteDetonatorVoteHelper() (map[uint16]uint8, map[uint16]bool,
 	itemVotes := make(map[uint16]uint8, len(b.players)) // key = value id; value = number of votes
 	cardType := make(map[uint16]bool, len(b.players))   // key = value id: value = isPassive
 	items, owners := b.getAllItems(false, nil)
-	showItems(items, 0)
+	if len(items) == 0 {
+		return itemVotes, cardType, owners
+	}
 	for range b.getPlayers(false) {
-		fmt.Println("Vote for the item to destroy.")
-		ans := readInput(0, len(items)-1)
+		b.ui.showItems(items, 0)
+		b.ui.Println("Vote for the item to destroy.")
+		ans := b.ui.readInput(0, len(items)-1)
 		id, isPassive := items[ans].getId(), items[ans].isPassive()
 		if _, ok := itemVotes[id]; !ok {
 			itemVotes[id] = 0
@@ -252,21 +232,25 @@ func (b *Board) remoteDetonatorVoteHelper() (map[uint16]uint8, map[uint16]bool,
 }
 
 // Helper to "The Bone" to get off it's first paid effect of adding one to any dice roll
-func (es *eventStack) theBoneFirstPaidHelper(tc *treasureCard) (cardEffect, error) {
+func (b *Board) theBoneFirstPaidHelper(tc *treasureCard) (cardEffect, error) {
 	var f cardEffect
 	var err error
-	rolls := es.getDiceRollEvents()
+	rolls := b.eventStack.getDiceRollEvents()
 	l := len(rolls)
 	if l == 0 {
 		err = errors.New("no dice roll events")
 	} else {
-		tc.loseCounters(1)
 		var i uint8
 		if l > 1 {
-			showEvents(rolls)
-			i = uint8(readInput(0, l))
+			b.ui.showEvents(rolls)
+			i = uint8(b.ui.readInput(0, l-1))
+		}
+		id := rolls[i].id
+		f = func(roll uint8) {
+			if node, err := b.getEventNodeById(id); err == nil {
+				_ = b.eventStack.addToDiceRoll(1, node)
+			}
 		}
-		f = func(roll uint8) { es.addToDiceRoll(1, rolls[i]) }
 	}
 	return f, err
 }
@@ -274,9 +258,9 @@ func (es *eventStack) theBoneFirstPaidHelper(tc *treasureCard) (cardEffect, erro
 // Helper for "The Bone" to get off it's second paid effect of damaging another monster or player by 1 damage
 func (b *Board) theBoneSecondPaidHelper(ap *player, players []*player, monsters []*monsterCard) cardEffect {
 	l := len(players)
-	showPlayers(players, 0)
-	showMonsterCards(monsters, l)
-	ans := readInput(0, l-1)
+	b.ui.showPlayers(players, 0)
+	b.ui.showMonsterCards(monsters, l)
+	ans := b.ui.readInput(0, l-1)
 	var f cardEffect = func(roll uint8) {
 		e, de := event{}, damageEvent{n: 1}
 		if ans < l {
@@ -296,18 +280,18 @@ func (b *Board) theBoneSecondPaidHelper(ap *player, players []*player, monsters
 // Dagaz, Soul Heart, and the Hierophant are such examples.
 // The blank card will double the amount of damage prevented.
 // Assumes the length will always be greater than 0.
-func (es *eventStack) preventDamageWithLootHelper(damageEvents []*eventNode, n uint8) lootCardEffect {
+func (b *Board) preventDamageWithLootHelper(damageEvents []*eventNode, n uint8) lootCardEffect {
 	var i uint8
 	l := len(damageEvents)
 	if l > 1 {
-		showEvents(damageEvents)
-		i = uint8(readInput(0, l-1))
+		b.ui.showEvents(damageEvents)
+		i = uint8(b.ui.readInput(0, l-1))
 	}
 	return func(roll uint8, blankCard bool) {
 		if blankCard {
 			n *= 2
 		}
-		_ = es.preventDamage(n, damageEvents[i])
+		_ = b.eventStack.preventDamage(n, damageEvents[i])
 	}
 }
 
@@ -339,18 +323,6 @@ func mergeEventSlices(a []*eventNode, b []*eventNode) []*eventNode {
 	return c
 }
 
-func modifyAttack(p *player, n uint8, leavingField bool) {
-	if !leavingField {
-		p.increaseBaseAttack(n)
-		p.increaseAP(n)
-	} else {
-		p.decreaseBaseAttack(n)
-		if p.Character.ap > p.Character.baseAttack {
-			p.decreaseAP(n)
-		}
-	}
-}
-
 // No dice roll can be below 1 or above 6.
 // This helper function assures any additions or subtractions
 // will keep the dice within these bounds.
@@ -361,17 +333,22 @@ func modifyDiceRoll(originalRoll *uint8, x int8) {
 	} else if y < 1 {
 		y = 1
 	}
+	*originalRoll = uint8(y)
 }
 
-func modifyHealth(p *player, n uint8, leavingField bool) {
-	if !leavingField {
-		p.increaseBaseHealth(n)
-		p.increaseHP(n)
-	} else {
-		p.decreaseBaseHealth(n)
-		if p.Character.hp > p.Character.baseHealth {
-			p.decreaseHP(n)
+// Continuous effect for items that raise the character's health.
+// The maximum health is derived from the item's modifiers; this keeps the
+// current health in step with it. Gaining the item heals the health it adds,
+// losing it brings the health down to the new maximum.
+func modifyHealth(p *player, b *Board, c card, isLeaving bool) {
+	if !isLeaving {
+		for _, m := range cardModifiers[c.getId()] {
+			if m.s == healthStat && m.n > 0 {
+				p.increaseHP(uint8(m.n))
+			}
 		}
+	} else if max := p.getMaxHealth(); p.Character.hp > max {
+		p.Character.hp = max
 	}
 }
 
@@ -0,0 +1,54 @@
+package four_souls
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+)
+
+// Plays every seat of a shared terminal, line by line, for players passing it around.
+// When another player has to decide, the screen is cleared and the game waits for them
+// to take the terminal. Only then are they shown what is for their eyes only: their hand,
+// the cards they peek at... Until then, it is held back.
+type HotSeat struct {
+	out     io.Writer
+	prompt  *promptDecider
+	current string // The player holding the terminal
+}
+
+func NewHotSeat(in io.Reader, out io.Writer) *HotSeat {
+	return &HotSeat{out: out, prompt: &promptDecider{in: bufio.NewReader(in), out: out}}
+}
+
+func (hs *HotSeat) Show(b *Board, s string) {
+	_, _ = fmt.Fprint(hs.out, s)
+}
+
+// What is for another player is part of their next decision, shown once they take the terminal.
+func (hs *HotSeat) ShowTo(b *Board, player string, s string) {
+	if player == hs.current {
+		_, _ = fmt.Fprint(hs.out, s)
+	}
+}
+
+func (hs *HotSeat) Decide(b *Board, d Decision) (int, error) {
+	if d.Player != "" && d.Player != hs.current {
+		if err := hs.handOff(d.Player); err != nil {
+			return 0, err
+		}
+		_, _ = fmt.Fprint(hs.out, d.Text) // The screen was cleared: show the player what they are deciding on
+	}
+	return hs.prompt.Decide(b, d)
+}
+
+// Clear the screen and wait for the player to take the terminal.
+func (hs *HotSeat) handOff(player string) error {
+	_, _ = fmt.Fprintf(hs.out, "%s%sPass the terminal to %s.\n%s, press Enter once no one else can see the screen.",
+		ansiClear, ansiHome, player, player)
+	if _, _, err := hs.prompt.readLine(nil); err != nil { // Through the prompt, which may be reading already
+		return fmt.Errorf("input closed while passing the terminal to %s: %w", player, err)
+	}
+	_, _ = fmt.Fprint(hs.out, ansiClear+ansiHome)
+	hs.current = player
+	return nil
+}
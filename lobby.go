@@ -0,0 +1,330 @@
+package four_souls
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// Where players meet to set up games. Someone opens a table with the options of their game,
+// others join it and bots fill the seats no one takes. Once every seat is taken and every player
+// is ready, the table's game starts. Each game is played on its own board, so the lobby runs
+// any number of them at once.
+type Lobby struct {
+	mu     sync.Mutex
+	tables map[string]*Table
+}
+
+// A table of the lobby, and the game played at it once it starts.
+type Table struct {
+	name     string
+	options  GameOptions
+	seats    []tableSeat
+	board    *Board // nil until the game starts
+	deciders *Seats
+	watchers []*Spectator  // Who watches before the game starts
+	started  chan struct{} // Closed once the game is dealt. The seats don't change after
+	done     chan struct{} // Closed once the game is over
+	winners  []string
+	err      error
+}
+
+type tableSeat struct {
+	user  string // Who sat down. Empty for an open seat
+	d     Decider
+	bot   bool
+	ready bool
+}
+
+// A table as the lobby lists it.
+type TableInfo struct {
+	Name     string      `json:"name"`
+	Options  GameOptions `json:"options"`
+	Seated   []string    `json:"seated"` // The users at the table, bots included, in seat order
+	Open     int         `json:"open"`   // The number of seats left
+	Started  bool        `json:"started"`
+	Finished bool        `json:"finished"`
+}
+
+func NewLobby() *Lobby {
+	return &Lobby{tables: make(map[string]*Table)}
+}
+
+// Open a table for a game set up with the options. The seed is picked when the game starts if it is 0.
+func (l *Lobby) CreateTable(name string, o GameOptions) (*Table, error) {
+	if name == "" {
+		return nil, wrapError(ErrInvalidInput, "a table needs a name")
+	}
+	if err := o.validate(); err != nil {
+		return nil, err
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, ok := l.tables[name]; ok {
+		return nil, wrapError(ErrInvalidInput, "there already is a table named %q", name)
+	}
+	t := &Table{name: name, options: o, seats: make([]tableSeat, o.Players),
+		started: make(chan struct{}), done: make(chan struct{})}
+	l.tables[name] = t
+	return t, nil
+}
+
+// Every table, by name.
+func (l *Lobby) Tables() []TableInfo {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	infos := make([]TableInfo, 0, len(l.tables))
+	for _, t := range l.tables {
+		infos = append(infos, t.info())
+	}
+	sort.Slice(infos, func(i, j int) bool { return infos[i].Name < infos[j].Name })
+	return infos
+}
+
+// The table with the name.
+func (l *Lobby) Table(name string) (*Table, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if t, ok := l.tables[name]; ok {
+		return t, nil
+	}
+	return nil, wrapError(ErrInvalidInput, "there is no table named %q", name)
+}
+
+// Take the first open seat of the table for the user, who plays at the decider.
+func (l *Lobby) Join(name string, user string, d Decider) (*Table, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	t, ok := l.tables[name]
+	if !ok {
+		return nil, wrapError(ErrInvalidInput, "there is no table named %q", name)
+	}
+	if user == "" {
+		return nil, wrapError(ErrInvalidInput, "a player needs a name")
+	}
+	if t.board != nil {
+		return nil, wrapError(ErrInvalidInput, "the game at %q has started", name)
+	}
+	if t.seatOf(user) >= 0 {
+		return nil, wrapError(ErrInvalidInput, "%s already sits at %q", user, name)
+	}
+	i := t.openSeat()
+	if i < 0 {
+		return nil, wrapError(ErrInvalidInput, "the table %q is full", name)
+	}
+	t.seats[i] = tableSeat{user: user, d: d}
+	return t, nil
+}
+
+// Seat a bot at the first open seat of the table.
+func (l *Lobby) AddBot(name string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	t, ok := l.tables[name]
+	if !ok {
+		return wrapError(ErrInvalidInput, "there is no table named %q", name)
+	}
+	if t.board != nil {
+		return wrapError(ErrInvalidInput, "the game at %q has started", name)
+	}
+	i := t.openSeat()
+	if i < 0 {
+		return wrapError(ErrInvalidInput, "the table %q is full", name)
+	}
+	var n int
+	for _, s := range t.seats {
+		if s.bot {
+			n += 1
+		}
+	}
+	t.seats[i] = tableSeat{user: botName(n + 1), bot: true, ready: true}
+	return nil
+}
+
+// Let the spectator watch the game at the table, from now on or from when it starts.
+func (l *Lobby) Watch(name string, sp *Spectator) (*Table, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	t, ok := l.tables[name]
+	if !ok {
+		return nil, wrapError(ErrInvalidInput, "there is no table named %q", name)
+	}
+	if t.deciders != nil {
+		t.deciders.Watch(sp)
+	} else {
+		t.watchers = append(t.watchers, sp)
+	}
+	return t, nil
+}
+
+// Give up the user's seat before the game starts. A table everyone left is closed.
+func (l *Lobby) Leave(name string, user string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	t, ok := l.tables[name]
+	if !ok {
+		return wrapError(ErrInvalidInput, "there is no table named %q", name)
+	}
+	if t.board != nil {
+		return wrapError(ErrInvalidInput, "the game at %q has started", name)
+	}
+	i := t.seatOf(user)
+	if i < 0 {
+		return wrapError(ErrInvalidInput, "%s doesn't sit at %q", user, name)
+	}
+	t.seats[i] = tableSeat{}
+	for _, s := range t.seats {
+		if s.user != "" && !s.bot {
+			return nil
+		}
+	}
+	delete(l.tables, name)
+	t.dismiss("Everyone left the table.")
+	return nil
+}
+
+// The user is ready to play. Once every seat is taken and everyone is ready, the game starts.
+// return: true if this started the game.
+func (l *Lobby) Ready(name string, user string) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	t, ok := l.tables[name]
+	if !ok {
+		return false, wrapError(ErrInvalidInput, "there is no table named %q", name)
+	}
+	i := t.seatOf(user)
+	if i < 0 {
+		return false, wrapError(ErrInvalidInput, "%s doesn't sit at %q", user, name)
+	}
+	t.seats[i].ready = true
+	if t.board != nil || t.openSeat() >= 0 {
+		return false, nil
+	}
+	for _, s := range t.seats {
+		if !s.ready {
+			return false, nil
+		}
+	}
+	if err := t.start(); err != nil {
+		delete(l.tables, name) // The game can't be dealt: there is nothing to wait for
+		t.dismiss(fmt.Sprintf("The game couldn't be dealt: %v", err))
+		return false, err
+	}
+	go func() {
+		t.play()
+		l.mu.Lock()
+		if l.tables[name] == t {
+			delete(l.tables, name)
+		}
+		l.mu.Unlock()
+	}()
+	return true, nil
+}
+
+func (t *Table) info() TableInfo {
+	ti := TableInfo{Name: t.name, Options: t.options, Seated: make([]string, 0, len(t.seats)), Started: t.board != nil}
+	for _, s := range t.seats {
+		if s.user == "" {
+			ti.Open += 1
+		} else {
+			ti.Seated = append(ti.Seated, s.user)
+		}
+	}
+	select {
+	case <-t.done:
+		ti.Finished = true
+	default:
+	}
+	return ti
+}
+
+func (t *Table) openSeat() int {
+	for i, s := range t.seats {
+		if s.user == "" {
+			return i
+		}
+	}
+	return -1
+}
+
+func (t *Table) seatOf(user string) int {
+	for i, s := range t.seats {
+		if s.user == user {
+			return i
+		}
+	}
+	return -1
+}
+
+// Deal the game, and seat each user at the character dealt to their seat.
+func (t *Table) start() error {
+	b, err := NewGameWithOptions(t.options)
+	if err != nil {
+		return err
+	}
+	seats := NewSeats()
+	for i, name := range b.Players() {
+		d := t.seats[i].d
+		if t.seats[i].bot {
+			d = NewBot(b.Options().Seed + int64(i))
+		}
+		seats.Sit(name, d)
+	}
+	for _, sp := range t.watchers {
+		seats.Watch(sp)
+	}
+	b.SetDecider(seats)
+	t.options, t.board, t.deciders, t.watchers = b.Options(), b, seats, nil
+	close(t.started)
+	return nil
+}
+
+// Tell who watches a table closed before its game started.
+func (t *Table) dismiss(why string) {
+	for _, sp := range t.watchers {
+		sp.Show(nil, why+"\n")
+		sp.Close()
+	}
+	t.watchers = nil
+}
+
+func (t *Table) play() {
+	defer close(t.done)
+	t.winners, t.err = t.board.Play()
+}
+
+func (t *Table) Name() string {
+	return t.name
+}
+
+// Closed once the game starts.
+func (t *Table) Started() <-chan struct{} {
+	return t.started
+}
+
+// Closed once the game is over.
+func (t *Table) Finished() <-chan struct{} {
+	return t.done
+}
+
+// The character the user plays, once the game has started.
+func (t *Table) Character(user string) (string, bool) {
+	select {
+	case <-t.started:
+		if i := t.seatOf(user); i >= 0 {
+			return t.board.players[i].Character.name, true
+		}
+	default:
+	}
+	return "", false
+}
+
+// Wait for the game to end, and return who won.
+func (t *Table) Wait() ([]string, error) {
+	<-t.done
+	return t.winners, t.err
+}
+
+func botName(n int) string {
+	return fmt.Sprintf("Bot %d", n)
+}
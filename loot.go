@@ -22,8 +22,7 @@ package four_souls
 
 import (
 	"errors"
-	"fmt"
-	"math/rand"
+	"math"
 )
 
 // Basic loot
@@ -138,7 +137,7 @@ func aSackFunc(p *player, b *Board) (lootCardEffect, bool, error) {
 func blankRuneFunc(p *player, b *Board) (lootCardEffect, bool, error) {
 	var f lootCardEffect = func(roll uint8, blankCard bool) {
 		for i := 0; i < len(b.players); i++ {
-			p := b.players[i]
+			p := &b.players[i]
 			switch roll {
 			case 1:
 				var n int8 = 1
@@ -160,6 +159,9 @@ func blankRuneFunc(p *player, b *Board) (lootCardEffect, bool, error) {
 					n *= 2
 				}
 				p.decreaseHP(n)
+				if p.isDead() {
+					b.pushDeath(p)
+				}
 			case 4:
 				var n int8 = 4
 				if blankCard {
@@ -193,9 +195,9 @@ func bombFunc(p *player, b *Board) (lootCardEffect, bool, error) {
 	mCards := b.monster.getActiveMonsters()
 	a := len(mCards)
 	players := b.getPlayers(true)
-	showMonsterCards(mCards, 0)
-	showPlayers(players, a)
-	ans := readInput(0, a+len(players)-1)
+	b.ui.showMonsterCards(mCards, 0)
+	b.ui.showPlayers(players, a)
+	ans := b.ui.readInput(0, a+len(players)-1)
 	var f lootCardEffect
 	if ans < a {
 		f = b.monster.bombHelper(p, b, mCards[ans], 1)
@@ -215,21 +217,32 @@ func butterBeanFunc(p *player, b *Board) (lootCardEffect, bool, error) {
 	aIEvents := b.eventStack.getActivateItemEvents()
 	lCEvents := b.eventStack.getLootCardEvents()
 	events := mergeEventSlices(aIEvents, lCEvents)
-	showEvents(events)
-	ans := readInput(0, len(events)-1)
-	node := events[ans]
-	n := events[ans].event.e
+	if len(events) == 0 {
+		return nil, false, errors.New("butter bean has no applicable target")
+	}
+	b.ui.showEvents(events)
+	ans := b.ui.readInput(0, len(events)-1)
+	id, n := events[ans].id, events[ans].event.e
 	var err error
 	var f lootCardEffect = func(roll uint8, blankCard bool) {
-		nextNodeEvent := node.next.event.e
-		if _, ok := nextNodeEvent.(damageEvent); ok { // golden razor blade, bombs, troll bombs, etc
-			_ = b.eventStack.fizzle(node.next)
-		} else if _, ok := nextNodeEvent.(diceRollEvent); ok { // pills, high priestess, the d6
-			_ = b.eventStack.fizzle(node.next)
-		} else if _, ok := nextNodeEvent.(deathOfCharacterEvent); ok { // deathPenalty tarot value
-			_ = b.eventStack.fizzle(node.next)
+		node, err := b.getEventNodeById(id)
+		if err != nil { // It resolved before it could be cancelled
+			return
+		}
+		if node.next != nil {
+			switch node.next.event.e.(type) {
+			case damageEvent: // golden razor blade, bombs, troll bombs, etc
+				_ = b.eventStack.fizzle(node.next)
+			case diceRollEvent: // pills, high priestess, the d6
+				_ = b.eventStack.fizzle(node.next)
+			case deathOfCharacterEvent: // deathPenalty tarot value
+				_ = b.eventStack.fizzle(node.next)
+			}
+		}
+		e, isLoot := node.event.e.(lootCardEvent)
+		if err := b.eventStack.fizzle(node); err == nil && isLoot && e.l.trinket { // A cancelled trinket never reaches its owner's board
+			b.discard(e.l)
 		}
-		_ = b.eventStack.fizzle(node)
 	}
 	_, ok1 := n.(activateEvent)
 	_, ok2 := n.(lootCardEvent)
@@ -239,6 +252,16 @@ func butterBeanFunc(p *player, b *Board) (lootCardEffect, bool, error) {
 	return f, false, err
 }
 
+// Basic loot
+// The next item you buy this turn costs 0 cents.
+// The blank card has no effect
+func creditCardFunc(p *player, b *Board) (lootCardEffect, bool, error) {
+	var f lootCardEffect = func(roll uint8, blankCard bool) {
+		p.addEffect(creditCard, nil, untilEndOfTurn)
+	}
+	return f, false, nil
+}
+
 // Basic loot
 // Choose one:
 // Destroy a curse.
@@ -251,23 +274,30 @@ func dagazFunc(p *player, b *Board) (lootCardEffect, bool, error) {
 	var e error
 	var f lootCardEffect
 	damageEvents := b.eventStack.getDamageOfCharacterEvents()
-	lde, lpc := len(damageEvents), len(p.Curses)
+	cursed := b.getCursedPlayers()
+	lde, lpc := len(damageEvents), len(cursed)
+	destroyCurse := func() lootCardEffect {
+		var destroy func() bool
+		if destroy, e = b.destroyCurseHelper(cursed); e != nil {
+			return nil
+		}
+		return func(roll uint8, blankCard bool) { destroy() }
+	}
 	if lde == 0 && lpc == 0 {
 		e = errors.New("no requirements for dagaz met")
 		return f, false, e
 	} else if lpc > 0 && lde == 0 { // destroy curse only option
-		f = p.dagazCurseHelper(b.monster, lpc)
+		f = destroyCurse()
 	} else if lpc == 0 && lde > 0 {
-		f = b.eventStack.preventDamageWithLootHelper(damageEvents, 1)
+		f = b.preventDamageWithLootHelper(damageEvents, 1)
 	} else {
-		fmt.Println("Choose which effect to activate:\n" +
-			"1) Destroy a curse\n" +
-			"2) Prevent 1 Damage to a player.")
-		ans := readInput(1, 2)
+		b.ui.Println("Choose which effect to activate:")
+		b.ui.menu(1, "Destroy a curse", "Prevent 1 Damage to a player.")
+		ans := b.ui.readInput(1, 2)
 		if ans == 1 {
-			f = p.dagazCurseHelper(b.monster, lpc)
+			f = destroyCurse()
 		} else {
-			f = b.eventStack.preventDamageWithLootHelper(damageEvents, 1)
+			f = b.preventDamageWithLootHelper(damageEvents, 1)
 		}
 	}
 	return f, false, e
@@ -284,8 +314,8 @@ func deathTarotCardFunc(p *player, b *Board) (lootCardEffect, bool, error) {
 	if l == 0 {
 		return f, false, errors.New("no live players for deathPenalty tarot")
 	} else if l > 1 {
-		showPlayers(players, 0)
-		i = uint8(readInput(0, l-1))
+		b.ui.showPlayers(players, 0)
+		i = uint8(b.ui.readInput(0, l-1))
 	}
 	target := players[i]
 	f = func(roll uint8, blankCard bool) { b.killPlayer(target) }
@@ -307,13 +337,17 @@ func diceShardFunc(p *player, b *Board) (lootCardEffect, bool, error) {
 		e = errors.New("no dice roll events on the stack")
 		return f, false, e
 	} else if l > 1 {
-		showEvents(nodes)
-		i = readInput(0, l-1)
+		b.ui.showEvents(nodes)
+		i = b.ui.readInput(0, l-1)
 	}
+	id := nodes[i].id
 	f = func(roll uint8, blankCard bool) {
-		diceRollNode := nodes[i]
+		diceRollNode, err := b.getEventNodeById(id)
+		if err != nil { // The roll already resolved
+			return
+		}
 		if _, ok := diceRollNode.event.e.(diceRollEvent); ok { // double confirm
-			diceRollNode.event = event{p: diceRollNode.event.p, e: diceRollEvent{n: uint8(rand.Intn(6) + 1)}}
+			diceRollNode.event = event{p: diceRollNode.event.p, e: diceRollEvent{n: uint8(b.rng.Intn(6) + 1)}}
 		}
 	}
 	return f, false, e
@@ -326,11 +360,9 @@ func diceShardFunc(p *player, b *Board) (lootCardEffect, bool, error) {
 func ehwazFunc(p *player, b *Board) (lootCardEffect, bool, error) {
 	m := b.monster
 	var f lootCardEffect = func(roll uint8, blankCard bool) {
-		var i uint8
-		for i = range m.zones {
-			mCard := m.zones[i].peek()
-			if !mCard.inBattle {
-				card := m.zones[i].pop()
+		for i := range m.zones { // The zones are refilled once the stack resolves
+			if zone := &m.zones[i]; !zone.isEmpty() && !zone.peek().inBattle {
+				card := zone.pop()
 				m.discard(&card)
 			}
 		}
@@ -338,6 +370,19 @@ func ehwazFunc(p *player, b *Board) (lootCardEffect, bool, error) {
 	return f, false, nil
 }
 
+// Basic loot
+// Cancel an attack. The attacking player may attack again this turn.
+// The blank card has no effect
+func getOutOfJailFunc(p *player, b *Board) (lootCardEffect, bool, error) {
+	if b.combat == nil && len(b.eventStack.getIntentionToAttackEvents()) == 0 {
+		return nil, false, wrapError(ErrNoEvent, "there is no attack to cancel")
+	}
+	var f lootCardEffect = func(roll uint8, blankCard bool) {
+		_ = b.cancelAttack(true)
+	}
+	return f, false, nil
+}
+
 // Basic loot
 // Deal 3 damage to a Monster or Player.
 // The blank card will double the damage dealt.
@@ -345,9 +390,9 @@ func goldBombFunc(p *player, b *Board) (lootCardEffect, bool, error) {
 	mCards := b.monster.getActiveMonsters()
 	a := len(mCards)
 	players := b.getPlayers(true)
-	showMonsterCards(mCards, 0)
-	showPlayers(players, a)
-	ans := readInput(0, a+len(players)-1)
+	b.ui.showMonsterCards(mCards, 0)
+	b.ui.showPlayers(players, a)
+	ans := b.ui.readInput(0, a+len(players)-1)
 	var f lootCardEffect
 	if ans < a {
 		f = b.monster.bombHelper(p, b, mCards[ans], 3)
@@ -368,8 +413,8 @@ func holyCardFunc(p *player, b *Board) (lootCardEffect, bool, error) {
 	}
 	var i uint8
 	if l > 1 {
-		showEvents(deathEvents)
-		i = uint8(readInput(0, l-1))
+		b.ui.showEvents(deathEvents)
+		i = uint8(b.ui.readInput(0, l-1))
 	}
 	node := deathEvents[i]
 	var f lootCardEffect = func(roll uint8, blankCard bool) {
@@ -396,8 +441,8 @@ func judgementFunc(p *player, b *Board) (lootCardEffect, bool, error) {
 	l := len(players)
 	var i uint8
 	if l > 1 {
-		showPlayers(players, 0)
-		i = uint8(readInput(0, l-1))
+		b.ui.showPlayers(players, 0)
+		i = uint8(b.ui.readInput(0, l-1))
 	}
 	target := players[i]
 	var f lootCardEffect = func(roll uint8, blankCard bool) {
@@ -405,12 +450,11 @@ func judgementFunc(p *player, b *Board) (lootCardEffect, bool, error) {
 		if blankCard {
 			n *= 2
 		}
-		for i = 0; i < n; i++ {
-			fmt.Println("Discard a soul value.")
-			showSouls(target.Souls, target.Character.name, 0)
-			ans := uint8(readInput(0, len(target.Souls)-1))
-			card := p.popSoul(ans)
-			b.discard(card)
+		for i = 0; i < n && len(target.Souls) > 0; i++ {
+			b.ui.Println("Discard a soul value.")
+			b.ui.showSouls(target.Souls, target.Character.name, 0)
+			ans := uint8(b.ui.readInput(0, len(target.Souls)-1))
+			_ = b.destroySoul(target, ans)
 		}
 	}
 	return f, false, nil
@@ -424,8 +468,8 @@ func justiceFunc(p *player, b *Board) (lootCardEffect, bool, error) {
 	l := len(players)
 	var i uint8
 	if l > 1 {
-		showPlayers(players, 0)
-		i = uint8(readInput(0, l-1))
+		b.ui.showPlayers(players, 0)
+		i = uint8(b.ui.readInput(0, l-1))
 	}
 	target := players[i]
 	loot := b.loot
@@ -449,8 +493,8 @@ func lilBatteryFunc(p *player, b *Board) (lootCardEffect, bool, error) {
 		cards := p.getTappedActiveItems()
 		l := len(cards)
 		if l > 0 {
-			showTreasureCards(cards, p.Character.name, 0)
-			ans := readInput(0, l-1)
+			b.ui.showTreasureCards(cards, p.Character.name, 0)
+			ans := b.ui.readInput(0, l-1)
 			cards[ans].recharge()
 		}
 	}
@@ -462,7 +506,7 @@ func lilBatteryFunc(p *player, b *Board) (lootCardEffect, bool, error) {
 // The blank card has no effect
 func lostSoulFunc(p *player, b *Board) (lootCardEffect, bool, error) {
 	var f lootCardEffect = func(roll uint8, blankCard bool) {
-		p.addSoulToBoard(lootCard{baseCard: baseCard{name: "Lost Soul", effect: "Gain this Soul.", id: lostSoul}})
+		p.gainSoul(lootCard{baseCard: baseCard{name: "Lost Soul", effect: "Gain this Soul.", id: lostSoul}})
 	}
 	return f, false, nil
 }
@@ -506,9 +550,9 @@ func pillsBlueFunc(p *player, b *Board) (lootCardEffect, bool, error) {
 			}
 			for i = 0; i < n; i++ {
 				if len(p.Hand) > 0 {
-					showLootCards(p.Hand, p.Character.name, 0)
-					fmt.Println("Discard a value.")
-					ans := uint8(readInput(0, len(p.Hand)))
+					b.ui.showHand(p, 0)
+					b.ui.Println("Discard a value.")
+					ans := uint8(b.ui.readDiscard(len(p.Hand)))
 					b.loot.discard(p.popHandCard(ans))
 				}
 			}
@@ -545,17 +589,13 @@ func pillsRedFunc(p *player, b *Board) (lootCardEffect, bool, error) {
 func pillsYellowFunc(p *player, b *Board) (lootCardEffect, bool, error) {
 	var f lootCardEffect = func(roll uint8, blankCard bool) {
 		var n int8 = 4 // default to resulitng roll of
-		var f func(n int8)
 		if roll == 1 || roll == 2 {
-			f = p.gainCents
+			p.gainCents(n)
 		} else if roll == 3 || roll == 4 {
-			n, f = 7, p.gainCents
+			p.gainCents(7)
 		} else if roll == 5 || roll == 6 {
-			f = p.loseCents
-		} else {
-			panic("impossible dice roll result.")
+			p.loseCents(n)
 		}
-		f(n)
 	}
 	return f, true, nil
 }
@@ -569,15 +609,18 @@ func soulHeartFunc(p *player, b *Board) (lootCardEffect, bool, error) {
 	if l == 0 {
 		return nil, false, errors.New("no damage events on the stack")
 	} else if l > 1 {
-		showEvents(damageEvents)
-		i = uint8(readInput(0, len(damageEvents)-1))
+		b.ui.showEvents(damageEvents)
+		i = uint8(b.ui.readInput(0, len(damageEvents)-1))
 	}
+	id := damageEvents[i].id
 	var f lootCardEffect = func(roll uint8, blankCard bool) {
 		var n uint8 = 1
 		if blankCard {
 			n = 2
 		}
-		_ = b.eventStack.preventDamage(n, damageEvents[i])
+		if node, err := b.getEventNodeById(id); err == nil {
+			_ = b.eventStack.preventDamage(n, node)
+		}
 	}
 	return f, false, nil
 }
@@ -604,13 +647,14 @@ func strengthFunc(p *player, b *Board) (lootCardEffect, bool, error) {
 // The blank card should double the amount of damage and the reward.
 func temperanceFunc(p *player, b *Board) (lootCardEffect, bool, error) {
 	var take2damage bool
-	fmt.Println("Choose One:\n1) Take 1 Damage: Gain 4 Cents.\n2) Take 2 Damage: Gain 8 Cents.")
-	ans := uint8(readInput(1, 2))
+	b.ui.Println("Choose One:")
+	b.ui.menu(1, "Take 1 Damage: Gain 4 Cents.", "Take 2 Damage: Gain 8 Cents.")
+	ans := uint8(b.ui.readInput(1, 2))
 	if ans == 2 {
 		take2damage = true
 	}
 	var f lootCardEffect = func(roll uint8, blankCard bool) {
-		if checkActiveEffects(p.activeEffects, temperance, true) {
+		if p.useEffect(temperance) {
 			var n int8 = 4 // assume one damage unless shown otherwise
 			var centsGain = p.gainCents
 			if p.Character.hp != 0 {
@@ -650,26 +694,36 @@ func theDevilFunc(p *player, b *Board) (lootCardEffect, bool, error) {
 	if l == 0 {
 		return nil, false, errors.New("no items to pay the cost")
 	}
-	showItems(items, 0)
-	fmt.Println("Destroy which value?")
-	ans := uint8(readInput(0, l-1))
+	b.ui.showItems(items, 0)
+	b.ui.Println("Destroy which value?")
+	ans := uint8(b.ui.readInput(0, l-1))
 	card := items[ans]
 	i, _ := p.getItemIndex(card.getId(), card.isPassive())
-	b.discard(p.popItemByIndex(i, card.isPassive()))
+	if c, err := p.popItemByIndex(i, card.isPassive()); err == nil {
+		b.discard(c)
+	}
 	var owners map[uint16]*player
 	items, owners = b.getAllItems(false, p)
 	l = len(items)
-	showItems(items, 0)
-	showTreasureCards(b.treasure.zones, "shop", l)
-	fmt.Println("Which card to steal?")
-	ans = uint8(readInput(0, l+len(b.treasure.zones)-1))
-	card = items[ans]
-	id, isPassive, f := card.getId(), card.isPassive(), func(roll uint8, blankCard bool) {}
+	b.ui.showItems(items, 0)
+	b.ui.showTreasureCards(b.treasure.zones, "shop", l)
+	b.ui.Println("Which card to steal?")
+	ans = uint8(b.ui.readInput(0, l+len(b.treasure.zones)-1))
+	var id uint16
+	var isPassive bool
+	f := func(roll uint8, blankCard bool) {}
+	if int(ans) < l {
+		id, isPassive = items[ans].getId(), items[ans].isPassive()
+	} else { // past the other players' items, the answer is a shop zone
+		id = b.treasure.zones[int(ans)-l].id
+	}
 	if owner, ok := owners[id]; ok { // The selected value is NOT in the shop.
 		f = func(roll uint8, blankCard bool) {
 			i, err := owner.getItemIndex(id, isPassive)
 			if err == nil {
-				p.addCardToBoard(owner.popItemByIndex(i, isPassive))
+				if c, err := owner.popItemByIndex(i, isPassive); err == nil {
+					p.addCardToBoard(c)
+				}
 			}
 		}
 	} else {
@@ -697,13 +751,18 @@ func theEmperorFunc(p *player, b *Board) (lootCardEffect, bool, error) {
 		if blankCard {
 			n = 10
 		}
-		mCards := make([]monsterCard, n)
+		mCards := make([]monsterCard, 0, n)
 		for i = 0; i < n; i++ {
-			mCards[i] = m.draw()
+			if c, err := m.draw(); err == nil {
+				mCards = append(mCards, c)
+			}
+		}
+		if n = uint8(len(mCards)); n == 0 {
+			return
 		}
-		showMonsterCards(mCards, 0)
-		fmt.Println("Which value should go on top?")
-		ans := uint8(readInput(0, int(n-1)))
+		b.ui.showMonsterCards(mCards, 0)
+		b.ui.Println("Which value should go on top?")
+		ans := uint8(b.ui.readInput(0, int(n-1)))
 		for i = 0; i < n; i++ {
 			if i == ans {
 				m.placeInDeck(mCards[i], true)
@@ -725,7 +784,7 @@ func theEmpressFunc(p *player, b *Board) (lootCardEffect, bool, error) {
 			n = 2
 		}
 		p.increaseAP(n)
-		p.activeEffects[theEmpress] = struct{}{}
+		p.addEffect(theEmpress, nil, untilEndOfTurn)
 	}
 	return f, false, nil
 }
@@ -747,32 +806,23 @@ func theFoolFunc(p *player, b *Board) (lootCardEffect, bool, error) {
 // Then Loot 2.
 // The blank card doubles the number of loot cards drawn
 func theHangedManFunc(p *player, b *Board) (lootCardEffect, bool, error) {
+	onTop := func() bool {
+		b.ui.Println("Choose what to do with each value.")
+		b.ui.menu(1, "Place back on top of the deck.", "Place on the bottom of the deck.")
+		return b.ui.readInput(1, 2) == 1
+	}
 	var f lootCardEffect = func(roll uint8, blankCard bool) {
-		msg := "Choose what to do with each value.\n1) Place back on top of the deck.\n2) Place on the bottom of the deck."
-		lc := b.loot.draw()
-		fmt.Println(msg)
-		ans := readInput(1, 2)
-		switch ans {
-		case 1:
-			b.loot.placeInDeck(lc, true)
-		case 2:
-			b.loot.placeInDeck(lc, false)
+		if lc, err := b.loot.draw(); err == nil {
+			b.ui.Print(lc.showCard(0))
+			b.loot.placeInDeck(lc, onTop())
 		}
-		mc := b.monster.draw()
-		ans = readInput(1, 2)
-		switch ans {
-		case 1:
-			b.monster.placeInDeck(mc, true)
-		case 2:
-			b.monster.placeInDeck(mc, false)
+		if mc, err := b.monster.draw(); err == nil {
+			b.ui.Print(mc.showCard(0))
+			b.monster.placeInDeck(mc, onTop())
 		}
-		tc := b.treasure.draw()
-		ans = readInput(1, 2)
-		switch ans {
-		case 1:
-			b.treasure.placeInDeck(tc, true)
-		case 2:
-			b.treasure.placeInDeck(tc, false)
+		if tc, err := b.treasure.draw(); err == nil {
+			b.ui.Print(tc.showCard(0))
+			b.treasure.placeInDeck(tc, onTop())
 		}
 		var i, n uint8 = 0, 2
 		if blankCard {
@@ -795,13 +845,18 @@ func theHermitFunc(p *player, b *Board) (lootCardEffect, bool, error) {
 		if blankCard {
 			n = 10
 		}
-		tCards := make([]treasureCard, n)
+		tCards := make([]treasureCard, 0, n)
 		for i = 0; i < n; i++ {
-			tCards[i] = t.draw()
+			if c, err := t.draw(); err == nil {
+				tCards = append(tCards, c)
+			}
+		}
+		if n = uint8(len(tCards)); n == 0 {
+			return
 		}
-		showMonsterCards(tCards, 0)
-		fmt.Println("Which value should go on top?")
-		ans := uint8(readInput(0, int(n-1)))
+		b.ui.showMonsterCards(tCards, 0)
+		b.ui.Println("Which value should go on top?")
+		ans := uint8(b.ui.readInput(0, int(n-1)))
 		for i = 0; i < n; i++ {
 			if i == ans {
 				t.placeInDeck(tCards[i], true)
@@ -824,8 +879,8 @@ func theHierophantFunc(p *player, b *Board) (lootCardEffect, bool, error) {
 	}
 	var i uint8
 	if l > 1 {
-		showEvents(damage)
-		i = uint8(readInput(0, l-1))
+		b.ui.showEvents(damage)
+		i = uint8(b.ui.readInput(0, l-1))
 	}
 	es := &b.eventStack
 	var f lootCardEffect = func(roll uint8, blankCard bool) {
@@ -845,9 +900,9 @@ func theHighPriestessFunc(p *player, b *Board) (lootCardEffect, bool, error) {
 	monsters := b.monster.getActiveMonsters()
 	l := len(monsters)
 	players := b.getPlayers(true)
-	showMonsterCards(monsters, 0)
-	showPlayers(players, l)
-	ans := readInput(0, l+len(players)-1)
+	b.ui.showMonsterCards(monsters, 0)
+	b.ui.showPlayers(players, l)
+	ans := b.ui.readInput(0, l+len(players)-1)
 	var c combatTarget
 	if ans < l {
 	}
@@ -894,11 +949,11 @@ func theMagicianFunc(p *player, b *Board) (lootCardEffect, bool, error) {
 	}
 	var i uint8
 	if max > 1 {
-		showEvents(diceRolls)
-		i = uint8(readInput(0, max-1))
+		b.ui.showEvents(diceRolls)
+		i = uint8(b.ui.readInput(0, max-1))
 	}
-	fmt.Println("Enter new roll.")
-	ans := uint8(readInput(1, 6))
+	b.ui.Println("Enter new roll.")
+	ans := uint8(b.ui.readInput(1, 6))
 	var f lootCardEffect = func(roll uint8, blankCard bool) {
 		diceRolls[i].event.e = diceRollEvent{n: ans}
 	}
@@ -916,13 +971,18 @@ func theMoonFunc(p *player, b *Board) (lootCardEffect, bool, error) {
 		if blankCard {
 			n = 10
 		}
-		lCards := make([]lootCard, n)
+		lCards := make([]lootCard, 0, n)
 		for i = 0; i < n; i++ {
-			lCards[i] = l.draw()
+			if c, err := l.draw(); err == nil {
+				lCards = append(lCards, c)
+			}
+		}
+		if n = uint8(len(lCards)); n == 0 {
+			return
 		}
-		showMonsterCards(lCards, 0)
-		fmt.Println("Which value should go on top?")
-		ans := uint8(readInput(0, int(n-1)))
+		b.ui.showMonsterCards(lCards, 0)
+		b.ui.Println("Which value should go on top?")
+		ans := uint8(b.ui.readInput(0, int(n-1)))
 		for i = 0; i < n; i++ {
 			if i == ans {
 				l.placeInDeck(lCards[i], true)
@@ -942,8 +1002,9 @@ func theStarsFunc(p *player, b *Board) (lootCardEffect, bool, error) {
 	var f lootCardEffect = func(roll uint8, blankCard bool) {
 		var i, n uint8 = 0, 1
 		for i = 0; i < n; i++ {
-			card := t.draw()
-			p.addCardToBoard(card)
+			if card, err := t.draw(); err == nil {
+				p.addCardToBoard(card)
+			}
 		}
 	}
 	return f, false, nil
@@ -954,7 +1015,7 @@ func theStarsFunc(p *player, b *Board) (lootCardEffect, bool, error) {
 // TODO: Make the blank card work here.
 func theSunFunc(p *player, b *Board) (lootCardEffect, bool, error) {
 	var f lootCardEffect = func(roll uint8, blankCard bool) {
-		p.activeEffects[theSun] = struct{}{}
+		p.addEffect(theSun, nil, nextTime)
 	}
 	return f, false, nil
 }
@@ -976,9 +1037,9 @@ func theTowerFunc(p *player, b *Board) (lootCardEffect, bool, error) {
 			}
 		} else if roll == 3 || roll == 4 {
 			for i := range b.monster.zones {
-				m := b.monster.zones[i].peek()
-				d := damageEvent{target: m, n: n}
-				b.eventStack.push(event{p: p, e: d})
+				if m := b.monster.zones[i].peek(); m != nil {
+					b.eventStack.push(event{p: p, e: damageEvent{target: m, n: n}})
+				}
 			}
 		} else if roll == 5 || roll == 6 {
 			if blankCard {
@@ -999,7 +1060,7 @@ func theTowerFunc(p *player, b *Board) (lootCardEffect, bool, error) {
 func theWorldFunc(p *player, b *Board) (lootCardEffect, bool, error) {
 	var f lootCardEffect = func(roll uint8, blankCard bool) {
 		for _, player := range b.getOtherPlayers(p, false) {
-			showLootCards(player.Hand, player.Character.name, 0)
+			b.ui.showHand(player, 0)
 		}
 		var i, n uint8 = 0, 2
 		if blankCard {
@@ -1058,8 +1119,9 @@ func wheelOfFortuneFunc(p *player, b *Board) (lootCardEffect, bool, error) {
 				roll *= 2
 			}
 			for i = 0; i < roll; i++ {
-				tc := b.treasure.draw()
-				p.addCardToBoard(tc)
+				if tc, err := b.treasure.draw(); err == nil {
+					p.addCardToBoard(tc)
+				}
 			}
 		}
 	}
@@ -1088,7 +1150,7 @@ func cainsEyeEvent(p *player, b *Board, lCard card, en *eventNode) (cardEffect,
 	var f cardEffect
 	var err error
 	if err = en.checkStartOfTurn(p); err == nil {
-		f = b.peekTrinketHelper(cainsEye)
+		f, err = b.peekTrinketHelper(p, cainsEye)
 	}
 	return f, false, err
 }
@@ -1096,21 +1158,11 @@ func cainsEyeEvent(p *player, b *Board, lCard card, en *eventNode) (cardEffect,
 // Conditional constant item
 // Each time you gain cents, gain an additional 1 cent.
 func counterfeitPennyChecker(p *player) {
-	if _, err := p.getItemIndex(counterfeitPenny, true); err == nil {
+	if _, err := p.getItemIndex(counterfeitPenny, true); err == nil && p.Pennies < math.MaxInt8 {
 		p.Pennies += 1
 	}
 }
 
-// Constant Passive
-// Gain +1 Attack for the first attack roll of your turn.
-func curvedHornConstant(p *player, b *Board, lCard card, isLeaving bool) {
-	if !isLeaving {
-		p.activeEffects[curvedHorn] = struct{}{}
-	} else {
-		delete(p.activeEffects, curvedHorn)
-	}
-}
-
 // Event based passive
 // At the start of your turn, look at the top card of the Treasure deck.
 // You may put it on the bottom.
@@ -1118,7 +1170,7 @@ func goldenHorseShoeEvent(p *player, b *Board, lCard card, en *eventNode) (cardE
 	var f cardEffect
 	var err error
 	if err = en.checkStartOfTurn(p); err == nil {
-		f = b.peekTrinketHelper(goldenHorseShoe)
+		f, err = b.peekTrinketHelper(p, goldenHorseShoe)
 	}
 	return f, false, err
 }
@@ -1141,7 +1193,7 @@ func purpleHeartEvent(p *player, b *Board, lCard card, en *eventNode) (cardEffec
 	var f cardEffect
 	var err error
 	if err = en.checkStartOfTurn(p); err == nil {
-		f = b.peekTrinketHelper(purpleHeart)
+		f, err = b.peekTrinketHelper(p, purpleHeart)
 	}
 	return f, false, err
 }
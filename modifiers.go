@@ -0,0 +1,177 @@
+package four_souls
+
+// A player's stat that a card in play can raise or lower.
+type stat uint8
+
+const (
+	attackStat     stat = iota // Damage dealt on a successful attack roll
+	healthStat                 // Maximum health of the character
+	diceStat                   // Every dice roll made by the player
+	attackRollStat             // Dice rolls made to attack a monster
+	shopCostStat               // The price of an item in the shop
+	lootPlaysStat              // Loot cards that may be played each turn
+	purchasesStat              // Items that may be bought each turn
+	attacksStat                // Times a monster may be attacked each turn
+)
+
+// The plays left to a player who may play as many cards as they want.
+const unlimitedPlays int8 = 127
+
+// A continuous change to one stat, active for as long as the card declaring it
+// is on its owner's board.
+type modifier struct {
+	s         stat
+	n         int8
+	firstOnly bool                         // Only applies to the first attack roll of the turn
+	cond      func(p *player, c card) bool // If set, the modifier applies only while this holds
+}
+
+// The modifiers each card gives to the player who has it in play.
+// Effective stats are never stored: they are derived from the character's
+// values and whichever of these cards the player controls at the moment.
+var cardModifiers = map[uint16][]modifier{
+	bellyButton:      {{s: lootPlaysStat, n: 1}},
+	breakfast:        {{s: healthStat, n: 1}},
+	brimstone:        {{s: attackStat, n: 1}},
+	bumbo:            {{s: attackRollStat, n: 2, firstOnly: true, cond: hasCounters(1)}, {s: attackStat, n: 1, cond: hasCounters(10)}, {s: attacksStat, n: 99, cond: hasCounters(25)}},
+	championBelt:     {{s: attackStat, n: 1, firstOnly: true}, {s: attacksStat, n: 1}},
+	curseOfTinyHands: {{s: lootPlaysStat, n: -1}},
+	curvedHorn:       {{s: attackStat, n: 1, firstOnly: true}},
+	dinner:           {{s: healthStat, n: 1}},
+	emptyVessel:      {{s: attackStat, n: 1, cond: hasEmptyHand}, {s: attackRollStat, n: 1, cond: hasNoCents}},
+	ipecac:           {{s: attackStat, n: 1}},
+	lard:             {{s: healthStat, n: 2}, {s: attackRollStat, n: -1}},
+	meat:             {{s: attackRollStat, n: 1}},
+	momsKnife:        {{s: attackStat, n: 1}},
+	placenta:         {{s: healthStat, n: 1}},
+	polydactyly:      {{s: attackStat, n: 1, firstOnly: true}, {s: lootPlaysStat, n: 1}},
+	polyphemus:       {{s: attackStat, n: 1}},
+	steamySale:       {{s: shopCostStat, n: -5}},
+	synthoil:         {{s: attackRollStat, n: 1}},
+	theresOptions:    {{s: purchasesStat, n: 1}},
+}
+
+// Condition for items like Bum-Bo! that switch on once they hold enough counters.
+func hasCounters(n int8) func(p *player, c card) bool {
+	return func(p *player, c card) bool {
+		ic, ok := c.(itemCard)
+		return ok && ic.getCounters() >= n
+	}
+}
+
+func hasEmptyHand(p *player, c card) bool {
+	return len(p.Hand) == 0
+}
+
+func hasNoCents(p *player, c card) bool {
+	return p.Pennies == 0
+}
+
+// The sum of every modifier to a stat from the cards on the player's board.
+// firstAttackRoll: if the stat is read for the player's first attack roll of the turn.
+func (p *player) getModifier(s stat, firstAttackRoll bool) int8 {
+	var n int8
+	add := func(c card) {
+		for _, m := range cardModifiers[c.getId()] {
+			if m.s == s && (!m.firstOnly || firstAttackRoll) && (m.cond == nil || m.cond(p, c)) {
+				n += m.n
+			}
+		}
+	}
+	for i := range p.ActiveItems {
+		add(&p.ActiveItems[i])
+	}
+	for _, c := range p.PassiveItems {
+		add(c)
+	}
+	for i := range p.Curses {
+		add(&p.Curses[i])
+	}
+	return n
+}
+
+// Is the next attack roll the player's first of the turn?
+func (p *player) isFirstAttackRoll() bool {
+	return p.numAttackRolls == 0
+}
+
+// The damage the player deals with a successful attack roll.
+func (p *player) getAttack() uint8 {
+	return addToUint8(p.Character.ap, p.getModifier(attackStat, p.isFirstAttackRoll()))
+}
+
+// The most health the player's character can have.
+func (p *player) getMaxHealth() uint8 {
+	return addToUint8(p.Character.baseHealth, p.getModifier(healthStat, false))
+}
+
+// Apply every modifier to a dice roll the player just made.
+// The temporary effects of The Empress, The Haunt and Larry Jr. are applied along with the cards in play.
+func (p *player) modifyRoll(roll uint8, isAttackRoll bool) uint8 {
+	n := p.getModifier(diceStat, false)
+	if p.hasEffect(theEmpress) {
+		n += 1
+	}
+	if p.hasEffect(theHaunt) {
+		n -= 1
+	}
+	if isAttackRoll {
+		n += p.getModifier(attackRollStat, p.isFirstAttackRoll())
+		if p.hasEffect(larryJr) {
+			n -= 1
+		}
+	}
+	modifyDiceRoll(&roll, n)
+	return roll
+}
+
+// The price of an item in the shop for the player.
+// Every change to the price, from items like Steamy Sale or a Credit Card played this turn, is applied here.
+func (p *player) getShopCost() int8 {
+	if p.hasEffect(creditCard) {
+		return 0
+	}
+	cost := shopCost + p.getModifier(shopCostStat, false)
+	if cost < 0 {
+		cost = 0
+	}
+	return cost
+}
+
+// The number of loot cards the player may still play this turn.
+// After a Box!, they may play as many as they want until the end of the turn.
+func (p *player) lootPlaysLeft() int8 {
+	if p.hasEffect(box) {
+		return unlimitedPlays
+	}
+	return p.numLootPlayed + p.getModifier(lootPlaysStat, false)
+}
+
+// Use up one of the player's loot plays for the turn.
+// Plays made after a Box! are free.
+func (p *player) useLootPlay() {
+	if !p.hasEffect(box) {
+		p.numLootPlayed -= 1
+	}
+}
+
+// The number of items the player may still buy this turn.
+func (p *player) purchasesLeft() int8 {
+	return p.numPurchases + p.getModifier(purchasesStat, false)
+}
+
+// The number of times the player may still attack this turn.
+func (p *player) attacksLeft() int8 {
+	return p.numAttacks + p.getModifier(attacksStat, false)
+}
+
+// Add a signed amount to an unsigned stat without wrapping around.
+func addToUint8(a uint8, n int8) uint8 {
+	x := int16(a) + int16(n)
+	if x < 0 {
+		x = 0
+	} else if x > 255 {
+		x = 255
+	}
+	return uint8(x)
+}
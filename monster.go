@@ -1,23 +1,11 @@
 package four_souls
 
-import (
-	"errors"
-	"fmt"
-	"math/rand"
-)
+import "errors"
 
+// When revealed, give this curse to any player.
 func giveCurseHelper(ap *player, b *Board, mCard card) (cardEffect, bool, error) {
-	others := b.getOtherPlayers(ap, false)
-	showPlayers(others, 0)
-	l, i := len(others), uint8(0)
-	if l > 1 {
-		i = uint8(readInput(0, l-1))
-	}
-	var f cardEffect = func(roll uint8) {
-		fmt.Println("Giving curse to ", others[i].Character.name)
-		others[i].addCardToBoard(mCard.(monsterCard))
-	}
-	return f, false, nil
+	f, err := b.revealCurse(ap, mCard.(monsterCard))
+	return f, false, err
 }
 
 func rewardCentsHelper(b *Board, n int8) (cardEffect, bool) {
@@ -52,7 +40,7 @@ func rewardTreasureHelper(b *Board, n uint8) (cardEffect, bool) {
 	var f cardEffect = func(roll uint8) {
 		var i uint8
 		for i = 0; i < n; i++ {
-			ap.addCardToBoard(b.treasure.draw())
+			ap.gainTreasure(b.treasure)
 		}
 	}
 	return f, false
@@ -73,7 +61,7 @@ func rewardLootAndCents(b *Board, loot, cents int8) (cardEffect, bool) {
 func rewardMegaBoss(b *Board) (cardEffect, bool) {
 	ap := b.getActivePlayer()
 	var f cardEffect = func(roll uint8) {
-		ap.addCardToBoard(b.treasure.draw())
+		ap.gainTreasure(b.treasure)
 		ap.gainCents(6)
 	}
 	return f, false
@@ -145,17 +133,17 @@ func dankGlobinDeath(p *player, b *Board, mCard card) (cardEffect, bool, error)
 		err = nil
 		var i uint8
 		if l > 1 {
-			showPlayers(players, 0)
-			fmt.Println("Choose a player to discard cards")
-			i = uint8(readInput(0, l-1))
+			b.ui.showPlayers(players, 0)
+			b.ui.Println("Choose a player to discard cards")
+			i = uint8(b.ui.readInput(0, l-1))
 		}
 		target := players[i]
 		f = func(roll uint8) {
 			if len(target.Hand) >= 2 {
-				fmt.Println("Discard 2 cards")
+				b.ui.Println("Discard 2 cards")
 				for i := 0; i < 2; i++ {
-					showLootCards(target.Hand, target.Character.name, 0)
-					b.discard(target.popHandCard(uint8(readInput(0, len(target.Hand)-1))))
+					b.ui.showHand(target, 0)
+					b.discard(target.popHandCard(uint8(b.ui.readDiscard(len(target.Hand)))))
 				}
 			}
 		}
@@ -167,6 +155,26 @@ func dankGlobinReward(b *Board) (cardEffect, bool) {
 	return rewardLootHelper(b, 2)
 }
 
+// Special enemy
+// This can't be attacked. At the end of each turn, it takes 1 damage.
+func deathsHeadEvent(p *player, b *Board, mCard card, en *eventNode) (cardEffect, bool, error) {
+	var f cardEffect
+	var err = errors.New("not the end of a turn")
+	if _, ok := en.event.e.(endTurnEvent); ok {
+		err = nil
+		f = func(roll uint8) {
+			if _, m := b.monster.getActiveMonster(mCard.getId()); m != nil { // Still active
+				b.damagePlayerToMonster(p, m, 1, 0)
+			}
+		}
+	}
+	return f, false, err
+}
+
+func deathsHeadReward(b *Board) (cardEffect, bool) {
+	return rewardCentsHelper(b, 5)
+}
+
 // Basic Enemy
 // When this kis killed on a roll of 6, double its rewards.
 func dingaReward(b *Board) (cardEffect, bool) {
@@ -240,9 +248,9 @@ func greedlingDeath(p *player, b *Board, mCard card) (cardEffect, bool, error) {
 		err = nil
 		var i uint8
 		if l > 1 {
-			showPlayers(players, 0)
-			fmt.Println("Choose a player to lose cents")
-			i = uint8(readInput(0, l-1))
+			b.ui.showPlayers(players, 0)
+			b.ui.Println("Choose a player to lose cents")
+			i = uint8(b.ui.readInput(0, l-1))
 		}
 		target := players[i]
 		f = func(roll uint8) { target.loseCents(7) }
@@ -257,7 +265,7 @@ func greedlingReward(b *Board) (cardEffect, bool) {
 // Basic enemy
 // When this dies, add an additional item from the top of the Treasure deck to the Shop.
 func hangerDeath(p *player, b *Board, mCard card) (cardEffect, bool, error) {
-	return func(roll uint8) { b.treasure.zones = append(b.treasure.zones, b.treasure.draw()) }, false, nil
+	return func(roll uint8) { b.treasure.addZones(1) }, false, nil
 }
 
 func hangerReward(b *Board) (cardEffect, bool) {
@@ -285,14 +293,9 @@ func hopperReward(b *Board) (cardEffect, bool) {
 }
 
 // Basic enemy
-// Constant passive with conditions
 // This deals 1 additional damage whenever the player attacking it rolls a 2.
 //
-// Handled in the battle step
-func horfChecker(mId uint16, roll uint8) bool {
-	return mId == horf && roll == 2
-}
-
+// Handled by the monster's roll reaction in combat
 func horfReward(b *Board) (cardEffect, bool) {
 	return rewardCentsHelper(b, 3)
 }
@@ -313,12 +316,9 @@ func keeperReward(b *Board) (cardEffect, bool) {
 }
 
 // Basic enemy
-// Constant passive with condition
 // This deals double damage on a roll of 1
-func leaperChecker(mId uint16, roll uint8) bool {
-	return mId == leaper && roll == 1
-}
-
+//
+// Handled by the monster's roll reaction in combat
 func leaperReward(b *Board) (cardEffect, bool) {
 	return rewardCentsHelper(b, 5)
 }
@@ -337,16 +337,18 @@ func momsDeadHandDeath(p *player, b *Board, mCard card) (cardEffect, bool, error
 	if l == 0 {
 		err = errors.New("no items to steal")
 	} else {
-		showItems(items, 0)
-		fmt.Println(fmt.Sprintf("%d) Don't steal", l))
-		ans := readInput(0, len(items))
+		b.ui.showItems(items, 0)
+		b.ui.menu(l, "Don't steal")
+		ans := b.ui.readChoice(l, 0, len(items))
 		if ans == l {
 			err = errors.New("decided not to steal")
 		} else {
 			item, target := items[ans], playerMap[items[ans].getId()]
 			f = func(roll uint8) {
 				if i, err := target.getItemIndex(item.getId(), item.isPassive()); err == nil {
-					p.addCardToBoard(target.popItemByIndex(i, item.isPassive()))
+					if c, err := target.popItemByIndex(i, item.isPassive()); err == nil {
+						p.addCardToBoard(c)
+					}
 				}
 			}
 		}
@@ -363,18 +365,18 @@ func momsDeadHandReward(b *Board) (cardEffect, bool) {
 func momsEyeDeath(p *player, b *Board, mCard card) (cardEffect, bool, error) {
 	var f cardEffect
 	var err error
-	fmt.Println("1) Look at player's hand\n2) Do nothing")
-	ans := readInput(1, 2)
+	b.ui.menu(1, "Look at player's hand", "Do nothing")
+	ans := b.ui.readChoice(2, 1, 2)
 	if ans == 2 {
 		err = errors.New("decided to not look")
 	} else {
 		others := b.getOtherPlayers(p, false)
 		var i uint8
 		if len(others) > 1 {
-			showPlayers(others, 0)
-			i = uint8(readInput(0, len(others)-1))
+			b.ui.showPlayers(others, 0)
+			i = uint8(b.ui.readInput(0, len(others)-1))
 		}
-		f = func(roll uint8) { showLootCards(others[i].Hand, others[i].Character.name, 0) }
+		f = func(roll uint8) { b.ui.showHand(others[i], 0) }
 	}
 	return f, false, err
 }
@@ -390,7 +392,10 @@ func momsHandEvent(p *player, b *Board, mCard card, en *eventNode) (cardEffect,
 	var err error
 	if err = p.checkAttackingPlayer(); err == nil {
 		if err = en.checkDiceRoll(6); err == nil {
-			f = func(roll uint8) { b.forceEndOfTurn() }
+			f = func(roll uint8) {
+				_ = b.cancelAttack(false)
+				b.forceEndOfTurn()
+			}
 		}
 	}
 
@@ -405,9 +410,9 @@ func momsHandReward(b *Board) (cardEffect, bool) {
 // When this dies, deal 3 damage to any player.
 func mulliboomDeath(p *player, b *Board, mCard card) (cardEffect, bool, error) {
 	players := b.getPlayers(true)
-	showPlayers(players, 0)
-	fmt.Println("Choose who receives 3 damage")
-	ans := readInput(0, len(players)-1)
+	b.ui.showPlayers(players, 0)
+	b.ui.Println("Choose who receives 3 damage")
+	ans := b.ui.readInput(0, len(players)-1)
 	var f cardEffect = func(roll uint8) { b.damagePlayerToPlayer(p, players[ans], 3) }
 	return f, false, nil
 }
@@ -419,7 +424,7 @@ func mulliboomReward(b *Board) (cardEffect, bool) {
 // Basic enemy
 // When this dies, expand the number of active monsters by 1
 func mulliganDeath(p *player, b *Board, mCard card) (cardEffect, bool, error) {
-	return func(roll uint8) { b.monster.zones = append(b.monster.zones, activeSlot{}) }, false, nil
+	return func(roll uint8) { b.monster.addZone() }, false, nil
 }
 
 func mulliganReward(b *Board) (cardEffect, bool) {
@@ -501,11 +506,15 @@ func ringOfFliesEvent(p *player, b *Board, mCard card, en *eventNode) (cardEffec
 			l := len(others)
 			var i uint8
 			if l > 1 {
-				showPlayers(others, 0)
-				i = uint8(readInput(0, l-1))
+				b.ui.showPlayers(others, 0)
+				i = uint8(b.ui.readInput(0, l-1))
 			}
 			target := others[i]
-			f = func(roll uint8) { p.Hand = append(p.Hand, target.popHandCard(uint8(rand.Intn(l)))) }
+			f = func(roll uint8) {
+				if len(target.Hand) > 0 {
+					p.Hand = append(p.Hand, target.popHandCard(uint8(b.rng.Intn(len(target.Hand)))))
+				}
+			}
 		}
 	}
 	return f, false, err
@@ -567,18 +576,18 @@ func triteReward(b *Board) (cardEffect, bool) {
 func wizoobDeath(p *player, b *Board, mCard card) (cardEffect, bool, error) {
 	var f cardEffect
 	var err error
-	fmt.Println("1) Force a player to discard a soul\n2) Do nothing.")
-	if readInput(1, 2) == 1 {
+	b.ui.menu(1, "Force a player to discard a soul", "Do nothing.")
+	if b.ui.readChoice(2, 1, 2) == 1 {
 		souls, playerMap := b.getSouls()
 		if len(playerMap) == 0 {
-			err = errors.New("no souls to collect")
+			return f, false, errors.New("no souls to discard")
 		}
-		showSoulsByPlayer(souls, playerMap, 0)
-		i := readInput(0, len(souls)-1)
+		b.ui.showSoulsByPlayer(souls, playerMap, 0)
+		i := b.ui.readInput(0, len(souls)-1)
 		f = func(roll uint8) {
 			target := playerMap[souls[i].getId()]
 			if j, err := target.getSoulIndex(souls[i].getId()); err == nil {
-				p.addSoulToBoard(target.popSoul(j))
+				_ = b.destroySoul(target, j)
 			}
 		}
 	} else {
@@ -597,11 +606,7 @@ func cursedFattyEvent(p *player, b *Board, mCard card, en *eventNode) (cardEffec
 	var f cardEffect
 	var err error
 	if err = en.checkDiceRoll(5); err == nil {
-		f = func(roll uint8) {
-			showLootCards(p.Hand, "self", 0)
-			fmt.Println("Discard one")
-			b.loot.discard(p.popHandCard(uint8(readInput(0, len(p.Hand)-1))))
-		}
+		f = func(roll uint8) { p.discardHandChoiceHelper(b.loot, 1) }
 	}
 	return f, false, nil
 }
@@ -743,9 +748,9 @@ func holyMomsEyeEvent(p *player, b *Board, mCard card, en *eventNode) (cardEffec
 		items := p.getTappedActiveItems()
 		l := len(items)
 		if l > 0 {
-			showTreasureCards(items, "self", 0)
-			fmt.Println(fmt.Sprintf("%d) Do not recharge", l))
-			if i := readInput(0, l); i != l {
+			b.ui.showTreasureCards(items, "self", 0)
+			b.ui.menu(l, "Do not recharge")
+			if i := b.ui.readChoice(l, 0, l); i != l {
 				f = func(roll uint8) { items[i].recharge() }
 			}
 		}
@@ -851,9 +856,12 @@ func darkOneReward(b *Board) (cardEffect, bool) {
 // When this dies, the Active Player must kill a player.
 func deathMonsterDeath(p *player, b *Board, mCard card) (cardEffect, bool, error) {
 	players := b.getPlayers(true)
-	showPlayers(players, 0)
-	fmt.Println("Who dies?")
-	ans := readInput(0, len(players)-1)
+	if len(players) == 0 {
+		return nil, false, errors.New("no player is alive to die")
+	}
+	b.ui.showPlayers(players, 0)
+	b.ui.Println("Who dies?")
+	ans := b.ui.readInput(0, len(players)-1)
 	return func(roll uint8) { b.killPlayer(players[ans]) }, false, nil
 }
 
@@ -868,12 +876,14 @@ func deathMonsterReward(b *Board) (cardEffect, bool) {
 // First effect is handled elsewhere
 func deliriumDeathHandler(deliriumCard monsterCard, mA *mArea) {
 	if deliriumCard.id == delirium {
-		cards := make([]monsterCard, 6, 6)
+		cards := make([]monsterCard, 0, 6)
 		for i := 0; i < 6; i++ {
-			cards[i] = mA.draw()
+			if c, err := mA.draw(); err == nil {
+				cards = append(cards, c)
+			}
 		}
 		mA.placeInDeck(deliriumCard, true)
-		for i := 5; i >= 0; i-- {
+		for i := len(cards) - 1; i >= 0; i-- {
 			mA.placeInDeck(cards[i], true)
 		}
 	}
@@ -913,7 +923,7 @@ func envyReward(b *Board) (cardEffect, bool) {
 // When this dies, the Active Player skips their next turn.
 func famineDeath(p *player, b *Board, mCard card) (cardEffect, bool, error) {
 	ap := &b.players[b.api]
-	return func(roll uint8) { ap.activeEffects[famine] = struct{}{} }, false, nil
+	return func(roll uint8) { ap.addEffect(famine, mCard, nextTime) }, false, nil
 }
 
 func famineReward(b *Board) (cardEffect, bool) {
@@ -1004,10 +1014,10 @@ func larryJrEvent(p *player, b *Board, mCard card, en *eventNode) (cardEffect, b
 	var f cardEffect
 	var err error = errors.New("larry jr already activated")
 	ap := &b.players[b.api]
-	if !checkActiveEffects(p.activeEffects, larryJr, false) {
+	if !ap.hasEffect(larryJr) {
 		m := mCard.(*monsterCard)
 		if _, err = en.checkDamageToSpecificMonster(larryJr); err == nil && m.hp > 0 && m.hp <= 2 {
-			f = func(roll uint8) { ap.activeEffects[larryJr] = struct{}{} }
+			f = func(roll uint8) { ap.addEffect(larryJr, mCard, untilEndOfTurn) }
 		}
 	}
 	return f, false, err
@@ -1083,13 +1093,11 @@ func thePeepDeath(p *player, b *Board, mCard card) (cardEffect, bool, error) {
 	var f cardEffect
 	var err error
 	if _, _, err = b.monster.deck.search(theBloat); err == nil {
-		showMonsterCards(b.monster.getActiveMonsters(), 0)
-		fmt.Println("Overlay which zone with The Bloat?")
-		i := uint8(readInput(0, len(b.monster.zones)-1))
+		i := b.monster.chooseZoneHelper(b, "Overlay which zone with The Bloat?")
 		f = func(roll uint8) {
 			if c, err := b.monster.deck.popById(theBloat); err == nil {
 				b.monster.zones[i].push(c.(monsterCard))
-				b.monster.deck.shuffle()
+				b.monster.deck.shuffle(b.rng)
 			}
 		}
 	}
@@ -1109,17 +1117,19 @@ func pestilenceDeath(p *player, b *Board, mCard card) (cardEffect, bool, error)
 	l1, l2 := len(players), len(monsters)
 	for len(targets) < 2 {
 		max := l1 + l2 - 1
-		showPlayers(players, 0)
-		showMonsterCards(monsters, l1)
+		b.ui.showPlayers(players, 0)
+		b.ui.showMonsterCards(monsters, l1)
 		if len(targets) == 1 {
 			max += 1
-			fmt.Println(fmt.Sprintf("%d) No additional targets", max))
+			b.ui.menu(max, "No additional targets")
 		}
-		ans := readInput(0, max)
+		ans := b.ui.readInput(0, max)
 		if ans >= 0 && ans < l1 {
 			targets = append(targets, players[ans])
-		} else {
+		} else if ans-l1 < l2 {
 			targets = append(targets, monsters[ans-l1])
+		} else { // No additional targets
+			break
 		}
 	}
 	f = func(roll uint8) {
@@ -1129,8 +1139,6 @@ func pestilenceDeath(p *player, b *Board, mCard card) (cardEffect, bool, error)
 				b.damagePlayerToMonster(p, m, n, 0)
 			} else if p2, ok := ct.(*player); ok {
 				b.damagePlayerToPlayer(p, p2, n)
-			} else {
-				panic("not an anticipated type switch for pestilence")
 			}
 		}
 	}
@@ -1164,9 +1172,9 @@ func prideEvent(p *player, b *Board, mCard card, en *eventNode) (cardEffect, boo
 			}
 			l := len(valid)
 			if l > 0 {
-				showPlayers(valid, 0)
-				fmt.Println("Choose who should discard 2 loot cards")
-				i := uint8(readInput(0, l-1))
+				b.ui.showPlayers(valid, 0)
+				b.ui.Println("Choose who should discard 2 loot cards")
+				i := uint8(b.ui.readInput(0, l-1))
 				f = func(roll uint8) { valid[i].discardHandChoiceHelper(b.loot, 2) }
 			}
 		}
@@ -1187,7 +1195,8 @@ func ragmanDeath(p *player, b *Board, mCard card) (cardEffect, bool, error) {
 			ap := &b.players[b.api]
 			for i := range ap.Souls {
 				if ap.Souls[i].getId() == ragman {
-					b.monster.placeInDeck(ap.popSoul(uint8(i)).(monsterCard), true)
+					b.monster.placeInDeck(ap.popSoul(uint8(i)).card.(monsterCard), true)
+					break
 				}
 			}
 		}
@@ -1271,7 +1280,7 @@ func theHauntEvent(p *player, b *Board, mCard card, en *eventNode) (cardEffect,
 	var d damageEvent
 	if d, err = en.checkDamageToSpecificMonster(theHaunt); err == nil && d.n == 2 {
 		ap := &b.players[b.api]
-		f = func(roll uint8) { ap.activeEffects[theHaunt] = struct{}{} }
+		f = func(roll uint8) { ap.addEffect(theHaunt, mCard, untilEndOfTurn) }
 	}
 	return f, false, nil
 }
@@ -1306,8 +1315,9 @@ func wrathDeath(p *player, b *Board, mCard card) (cardEffect, bool, error) {
 		if roll >= 4 {
 			n = 2
 		}
+		m := mCard.(monsterCard) // a dead monster is no longer in a zone
 		for _, p2 := range b.getPlayers(true) {
-			b.damageMonsterToPlayer(mCard.(*monsterCard), p2, n, 0)
+			b.damageMonsterToPlayer(&m, p2, n, 0)
 		}
 	}, true, nil
 }
@@ -1320,13 +1330,9 @@ func wrathReward(b *Board) (cardEffect, bool) {
 // This deals double damage on a roll of 1
 // When this dies, expand the number of active monsters by 1
 //
-// First effect handled at the damage calculation step
-func momChecker(mId uint16, roll uint8) bool {
-	return mId == mom && roll == 1
-}
-
+// First effect handled by the monster's roll reaction in combat
 func momDeath(p *player, b *Board, mCard card) (cardEffect, bool, error) {
-	return func(roll uint8) { b.monster.zones = append(b.monster.zones, activeSlot{}) }, false, nil
+	return func(roll uint8) { b.monster.addZone() }, false, nil
 }
 
 func momReward(b *Board) (cardEffect, bool) {
@@ -1341,9 +1347,9 @@ func satanEvent(p *player, b *Board, mCard card, en *eventNode) (cardEffect, boo
 	if err = p.checkAttackingPlayer(); err == nil {
 		if err = en.checkDiceRoll(6); err == nil {
 			players := b.getPlayers(true)
-			showPlayers(players, 0)
-			fmt.Println("Who to kill?")
-			i := readInput(0, len(players)-1)
+			b.ui.showPlayers(players, 0)
+			b.ui.Println("Who to kill?")
+			i := b.ui.readInput(0, len(players)-1)
 			f = func(roll uint8) { b.killPlayer(players[i]) }
 		}
 	}
@@ -1367,15 +1373,15 @@ func theLambDeath(p *player, b *Board, mCard card) (cardEffect, bool, error) {
 	}
 	l := len(valid)
 	if l > 0 {
-		showPlayers(valid, 0)
-		fmt.Println("Steal a soul from whom?")
-		target := valid[readInput(0, l-1)]
-		showSouls(target.Souls, target.Character.name, 0)
-		fmt.Println("Which soul to steal?")
-		soulId := target.Souls[uint8(readInput(0, len(target.Souls)-1))].getId()
+		b.ui.showPlayers(valid, 0)
+		b.ui.Println("Steal a soul from whom?")
+		target := valid[b.ui.readInput(0, l-1)]
+		b.ui.showSouls(target.Souls, target.Character.name, 0)
+		b.ui.Println("Which soul to steal?")
+		soulId := target.Souls[uint8(b.ui.readInput(0, len(target.Souls)-1))].getId()
 		f = func(roll uint8) {
 			if i, err := target.getSoulIndex(soulId); err == nil {
-				p.addSoulToBoard(target.popSoul(i))
+				_, _ = p.stealSoul(target, i)
 			}
 		}
 	} else {
@@ -1392,8 +1398,8 @@ func theLambReward(b *Board) (cardEffect, bool) {
 // You must attack the monster deck 2 times this turn
 func ambushFunc(ap *player, b *Board, mCard card) (cardEffect, bool, error) {
 	var f cardEffect = func(roll uint8) {
-		if ap.numAttacks == 1 && !ap.inBattle {
-			ap.numAttacks = 2
+		if ap.attacksLeft() == 1 && !ap.inBattle {
+			ap.numAttacks += 1
 		} else {
 			ap.numAttacks += 2
 		}
@@ -1437,7 +1443,7 @@ func cursedChestFunc(ap *player, b *Board, mCard card) (cardEffect, bool, error)
 			revealedCards := make(deck, 0, b.treasure.deck.len())
 			tc := treasureCard{}
 			for b.treasure.deck.len() > 0 {
-				tc = b.treasure.draw()
+				tc, _ = b.treasure.draw()
 				if _, ok := validGuppyItems[tc.id]; !ok {
 					revealedCards = append(revealedCards, tc)
 				} else {
@@ -1445,12 +1451,12 @@ func cursedChestFunc(ap *player, b *Board, mCard card) (cardEffect, bool, error)
 				}
 			}
 			if _, ok := validGuppyItems[tc.id]; ok {
-				fmt.Println("Found Guppy Item")
+				b.ui.Println("Found Guppy Item")
 				tc.showCard(0)
 				ap.addCardToBoard(tc)
 			}
-			showDeck(revealedCards, false)
-			b.treasure.deck.merge(revealedCards, true, true)
+			b.ui.showDeck(revealedCards, false)
+			b.treasure.deck.merge(revealedCards, true, b.rng)
 		}
 	}
 	return f, true, nil
@@ -1475,9 +1481,10 @@ func darkChestFunc(ap *player, b *Board, mCard card) (cardEffect, bool, error) {
 // Choose one: 1: Discard this. 2: Draw 2, take 1 damage. 3: Search the treasure deck for a guppy
 // item. Gain it and take 2 damage. Shuffle the deck
 func devilDealFunc(ap *player, b *Board, mCard card) (cardEffect, bool, error) {
-	fmt.Println("Choose 1:\n1) Discard this.\n2) Draw 2, take 1 damage\n3) Search the Treasure deck for a Guppy" +
-		"item, gain it and take 2 damage. Shuffle the deck.")
-	ans := readInput(1, 3)
+	b.ui.Println("Choose 1:")
+	b.ui.menu(1, "Discard this.", "Draw 2, take 1 damage",
+		"Search the Treasure deck for a Guppy item, gain it and take 2 damage. Shuffle the deck.")
+	ans := b.ui.readInput(1, 3)
 	var f cardEffect = func(roll uint8) {
 		if ans == 2 {
 			ap.loot(b.loot)
@@ -1489,9 +1496,9 @@ func devilDealFunc(ap *player, b *Board, mCard card) (cardEffect, bool, error) {
 			}...)
 			l := len(guppyCards)
 			if l > 0 {
-				showTreasureCards(guppyCards, "deck", 0)
-				fmt.Println("Which Guppy item to gain?")
-				card := guppyCards[readInput(0, l-1)]
+				b.ui.showTreasureCards(guppyCards, "deck", 0)
+				b.ui.Println("Which Guppy item to gain?")
+				card := guppyCards[b.ui.readInput(0, l-1)]
 				if c, err := b.treasure.deck.popByIndex(idIndexMap[card.getId()]); err == nil {
 					ap.addCardToBoard(c)
 				}
@@ -1506,7 +1513,7 @@ func devilDealFunc(ap *player, b *Board, mCard card) (cardEffect, bool, error) {
 func goldChestFunc(ap *player, b *Board, mCard card) (cardEffect, bool, error) {
 	var f cardEffect = func(roll uint8) {
 		if roll == 1 || roll == 2 {
-			ap.addCardToBoard(b.treasure.draw())
+			ap.gainTreasure(b.treasure)
 		} else if roll == 3 || roll == 4 {
 			ap.gainCents(5)
 		} else {
@@ -1533,9 +1540,9 @@ func greedBonusFunc(ap *player, b *Board, mCard card) (cardEffect, bool, error)
 	var i uint8 = 0
 	l := len(conflict)
 	if l > 1 {
-		showPlayers(conflict, 0)
-		fmt.Println("Which players should lose all cents?")
-		i = uint8(readInput(0, l-1))
+		b.ui.showPlayers(conflict, 0)
+		b.ui.Println("Which players should lose all cents?")
+		i = uint8(b.ui.readInput(0, l-1))
 	}
 	var f cardEffect = func(roll uint8) { conflict[i].loseCents(100) }
 	return f, false, nil
@@ -1545,17 +1552,23 @@ func greedBonusFunc(ap *player, b *Board, mCard card) (cardEffect, bool, error)
 // Look at the top 6 cards of the loot deck. You may put them back in any order, then loot 1
 func iCanSeeForeverFunc(ap *player, b *Board, mCard card) (cardEffect, bool, error) {
 	var f cardEffect = func(roll uint8) {
-		cards := make([]lootCard, 6)
+		done := b.ui.privately(ap) // Only they see the cards
+		cards := make([]lootCard, 0, 6)
 		for i := 0; i < 6; i++ {
-			cards[i] = b.loot.draw()
+			if c, err := b.loot.draw(); err == nil {
+				cards = append(cards, c)
+			}
 		}
+		n := len(cards)
 		for len(cards) > 0 {
-			showLootCards(cards, "peek", 0)
-			fmt.Println("Place which card on top of the deck?")
-			i := uint8(readInput(0, len(cards)-1))
+			b.ui.showLootCards(cards, "peek", 0)
+			b.ui.Println("Place which card on top of the deck?")
+			i := uint8(b.ui.readInput(0, len(cards)-1))
 			b.loot.placeInDeck(cards[i], true)
 			cards = append(cards[:i], cards[i+1:]...)
 		}
+		b.revealTop(zone{kind: lootDeckZone}, n, ap)
+		done()
 		ap.loot(b.loot)
 	}
 	return f, false, nil
@@ -1566,7 +1579,7 @@ func iCanSeeForeverFunc(ap *player, b *Board, mCard card) (cardEffect, bool, err
 func megaTrollBombFunc(ap *player, b *Board, mCard card) (cardEffect, bool, error) {
 	var f cardEffect = func(roll uint8) {
 		for _, p := range b.getPlayers(true) {
-			b.damagePlayerToPlayer(ap, p, 1)
+			b.damagePlayerToPlayer(ap, p, 2)
 		}
 	}
 	return f, false, nil
@@ -1592,7 +1605,7 @@ func secretRoomFunc(ap *player, b *Board, mCard card) (cardEffect, bool, error)
 		} else if roll == 4 || roll == 5 {
 			ap.gainCents(7)
 		} else {
-			ap.addCardToBoard(b.treasure.draw())
+			ap.gainTreasure(b.treasure)
 		}
 	}
 	return f, true, nil
@@ -1603,8 +1616,7 @@ func secretRoomFunc(ap *player, b *Board, mCard card) (cardEffect, bool, error)
 // You may attack an additional time this turn.
 func shopUpgradeFunc(ap *player, b *Board, mCard card) (cardEffect, bool, error) {
 	var f cardEffect = func(roll uint8) {
-		placeHolder := treasureCard{}
-		b.treasure.zones = append(b.treasure.zones, placeHolder, placeHolder)
+		b.treasure.addZones(2)
 		ap.numAttacks += 1
 	}
 	return f, false, nil
@@ -1617,9 +1629,9 @@ func weNeedToGoDeeperFunc(ap *player, b *Board, mCard card) (cardEffect, bool, e
 	var f cardEffect = func(roll uint8) {
 		for len(b.monster.discardPile) > 0 {
 			l := b.monster.discardPile.len()
-			showDeck(b.monster.discardPile, false)
-			fmt.Println(fmt.Sprintf("%d) Stop putting discarded monsters on top of the deck", l))
-			ans := uint8(readInput(0, int(l)))
+			b.ui.showDeck(b.monster.discardPile, false)
+			b.ui.menu(int(l), "Stop putting discarded monsters on top of the deck")
+			ans := uint8(b.ui.readChoice(int(l), 0, int(l)))
 			if ans < l {
 				c, _ := b.monster.discardPile.popByIndex(ans)
 				b.monster.placeInDeck(c.(monsterCard), true)
@@ -1637,16 +1649,16 @@ func weNeedToGoDeeperFunc(ap *player, b *Board, mCard card) (cardEffect, bool, e
 // You may attack an additional time this turn.
 func xlFloorFunc(ap *player, b *Board, mCard card) (cardEffect, bool, error) {
 	var f cardEffect = func(roll uint8) {
-		b.monster.zones = append(b.monster.zones, activeSlot{})
+		b.monster.addZone()
 		ap.numAttacks += 1
 	}
 	return f, false, nil
 }
 
 // Curse
-// When revealed, give this curse to any player (handled elsewhere)
+// When revealed, give this curse to any player (handled by revealCurse)
 // At the end of your turn, discard 2 loot.
-// When you die, discard this. (handled elsewhere)
+// When you die, discard this. (handled by discardCurses)
 func curseOfAmnesiaEvent(p *player, b *Board, mCard card, en *eventNode) (cardEffect, bool, error) {
 	var f cardEffect
 	var err error
@@ -1657,9 +1669,9 @@ func curseOfAmnesiaEvent(p *player, b *Board, mCard card, en *eventNode) (cardEf
 }
 
 // Curse
-// When revealed, give this curse to any player (handled elsewhere)
+// When revealed, give this curse to any player (handled by revealCurse)
 // At the end of your turn, lose 4 cents
-// When you die, discard this. (handled elsewhere)
+// When you die, discard this. (handled by discardCurses)
 func curseOfGreedEvent(p *player, b *Board, mCard card, en *eventNode) (cardEffect, bool, error) {
 	var f cardEffect
 	var err error
@@ -1670,24 +1682,9 @@ func curseOfGreedEvent(p *player, b *Board, mCard card, en *eventNode) (cardEffe
 }
 
 // Curse
-// When revealed, give this curse to any player (handled elsewhere)
-// You now need 5 souls to win.
-// When you die, discard this. (handled elsewhere)
-func curseOfLossChecker(p player) bool {
-	var found bool
-	for _, c := range p.Curses {
-		if c.id == curseOfLoss {
-			found = true
-			break
-		}
-	}
-	return found
-}
-
-// Curse
-// When revealed, give this curse to any player (handled elsewhere)
+// When revealed, give this curse to any player (handled by revealCurse)
 // At the start of your turn, take 1 damage.
-// When you die, discard this. (handled elsewhere)
+// When you die, discard this. (handled by discardCurses)
 func curseOfPainEvent(p *player, b *Board, mCard card, en *eventNode) (cardEffect, bool, error) {
 	var f cardEffect
 	var err error
@@ -1698,15 +1695,48 @@ func curseOfPainEvent(p *player, b *Board, mCard card, en *eventNode) (cardEffec
 }
 
 // Curse
-// When revealed, give this curse to any player (handled elsewhere)
+// When revealed, give this curse to any player (handled by revealCurse)
 // All monsters you attack gain +1 Dice Roll
-// When you die, discard this. (handled elsewhere)
+// When you die, discard this. (handled by discardCurses)
 func curseOfTheBlindEvent(p *player, b *Board, mCard card, en *eventNode) (cardEffect, bool, error) {
 	var f cardEffect
 	var err error
-	var intention intentionToAttackEvent
-	if intention, err = en.checkIntentionToAttack(); err == nil && p.getId() == en.event.p.getId() {
-		f = func(roll uint8) { intention.m.modifyDiceRoll(1) }
+	if _, err = en.checkIntentionToAttack(); err == nil && p.getId() == en.event.p.getId() {
+		f = func(roll uint8) { // The battle starts once the intention resolves, even against the top of the monster deck
+			if b.combat != nil && b.combat.p == p {
+				b.combat.m.modifyDiceRoll(1)
+			}
+		}
+	}
+	return f, false, err
+}
+
+// Curse
+// When revealed, give this curse to any player (handled by revealCurse)
+// Each time you deal damage to a monster, take 1 damage.
+// When you die, discard this. (handled by discardCurses)
+func curseOfBloodLustEvent(p *player, b *Board, mCard card, en *eventNode) (cardEffect, bool, error) {
+	var f cardEffect
+	var err error
+	if _, err = en.checkDamageToMonster(); err == nil && p.getId() == en.event.p.getId() {
+		f = func(roll uint8) { b.damagePlayerToPlayer(p, p, 1) }
+	}
+	return f, false, err
+}
+
+// Curse
+// When revealed, give this curse to any player (handled by revealCurse)
+// At the start of your turn, you must attack the monster deck.
+// When you die, discard this. (handled by discardCurses)
+func curseOfImpulseEvent(p *player, b *Board, mCard card, en *eventNode) (cardEffect, bool, error) {
+	var f cardEffect
+	var err error
+	if err = en.checkStartOfTurn(p); err == nil {
+		f = func(roll uint8) {
+			if p.attacksLeft() > 0 {
+				b.eventStack.push(event{p: p, e: intentionToAttackEvent{m: nil}}) // nil = attack monster deck
+			}
+		}
 	}
 	return f, false, err
 }
@@ -0,0 +1,90 @@
+package four_souls
+
+import (
+	"math/rand"
+	"time"
+)
+
+const (
+	minPlayers uint8 = 2
+	maxPlayers uint8 = 4
+)
+
+// How long players have to decide in games played over the network, unless set otherwise.
+const (
+	DefaultPriorityTimeout = 30 * time.Second
+	DefaultChoiceTimeout   = 2 * time.Minute
+)
+
+// How a game is set up.
+type GameOptions struct {
+	Players       uint8 `json:"players"`         // The number of players, from 2 to 4
+	Kickstarter   bool  `json:"kickstarter"`     // Include the Kickstarter expansion's cards
+	FourSoulsPlus bool  `json:"four_souls_plus"` // Include the Four Souls+ expansion's cards
+	Seed          int64 `json:"seed"`            // Seeds the shuffles and the dice. 0 picks a seed from the clock
+	// How long a player has to act or respond when given priority, and to make other decisions.
+	// Once it runs out, the decision's default is answered for them: pass, a random discard...
+	// 0 lets players take as long as they need.
+	PriorityTimeout time.Duration `json:"priority_timeout"`
+	ChoiceTimeout   time.Duration `json:"choice_timeout"`
+}
+
+func (o GameOptions) validate() error {
+	if o.Players < minPlayers || o.Players > maxPlayers {
+		return wrapError(ErrInvalidInput, "a game takes %d to %d players, not %d", minPlayers, maxPlayers, o.Players)
+	}
+	if o.PriorityTimeout < 0 || o.ChoiceTimeout < 0 {
+		return wrapError(ErrInvalidInput, "decision timeouts can't be negative")
+	}
+	return nil
+}
+
+// Start a new game set up with the options. The same options and seed always deal the same game.
+func NewGameWithOptions(o GameOptions) (b *Board, err error) {
+	defer recoverEngineError(&err)
+	if err = o.validate(); err != nil {
+		return nil, err
+	}
+	if o.Seed == 0 {
+		o.Seed = time.Now().UnixNano()
+	}
+	board := newBoard(rand.New(rand.NewSource(o.Seed)), o.Players, o.Kickstarter, o.FourSoulsPlus)
+	board.options = o
+	return board, nil
+}
+
+// The options the game was set up with, including its seed.
+func (b *Board) Options() GameOptions {
+	return b.options
+}
+
+// The character names of the players, in turn order.
+func (b *Board) Players() []string {
+	names := make([]string, len(b.players))
+	for i := range b.players {
+		names[i] = b.players[i].Character.name
+	}
+	return names
+}
+
+// Whether the player, by character name, passes priority without being asked while they hold no response.
+func (b *Board) AutoPass(name string) bool {
+	for i := range b.players {
+		if b.players[i].Character.name == name {
+			return b.players[i].autoPass
+		}
+	}
+	return false
+}
+
+// Let the player, by character name, pass priority without being asked while they hold no response:
+// no loot card they may play, nothing to activate... They are still asked to act on their own turn.
+func (b *Board) SetAutoPass(name string, on bool) error {
+	for i := range b.players {
+		if b.players[i].Character.name == name {
+			b.players[i].autoPass = on
+			return nil
+		}
+	}
+	return wrapError(ErrInvalidInput, "no player plays %q", name)
+}
@@ -0,0 +1,207 @@
+package four_souls
+
+import (
+	"bufio"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// What a seat does once its player stays disconnected past the timeout.
+type DisconnectPolicy uint8
+
+const (
+	WaitForPlayer DisconnectPolicy = iota // Keep waiting: the game stalls until they come back
+	AutoPass                              // Answer each decision with its default: pass, or the first answer
+	BotTakesOver                          // A bot plays the seat until they come back
+)
+
+func (dp DisconnectPolicy) String() string {
+	switch dp {
+	case AutoPass:
+		return "auto-pass"
+	case BotTakesOver:
+		return "bot"
+	default:
+		return "wait"
+	}
+}
+
+// Parse a policy from its name: wait, auto-pass or bot.
+func ParseDisconnectPolicy(s string) (DisconnectPolicy, error) {
+	for _, dp := range []DisconnectPolicy{WaitForPlayer, AutoPass, BotTakesOver} {
+		if s == dp.String() {
+			return dp, nil
+		}
+	}
+	return WaitForPlayer, wrapError(ErrInvalidInput, "no disconnect policy is named %q: use wait, auto-pass or bot", s)
+}
+
+// The seat of a player who plays over the network, line by line like on a terminal.
+// The player keeps their seat for the whole game through a session token: if their connection drops,
+// they resume with the token on a new one, and are shown the board as they may see it and the decision
+// the game is waiting on. If they stay away past the timeout, the policy plays the seat meanwhile.
+type RemoteSeat struct {
+	mu       sync.Mutex
+	token    string
+	player   string // The character name of the player seated here
+	out      io.Writer
+	prompt   *promptDecider // Reads the answers from the connection. nil while disconnected
+	stale    bool           // The connection resumes the seat: its player hasn't seen the board yet
+	resumed  bool           // The seat had a connection before
+	attached chan struct{}  // Signals a new connection to a decision waiting on one
+	lost     time.Time      // When the connection dropped
+	policy   DisconnectPolicy
+	timeout  time.Duration
+	bot      *Bot
+}
+
+func NewRemoteSeat(policy DisconnectPolicy, timeout time.Duration, seed int64) (*RemoteSeat, error) {
+	token, err := newSessionToken()
+	if err != nil {
+		return nil, err
+	}
+	return &RemoteSeat{token: token, attached: make(chan struct{}, 1), lost: time.Now(),
+		policy: policy, timeout: timeout, bot: NewBot(seed)}, nil
+}
+
+func newSessionToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("making a session token: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// The token that resumes this seat.
+func (rs *RemoteSeat) Token() string {
+	return rs.token
+}
+
+// The seats tell the seat which player it is for.
+func (rs *RemoteSeat) sitAs(player string) {
+	rs.mu.Lock()
+	rs.player = player
+	rs.mu.Unlock()
+}
+
+// Play the seat on a connection, replacing the one it had: read the answers from in, and write to out.
+// The player is brought up to date the next time the game shows them something or waits on them.
+func (rs *RemoteSeat) Attach(in io.Reader, out io.Writer) {
+	rs.mu.Lock()
+	rs.out, rs.prompt, rs.stale, rs.resumed = out, &promptDecider{in: bufio.NewReader(in), out: out}, rs.resumed, true
+	rs.mu.Unlock()
+	select {
+	case rs.attached <- struct{}{}:
+	default:
+	}
+}
+
+// Whether a connection is attached.
+func (rs *RemoteSeat) Connected() bool {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	return rs.prompt != nil
+}
+
+// How long the seat has been disconnected.
+func (rs *RemoteSeat) away() time.Duration {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	return time.Since(rs.lost)
+}
+
+// Forget the connection, unless it was replaced already.
+func (rs *RemoteSeat) detach(pd *promptDecider) {
+	rs.mu.Lock()
+	if rs.prompt == pd {
+		rs.out, rs.prompt, rs.lost = nil, nil, time.Now()
+	}
+	rs.mu.Unlock()
+}
+
+// The connection's prompt, first showing a connection that was just attached the board as the player may see it.
+// Only called by the game, so the board doesn't change while it is read.
+// return: the prompt, nil if disconnected, and true if the player was just brought up to date.
+func (rs *RemoteSeat) current(b *Board) (*promptDecider, bool) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	caughtUp := rs.prompt != nil && rs.stale
+	if caughtUp {
+		rs.stale = false
+		_, _ = fmt.Fprintf(rs.out, "Welcome back, %s.\n", rs.player)
+		if b != nil {
+			_, _ = fmt.Fprint(rs.out, b.ViewFor(rs.player))
+		}
+	}
+	return rs.prompt, caughtUp
+}
+
+func (rs *RemoteSeat) Show(b *Board, s string) {
+	if pd, _ := rs.current(b); pd != nil {
+		pd.Show(b, s)
+	}
+}
+
+// The seats only show a player's seat what is for them.
+func (rs *RemoteSeat) ShowTo(b *Board, player string, s string) {
+	rs.Show(b, s)
+}
+
+// Wait on the connection for the answer. If there is none, or it drops, wait for the player to come back,
+// until the timeout: then the policy answers. Either way, the decision's own timeout runs as usual.
+func (rs *RemoteSeat) Decide(b *Board, d Decision) (int, error) {
+	var expired <-chan time.Time
+	deadline := time.Now().Add(d.Timeout)
+	if d.Timeout > 0 {
+		timer := time.NewTimer(d.Timeout)
+		defer timer.Stop()
+		expired = timer.C
+	}
+	for {
+		if pd, caughtUp := rs.current(b); pd != nil {
+			if caughtUp { // They missed what the decision is about
+				_, _ = fmt.Fprint(pd.out, d.Text)
+			}
+			left := d
+			if d.Timeout > 0 {
+				if left.Timeout = time.Until(deadline); left.Timeout <= 0 {
+					return d.Default, nil
+				}
+			}
+			n, err := pd.Decide(b, left)
+			if err == nil {
+				return n, nil
+			}
+			rs.detach(pd)
+			continue
+		}
+		if left := rs.timeout - rs.away(); rs.policy == WaitForPlayer || left > 0 {
+			var timeout <-chan time.Time
+			var timer *time.Timer
+			if rs.policy != WaitForPlayer {
+				timer = time.NewTimer(left)
+				timeout = timer.C
+			}
+			select {
+			case <-rs.attached:
+			case <-timeout:
+			case <-expired:
+				return d.Default, nil
+			}
+			if timer != nil {
+				timer.Stop()
+			}
+		}
+		if rs.Connected() || rs.policy == WaitForPlayer || rs.away() < rs.timeout {
+			continue
+		}
+		if rs.policy == BotTakesOver {
+			return rs.bot.Decide(b, d)
+		}
+		return d.Default, nil
+	}
+}
@@ -0,0 +1,97 @@
+package four_souls
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Seats the players of one game at different deciders: a terminal each, a bot, a remote client...
+// Each decision goes to the decider of the player who makes it. Everything shown goes to every decider.
+// Deciders must be comparable, like pointers, since a decider seated for several players is shown things once.
+// Spectators may watch at any time, even while the game is played: they are only shown what every player is.
+type Seats struct {
+	deciders map[string]Decider
+	all      []Decider // Each distinct decider, in the order they were seated
+	mu       sync.Mutex
+	watchers []*Spectator
+}
+
+func NewSeats() *Seats {
+	return &Seats{deciders: make(map[string]Decider, maxPlayers)}
+}
+
+// Seat the player, by character name, at the decider.
+func (s *Seats) Sit(player string, d Decider) {
+	s.deciders[player] = d
+	if seat, ok := d.(interface{ sitAs(string) }); ok {
+		seat.sitAs(player)
+	}
+	for _, seated := range s.all {
+		if seated == d {
+			return
+		}
+	}
+	s.all = append(s.all, d)
+}
+
+// The decider the player is seated at, if any.
+func (s *Seats) For(player string) (Decider, bool) {
+	d, ok := s.deciders[player]
+	return d, ok
+}
+
+// Let the spectator watch the game from now on.
+func (s *Seats) Watch(sp *Spectator) {
+	s.mu.Lock()
+	s.watchers = append(s.watchers, sp)
+	s.mu.Unlock()
+}
+
+func (s *Seats) spectators() []*Spectator {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	watching := s.watchers[:0]
+	for _, sp := range s.watchers {
+		select {
+		case <-sp.Gone(): // They left
+		default:
+			watching = append(watching, sp)
+		}
+	}
+	s.watchers = watching
+	return append([]*Spectator(nil), watching...)
+}
+
+func (s *Seats) Show(b *Board, str string) {
+	for _, d := range s.all {
+		d.Show(b, str)
+	}
+	for _, sp := range s.spectators() {
+		sp.Show(b, str)
+	}
+}
+
+// Only the player's decider is shown what is for them.
+func (s *Seats) ShowTo(b *Board, player string, str string) {
+	if d, ok := s.deciders[player]; ok {
+		showTo(d, b, player, str)
+	}
+}
+
+// A decision without a player is made by the active player.
+// Spectators are shown the board first, if it changed since they last saw it.
+func (s *Seats) Decide(b *Board, d Decision) (int, error) {
+	for _, sp := range s.spectators() {
+		sp.watch(b)
+	}
+	player := d.Player
+	if player == "" && b != nil {
+		if ap := b.getActivePlayer(); ap != nil {
+			player = ap.Character.name
+		}
+	}
+	if seat, ok := s.deciders[player]; ok {
+		return seat.Decide(b, d)
+	}
+	return 0, fmt.Errorf("no one is seated for %q", player)
+}
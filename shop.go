@@ -0,0 +1,73 @@
+package four_souls
+
+// Buy an itemCard from either the treasure zone or the top of the deck.
+// idx: the shop zone to buy from. len(zones) buys the top card of the treasure deck instead.
+// The price is the player's shop cost, so Steamy Sale, Credit Card and the like apply.
+// A purchase that fails leaves the shop, the deck and the player as they were.
+// A zone that was bought from stays empty until the field is checked once the stack resolves.
+func (t *tArea) buyFromShop(p *player, idx uint8) error {
+	l := uint8(len(t.zones))
+	if idx > l {
+		return wrapError(ErrInvalidTarget, "there is no shop zone %d", idx)
+	}
+	if idx < l && t.zones[idx].id == 0 {
+		return wrapError(ErrInvalidTarget, "shop zone %d is empty", idx)
+	}
+	if idx == l && len(t.deck) == 0 && len(t.discardPile) == 0 {
+		return wrapError(ErrEmptyDeck, "there is no treasure to buy from the deck")
+	}
+	cost := p.getShopCost()
+	if p.Pennies < cost {
+		return wrapError(ErrIllegalAction, "%s needs %d¢ to buy an item", p.Character.name, cost)
+	}
+	var tCard treasureCard
+	if idx < l {
+		tCard, t.zones[idx] = t.zones[idx], treasureCard{}
+	} else {
+		var err error
+		if tCard, err = t.draw(); err != nil {
+			return err
+		}
+	}
+	p.useEffect(creditCard)
+	p.loseCents(cost)
+	p.numPurchases -= 1
+	p.addCardToBoard(&tCard)
+	p.bus.publishCard(ItemBought, p, tCard, int(cost))
+	return nil
+}
+
+// Add empty shop zones. They are filled from the treasure deck when the field is next checked.
+func (t *tArea) addZones(n uint8) {
+	for i := uint8(0); i < n; i++ {
+		t.zones = append(t.zones, treasureCard{})
+	}
+}
+
+// Put the item in a shop zone into discard, leaving the zone empty.
+func (t *tArea) discardZone(idx uint8) {
+	if int(idx) < len(t.zones) && t.zones[idx].id != 0 {
+		tc := t.zones[idx]
+		t.zones[idx] = treasureCard{}
+		t.discard(&tc)
+	}
+}
+
+// Fill every empty shop zone with the top card of the treasure deck.
+func (t *tArea) refill() {
+	for i := range t.zones {
+		if t.zones[i].id == 0 { // No treasure value here
+			if tc, err := t.draw(); err == nil {
+				t.zones[i] = tc
+			}
+		}
+	}
+}
+
+// Show the shop as the player sees it: the items in the zones, the deck to buy
+// from blind, and what an item costs them.
+func (t *tArea) showShop(p *player) {
+	p.ui.showTreasureCards(t.zones, "shop", 0)
+	p.ui.menu(len(t.zones), "Top card of the Treasure deck")
+	p.ui.Printf("Everything costs %d¢ for %s. Buy which item?\n", p.getShopCost(), p.Character.name)
+}
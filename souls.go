@@ -0,0 +1,105 @@
+package four_souls
+
+// Where a soul card came from.
+type soulOrigin uint8
+
+const (
+	bossSoul      soulOrigin = iota // A boss killed by the player
+	lootSoul                        // The Lost Soul loot card
+	characterSoul                   // The Lost, whose character card starts the game as a soul
+	bonusSoul                       // Any other card that became a soul, like The Chest or The Bone
+)
+
+func (so soulOrigin) String() string {
+	switch so {
+	case bossSoul:
+		return "Boss"
+	case lootSoul:
+		return "Loot"
+	case characterSoul:
+		return "Character"
+	default:
+		return "Bonus"
+	}
+}
+
+// The bosses worth two souls.
+var doubleSouls = map[uint16]struct{}{mom: {}, satan: {}, theLamb: {}, hush: {}, isaacMonster: {}, momsHeart: {}}
+
+// A card a player controls as a soul.
+type soul struct {
+	card              // The card the soul was gained from
+	value  uint8      // The number of souls this card counts as toward victory
+	origin soulOrigin // How the card became a soul
+}
+
+// Make a soul out of a card. A card that is already a soul keeps its value and origin.
+func newSoul(c card) soul {
+	if s, ok := c.(soul); ok {
+		return s
+	}
+	s := soul{card: c, value: 1, origin: bonusSoul}
+	switch c.(type) {
+	case characterCard, *characterCard:
+		s.origin = characterSoul
+	case lootCard, *lootCard:
+		s.origin = lootSoul
+	case monsterCard:
+		if c.(monsterCard).isBoss {
+			s.origin = bossSoul
+		}
+	case *monsterCard:
+		if c.(*monsterCard).isBoss {
+			s.origin = bossSoul
+		}
+	}
+	if _, ok := doubleSouls[c.getId()]; ok {
+		s.value = 2
+	}
+	return s
+}
+
+// Adds a card to the player's souls.
+// Return: if the player reached the number of souls to win.
+func (p *player) gainSoul(c card) bool {
+	s := newSoul(c)
+	p.Souls = append(p.Souls, s)
+	p.bus.publishCard(SoulGained, p, s.card, int(s.value))
+	return p.soulValue() >= p.soulsToWin()
+}
+
+// Take a soul from another player.
+// Return: if the player reached the number of souls to win.
+func (p *player) stealSoul(from *player, idx uint8) (bool, error) {
+	if int(idx) >= len(from.Souls) {
+		return false, wrapError(ErrInvalidTarget, "%s has no soul %d", from.Character.name, idx)
+	}
+	return p.gainSoul(from.popSoul(idx)), nil
+}
+
+// Put one of the player's souls into the discard pile of the deck it came from.
+func (b *Board) destroySoul(p *player, idx uint8) error {
+	if int(idx) >= len(p.Souls) {
+		return wrapError(ErrInvalidTarget, "%s has no soul %d", p.Character.name, idx)
+	}
+	b.discard(p.popSoul(idx).card)
+	return nil
+}
+
+// The number of souls the player has toward victory.
+func (p player) soulValue() uint8 {
+	var n uint8
+	for _, s := range p.Souls {
+		n += s.value
+	}
+	return n
+}
+
+// The number of souls the player needs to win.
+func (p player) soulsToWin() uint8 {
+	n := soulsToWin
+	if p.hasCurse(curseOfLoss) {
+		n += 1
+	}
+	return n
+}
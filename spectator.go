@@ -0,0 +1,102 @@
+package four_souls
+
+import (
+	"io"
+	"sync"
+	"time"
+)
+
+// Watches a game without a seat. It is never asked anything, and is only shown what is public:
+// what the engine shows every player, and the board as a spectator sees it whenever it changes.
+// With a delay, everything reaches it that much later, so that a player who streams their game
+// can't be watched for their hand.
+type Spectator struct {
+	out   io.Writer
+	delay time.Duration
+	mu    sync.Mutex
+	cond  *sync.Cond
+	queue []spectated
+	last  string // The last view of the board queued
+	done  bool   // No more is queued once the game is over, or the spectator left
+	gone  chan struct{}
+}
+
+type spectated struct {
+	at   time.Time
+	text string
+}
+
+// Make a spectator that writes the game to out, delay after it happens.
+func NewSpectator(out io.Writer, delay time.Duration) *Spectator {
+	s := &Spectator{out: out, delay: delay, gone: make(chan struct{})}
+	s.cond = sync.NewCond(&s.mu)
+	go s.feed()
+	return s
+}
+
+// Queue what the engine showed every player.
+func (s *Spectator) Show(b *Board, str string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.done {
+		s.queue = append(s.queue, spectated{at: time.Now(), text: str})
+		s.cond.Signal()
+	}
+}
+
+// Queue the board as a spectator sees it, if it changed since it was last queued.
+// Only called by the game, so the board doesn't change while it is read.
+func (s *Spectator) watch(b *Board) {
+	s.mu.Lock()
+	done := s.done
+	s.mu.Unlock()
+	if b == nil || done {
+		return
+	}
+	view := b.ViewFor("").String()
+	s.mu.Lock()
+	changed := view != s.last
+	s.last = view
+	s.mu.Unlock()
+	if changed {
+		s.Show(b, "\n"+view+"\n")
+	}
+}
+
+// Stop queueing: the spectator is still sent what was queued, as its delay runs out.
+func (s *Spectator) Close() {
+	s.mu.Lock()
+	s.done = true
+	s.cond.Signal()
+	s.mu.Unlock()
+}
+
+// Closed once everything queued was sent, or the spectator can't be written to anymore.
+func (s *Spectator) Gone() <-chan struct{} {
+	return s.gone
+}
+
+// Write what is queued once its delay ran out, until the spectator is closed and everything was sent.
+func (s *Spectator) feed() {
+	defer close(s.gone)
+	for {
+		s.mu.Lock()
+		for len(s.queue) == 0 && !s.done {
+			s.cond.Wait()
+		}
+		if len(s.queue) == 0 {
+			s.mu.Unlock()
+			return
+		}
+		next := s.queue[0]
+		s.queue = s.queue[1:]
+		s.mu.Unlock()
+		time.Sleep(time.Until(next.at.Add(s.delay)))
+		if _, err := io.WriteString(s.out, next.text); err != nil {
+			s.mu.Lock()
+			s.done, s.queue = true, nil
+			s.mu.Unlock()
+			return
+		}
+	}
+}
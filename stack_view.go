@@ -0,0 +1,119 @@
+package four_souls
+
+import "errors"
+
+// A read-only snapshot of one node on the event stack.
+// Clients render these, and card effects that target an event (Dice Shard, Soul Heart, Butter Bean...)
+// can refer to the node by its Id, which never changes while the node is on the stack.
+type StackNode struct {
+	Id         uint     `json:"id"`         // The stable id of the node on the event stack
+	Kind       string   `json:"kind"`       // The type of event (Damage, Dice Roll, Loot Card...)
+	Source     string   `json:"source"`     // The name of the card that created the event, if any
+	SourceId   uint16   `json:"source_id"`  // The id of the card that created the event, if any
+	Controller string   `json:"controller"` // The character name of the player who pushed the event
+	Targets    []string `json:"targets"`    // The names of the cards / characters the event targets
+	Roll       uint8    `json:"roll"`       // The dice roll attached to the event. 0 if no roll is attached
+	Fizzled    bool     `json:"fizzled"`    // True if the event was cancelled and will do nothing on resolving
+}
+
+// The whole event stack, with the top of the stack (next to resolve) at index 0.
+type StackView []StackNode
+
+// Take a read-only snapshot of the event stack.
+// Modifying the returned view does not change the board.
+func (b *Board) StackView() StackView {
+	view := make(StackView, 0, b.eventStack.size)
+	if b.eventStack.head != nil {
+		for curr := b.eventStack.head.top; curr != nil; curr = curr.next {
+			view = append(view, curr.view())
+		}
+	}
+	return view
+}
+
+// Find a node in the view by its stable id.
+func (sv StackView) Node(id uint) (StackNode, error) {
+	var node StackNode
+	var err = errors.New("node not found")
+	for _, n := range sv {
+		if n.Id == id {
+			node, err = n, nil
+			break
+		}
+	}
+	return node, err
+}
+
+// Get the live node on the event stack that a StackNode describes.
+// Card effects use this after a player picks a target from the view.
+func (b *Board) getEventNodeById(id uint) (*eventNode, error) {
+	if b.eventStack.isEmpty() {
+		return nil, errors.New("no items in stack")
+	}
+	return b.eventStack.search(id)
+}
+
+// Describe the event held in the node.
+func (en eventNode) view() StackNode {
+	node := StackNode{Id: en.id, Roll: en.event.roll, Targets: make([]string, 0, 1)}
+	if en.event.p != nil {
+		node.Controller = en.event.p.Character.name
+	}
+	setSource := func(c card) {
+		if c != nil {
+			node.Source, node.SourceId = c.getName(), c.getId()
+		}
+	}
+	switch value := en.event.e.(type) {
+	case activateEvent:
+		node.Kind = "Activated Effect"
+		setSource(value.c)
+	case damageEvent:
+		node.Kind = "Damage"
+		if value.monster != nil {
+			setSource(value.monster)
+		}
+		if value.target != nil {
+			node.Targets = append(node.Targets, value.target.getName())
+		}
+	case deathOfCharacterEvent:
+		node.Kind = "Death"
+		node.Targets = append(node.Targets, node.Controller)
+	case declareAttackEvent:
+		node.Kind = "Attack Monster"
+		if value.m != nil {
+			node.Targets = append(node.Targets, value.m.name)
+		}
+	case declarePurchaseEvent:
+		node.Kind = "Buy Item"
+	case diceRollEvent:
+		node.Kind, node.Roll = "Dice Roll", value.n
+	case endTurnEvent:
+		node.Kind = "End of Turn"
+	case fizzledEvent:
+		node.Kind, node.Fizzled = "Fizzled", true
+	case intentionToAttackEvent:
+		node.Kind = "Intention to Attack"
+		if value.m != nil {
+			node.Targets = append(node.Targets, value.m.name)
+		} else {
+			node.Targets = append(node.Targets, "Monster Deck")
+		}
+	case intentionToPurchaseEvent:
+		node.Kind = "Intention to Purchase"
+	case lootCardEvent:
+		node.Kind = "Loot Card"
+		setSource(value.l)
+	case monsterRewardEvent:
+		node.Kind = "Monster Reward"
+	case paidItemEvent:
+		node.Kind = "Paid Item"
+		setSource(value.t)
+	case startOfTurnEvent:
+		node.Kind = "Start of Turn"
+	case triggeredEffectEvent:
+		node.Kind = "Triggered Effect"
+		setSource(value.c)
+	}
+	return node
+}
@@ -31,8 +31,6 @@ package four_souls
 
 import (
 	"errors"
-	"fmt"
-	"math/rand"
 	"sort"
 )
 
@@ -57,16 +55,16 @@ func batteryBumFunc(p *player, b *Board, tCard card) (cardEffect, bool, error) {
 	if p.Pennies < 4 {
 		return nil, false, errors.New("not enough cents to pay")
 	}
-	p.loseCents(4)
 	items := p.getTappedActiveItems()
 	l := len(items)
 	if l == 0 {
 		return nil, false, errors.New("no items have been tapped")
 	}
+	p.loseCents(4)
 	var i uint8
 	if l > 1 {
-		showTreasureCards(items, "self", 0)
-		i = uint8(readInput(0, l-1))
+		b.ui.showTreasureCards(items, "self", 0)
+		i = uint8(b.ui.readInput(0, l-1))
 	}
 	return func(roll uint8) { p.rechargeActiveItemById(items[i].id) }, false, nil
 }
@@ -78,8 +76,8 @@ func bellyButtonFuncEvent(p *player, b *Board, tCard card, en *eventNode) (cardE
 	var f cardEffect
 	var err error
 	if _, err = en.checkDamageToPlayer(p.Character.id); err == nil && p.Character.tapped {
-		fmt.Println("1) Recharge character value\n2) Do not.")
-		if uint8(readInput(1, 2)) == 1 {
+		b.ui.menu(1, "Recharge character value", "Do not.")
+		if uint8(b.ui.readChoice(2, 1, 2)) == 1 {
 			f = func(roll uint8) { p.Character.recharge() }
 		}
 	} else {
@@ -88,25 +86,27 @@ func bellyButtonFuncEvent(p *player, b *Board, tCard card, en *eventNode) (cardE
 	return f, false, err
 }
 
-// Hybrid passive item
-// You may play an additional loot card on your turn <-
-// Each time you take damage, you may recharge your Character card.
-func bellyButtonFuncConstant(p *player, b *Board, c card, leavingField bool) {
-	if !leavingField {
-		p.numLootPlayed += 1
-		p.baseNumLootPlayed += 1
-	} else {
-		if p.numLootPlayed > 0 {
-			p.numLootPlayed -= 1
+// Active item
+// Destroy a curse. If you do, gain +1 treasure.
+func blackCandleFunc(p *player, b *Board, tCard card) (cardEffect, bool, error) {
+	destroy, err := b.destroyCurseHelper(b.getCursedPlayers())
+	if err != nil {
+		return nil, false, err
+	}
+	var f cardEffect = func(roll uint8) {
+		if destroy() {
+			if tc, err := b.treasure.draw(); err == nil {
+				_ = p.addCardToBoard(&tc)
+			}
 		}
-		p.baseNumLootPlayed -= 1
 	}
+	return f, false, nil
 }
 
 // Active Item
 // Double the effect of the next Loot Card you play.
 func blankCardFunc(p *player, b *Board, tCard card) (cardEffect, bool, error) {
-	return func(roll uint8) { p.activeEffects[blankCard] = struct{}{} }, false, nil
+	return func(roll uint8) { p.addEffect(blankCard, tCard, nextTime) }, false, nil
 }
 
 // Starting Item (Samson)
@@ -116,9 +116,9 @@ func bloodLustFunc(p *player, b *Board, tCard card) (cardEffect, bool, error) {
 	players := b.getPlayers(true)
 	l := len(players)
 	monsters := b.monster.getActiveMonsters()
-	showPlayers(players, 0)
-	showMonsterCards(monsters, l)
-	ans := readInput(0, l+len(monsters)-1)
+	b.ui.showPlayers(players, 0)
+	b.ui.showMonsterCards(monsters, l)
+	ans := b.ui.readInput(0, l+len(monsters)-1)
 	var c combatTarget
 	if ans < l {
 		c = players[ans]
@@ -143,16 +143,16 @@ func bobsBrainFunc(p *player, b *Board, tCard card, en *eventNode) (cardEffect,
 		err, f = nil, func(roll uint8) {
 			if roll == 1 || roll == 2 {
 				monsters := b.monster.getActiveMonsters()
-				showMonsterCards(monsters, 0)
-				ans := uint8(readInput(0, len(monsters)-1))
+				b.ui.showMonsterCards(monsters, 0)
+				ans := uint8(b.ui.readInput(0, len(monsters)-1))
 				b.damagePlayerToMonster(p, monsters[ans], 1, 0)
 			} else if roll == 3 || roll == 4 {
 				players := b.getPlayers(true)
 				l := len(players)
 				var ans uint8
 				if l > 1 {
-					showPlayers(players, 0)
-					ans = uint8(readInput(0, l-1))
+					b.ui.showPlayers(players, 0)
+					ans = uint8(b.ui.readInput(0, l-1))
 				}
 				b.damagePlayerToPlayer(p, players[ans], 1)
 			} else {
@@ -174,16 +174,16 @@ func bookOfBelialFunc(p *player, b *Board, tCard card) (cardEffect, bool, error)
 	if l == 0 {
 		return nil, false, errors.New("no dice rolls")
 	} else if l > 1 {
-		showEvents(rolls)
-		ans = uint8(readInput(0, len(rolls)-1))
+		b.ui.showEvents(rolls)
+		ans = uint8(b.ui.readInput(0, len(rolls)-1))
 	}
 	node := rolls[ans]
-	fmt.Println("1) Add 1 to the roll.\n2) Subtract 1 from the roll.")
-	ans = uint8(readInput(1, 2))
+	b.ui.menu(1, "Add 1 to the roll.", "Subtract 1 from the roll.")
+	ans = uint8(b.ui.readInput(1, 2))
 	if ans == 2 {
 		n = -1
 	}
-	var f cardEffect = func(roll uint8) { b.eventStack.addToDiceRoll(n, node) }
+	var f cardEffect = func(roll uint8) { _ = b.eventStack.addToDiceRoll(n, node) }
 	return f, false, nil
 }
 
@@ -213,13 +213,13 @@ func boomerangeFunc(p *player, b *Board, tCard card) (cardEffect, bool, error) {
 	l := len(players)
 	var i uint8
 	if l > 1 {
-		showPlayers(players, 0)
-		i = uint8(readInput(0, l-1))
+		b.ui.showPlayers(players, 0)
+		i = uint8(b.ui.readInput(0, l-1))
 	}
 	p2 := players[i]
 	var f cardEffect = func(roll uint8) {
 		if len(p2.Hand) > 0 {
-			c := p2.popHandCard(uint8(rand.Intn(len(p2.Hand))))
+			c := p2.popHandCard(uint8(b.rng.Intn(len(p2.Hand))))
 			p.Hand = append(p.Hand, c)
 		}
 	}
@@ -238,14 +238,10 @@ func boxFunc(p *player, b *Board, tCard card) (cardEffect, bool, error) {
 	if err != nil {
 		return nil, false, err
 	}
-	b.treasure.discard(&p.ActiveItems[i])
-	return func(roll uint8) { p.numLootPlayed = 255 }, false, nil
-}
-
-// Constant Passive Item
-// +1 HP
-func breakfastFunc(p *player, b *Board, c card, leavingField bool) {
-	modifyHealth(p, 1, leavingField)
+	if c, err := p.popActiveItem(i); err == nil {
+		b.discard(c)
+	}
+	return func(roll uint8) { p.addEffect(box, tCard, untilEndOfTurn) }, false, nil
 }
 
 // Hybrid Passive Item
@@ -260,9 +256,9 @@ func brimstoneFuncEvent(p *player, b *Board, tCard card, en *eventNode) (cardEff
 		if l > 0 {
 			var i uint8
 			if l > 1 {
-				showPlayers(others, 0)
-				fmt.Println("Choose who to inflict damage to")
-				i = uint8(readInput(0, l-1))
+				b.ui.showPlayers(others, 0)
+				b.ui.Println("Choose who to inflict damage to")
+				i = uint8(b.ui.readInput(0, l-1))
 			}
 			err, f = nil, func(roll uint8) { b.damagePlayerToPlayer(p, others[i], 1) }
 		}
@@ -270,39 +266,14 @@ func brimstoneFuncEvent(p *player, b *Board, tCard card, en *eventNode) (cardEff
 	return f, false, err
 }
 
-// Hybrid Passive Item
-// +1 Attack
-// Each time you deal damage to a monster, also deal 1 damage to another player
-func brimstoneFuncConstant(p *player, b *Board, tCard card, isLeaving bool) {
-	modifyAttack(p, 1, isLeaving)
-}
-
-// Constant Passive Item
-// If you would gain cents, instead put that many counters on this.
-// 1+: Add +2 to your first attack roll each turn.
-// 10+: Gain +1 Attack
-// 25+: You may attack any number of times this turn.
-func bumboFunc(p *player, b *Board, tCard card, isLeaving bool) {
-	if isLeaving {
-		t := tCard.(treasureCard)
-		if t.counters >= 25 {
-			p.numAttacks -= 99
-			p.baseNumAttacks -= 99
-		}
-		if t.counters >= 10 {
-			modifyAttack(p, 1, isLeaving)
-		}
-	}
-}
-
 // Active Item
 // Loot 1: Put a loot card from your hand on top of the deck.
 func bumFriendFunc(p *player, b *Board, tCard card) (cardEffect, bool, error) {
 	var f cardEffect = func(roll uint8) {
 		p.loot(b.loot)
-		showLootCards(p.Hand, "self", 0)
-		fmt.Println("Which to place on top of deck?")
-		ans := readInput(0, len(p.Hand)-1)
+		b.ui.showHand(p, 0)
+		b.ui.Println("Which to place on top of deck?")
+		ans := b.ui.readInput(0, len(p.Hand)-1)
 		c := p.popHandCard(uint8(ans))
 		b.loot.placeInDeck(c, true)
 	}
@@ -321,47 +292,45 @@ func cambionConceptionFunc(p *player, b *Board, tCard card, en *eventNode) (card
 			t.counters += 1
 			if t.counters == 6 {
 				t.counters = 0
-				p.addCardToBoard(b.treasure.draw())
+				p.gainTreasure(b.treasure)
 			}
 		}
 	}
 	return f, false, err
 }
 
-// Constant Passive item
-// Gain +1 Attack for the first attack roll of your turn
-// You may attack an additional time
-func championBeltFunc(p *player, b *Board, tCard card, isLeaving bool) {
-	if !isLeaving {
-		p.numAttacks += 1
-		p.baseNumAttacks += 1
-	} else {
-		p.numAttacks -= 1
-		p.baseNumAttacks -= 1
-	}
-}
-
 // Active Item
-// Each player gives all of their loot cards to the player to the left.
+// Each player gives all of their non-eternal items to the player to their left.
 func chaosFunc(p *player, b *Board, tCard card) (cardEffect, bool, error) {
 	var f cardEffect = func(roll uint8) {
-		orderedPlayers := b.getPlayers(false)
-		l := len(orderedPlayers)
-		hands := make([][]lootCard, l)
-		for i, p := range orderedPlayers {
-			hands[i] = p.Hand
-		}
-		temp := hands[0]
-		copy(hands[0:], hands[1:])
-		hands[l-1] = temp
-		for i, h := range hands {
-			orderedPlayers[i].Hand = h
+		if err := b.chaos(); err != nil {
+			b.ui.Println(err)
 		}
 	}
 	return f, false, nil
 }
-func (b *Board) chaos() {
 
+// Give every non-eternal item of each player to the player on their left (the next player).
+// Souls aren't items: they stay where they are.
+func (b *Board) chaos() error {
+	players := b.getPlayers(false)
+	l := len(players)
+	exchanges := make([]cardExchange, 0, l)
+	for i, p := range players {
+		ex := cardExchange{from: p, to: players[(i+1)%l]}
+		for j := range p.ActiveItems {
+			if !p.ActiveItems[j].isEternal() {
+				ex.items = append(ex.items, p.ActiveItems[j])
+			}
+		}
+		for j := range p.PassiveItems {
+			if !p.PassiveItems[j].isEternal() {
+				ex.items = append(ex.items, p.PassiveItems[j])
+			}
+		}
+		exchanges = append(exchanges, ex)
+	}
+	return b.exchangeCards(exchanges)
 }
 
 // Active Item
@@ -377,14 +346,14 @@ func chaosCardFunc(p *player, b *Board, tCard card) (cardEffect, bool, error) {
 	}
 	b.treasure.discard(&p.ActiveItems[i])
 	var f cardEffect = func(roll uint8) {
-		fmt.Println("1) Kill a monster / player.\n2) Destroy an treasure or soul value.")
-		ans := readInput(1, 2)
+		b.ui.menu(1, "Kill a monster / player.", "Destroy an treasure or soul value.")
+		ans := b.ui.readInput(1, 2)
 		if ans == 1 {
 			monsters, characters := b.monster.getActiveMonsters(), b.getCharacters(true)
 			l := len(monsters)
-			showMonsterCards(monsters, 0)
-			showCharacterCards(b.getPlayers(false), l)
-			i := uint8(readInput(0, l-1))
+			b.ui.showMonsterCards(monsters, 0)
+			b.ui.showCharacterCards(b.getPlayers(false), l)
+			i := uint8(b.ui.readInput(0, l-1))
 			if i < uint8(l) {
 				b.killMonster(p, monsters[i].id)
 			} else {
@@ -395,19 +364,24 @@ func chaosCardFunc(p *player, b *Board, tCard card) (cardEffect, bool, error) {
 			for _, p2 := range b.getPlayers(false) {
 				items := p2.getAllItems(false)
 				l := len(items)
-				fmt.Println("0) Continue to next player.")
-				showItems(items, 0)
-				showSouls(p2.Souls, p2.Character.name, l)
-				ans := readInput(0, l+len(p2.Souls))
-				var c card
-				if ans > 0 && ans < l {
+				b.ui.menu(0, "Continue to next player.")
+				b.ui.showItems(items, 1)
+				b.ui.showSouls(p2.Souls, p2.Character.name, l+1)
+				ans := b.ui.readChoice(0, 0, l+len(p2.Souls))
+				if ans == 0 {
+					continue
+				}
+				if ans <= l {
 					id, isPassive := items[ans-1].getId(), items[ans-1].isPassive()
 					j, _ := p2.getItemIndex(id, isPassive)
-					c = p2.popItemByIndex(j, isPassive)
+					item, err := p2.popItemByIndex(j, isPassive)
+					if err != nil {
+						break
+					}
+					b.discard(item)
 				} else {
-					c = p2.popSoul(uint8(ans - l - 1))
+					_ = b.destroySoul(p2, uint8(ans-l-1))
 				}
-				b.discard(c)
 				break
 			}
 		}
@@ -424,13 +398,13 @@ func chargedBabyFunc(p *player, b *Board, tCard card, en *eventNode) (cardEffect
 		tappedItems := p.getTappedActiveItems()
 		l := len(tappedItems)
 		if l > 0 {
-			fmt.Println("1) Recharge an item?\n2) Do nothing.")
-			ans := uint8(readInput(1, 2))
+			b.ui.menu(1, "Recharge an item?", "Do nothing.")
+			ans := uint8(b.ui.readChoice(2, 1, 2))
 			if ans == 1 {
 				var i uint8
 				if l > 1 {
-					showTreasureCards(tappedItems, "self", 0)
-					i = uint8(readInput(0, l-1))
+					b.ui.showTreasureCards(tappedItems, "self", 0)
+					i = uint8(b.ui.readInput(0, l-1))
 				}
 				f = func(roll uint8) { p.rechargeActiveItemById(tappedItems[i].id) }
 			}
@@ -447,19 +421,14 @@ func cheeseGraterFunc(p *player, b *Board, tCard card, en *eventNode) (cardEffec
 	var err error
 	if err = en.checkDiceRoll(6); err == nil {
 		f = func(roll uint8) {
-			fmt.Print("1) Loot Deck\n2)Monster Deck\n3) Treasure Deck")
-			ans := readInput(1, 3)
-			var c card
-			if ans == 1 {
-				c = b.loot.draw()
-			} else if ans == 2 {
-				c = b.monster.draw()
-			} else {
-				c = b.treasure.draw()
+			b.ui.menu(1, "Loot Deck", "Monster Deck", "Treasure Deck")
+			c, err := b.drawTopCard(b.ui.readInput(1, 3))
+			if err != nil {
+				return
 			}
-			c.showCard(0)
-			fmt.Println("1) Discard this value?\n2) Place back on top.")
-			ans = readInput(1, 2)
+			b.ui.Print(c.showCard(0))
+			b.ui.menu(1, "Discard this value?", "Place back on top.")
+			ans := b.ui.readChoice(2, 1, 2)
 			if ans == 1 {
 				b.discard(c)
 			} else {
@@ -474,7 +443,7 @@ func cheeseGraterFunc(p *player, b *Board, tCard card, en *eventNode) (cardEffec
 // The next time a player would loot, they loot from the top
 // of the loot deck's discard pile instead.
 func compostFunc(p *player, b *Board, tCard card) (cardEffect, bool, error) {
-	return func(roll uint8) { b.loot.activeEffects[compost] = struct{}{} }, false, nil
+	return func(roll uint8) { b.loot.effects.add(effect{id: compost, source: tCard, d: nextTime}) }, false, nil
 }
 
 // Paid Item
@@ -486,32 +455,38 @@ func contractFromBelowFunc(p *player, b *Board, tCard card) (cardEffect, bool, e
 	if l < 2 {
 		return nil, false, errors.New("not enough items to destroy")
 	}
+	others, owners := b.getAllItems(false, p)
+	if len(others) == 0 {
+		return nil, false, wrapError(ErrIllegalAction, "no item to steal")
+	}
 	var i uint8
-	showItems(items, 0)
-	fmt.Println("Pick two cards to destroy")
+	b.ui.showItems(items, 0)
+	b.ui.Println("Pick two cards to destroy")
 	toDestroy := make(map[uint8]struct{}, 2)
 	for i < 2 {
-		ans := uint8(readInput(0, l-1))
+		ans := uint8(b.ui.readInput(0, l-1))
 		if _, ok := toDestroy[ans]; !ok {
 			toDestroy[ans] = struct{}{}
 			i += 1
 		} else {
-			fmt.Println("Already chose this one.")
+			b.ui.Println("Already chose this one.")
 		}
 	}
 	for k := range toDestroy {
 		id, isPassive := items[k].getId(), items[k].isPassive()
 		idx, _ := p.getItemIndex(id, isPassive)
-		b.discard(p.popItemByIndex(idx, isPassive))
+		if c, err := p.popItemByIndex(idx, isPassive); err == nil {
+			b.discard(c)
+		}
 	}
-	var owners map[uint16]*player
-	items, owners = b.getAllItems(false, p)
-	showItems(items, 0)
-	fmt.Println("Which to steal?")
-	ans := uint8(readInput(0, len(items)-1))
+	b.ui.showItems(others, 0)
+	b.ui.Println("Which to steal?")
+	ans := uint8(b.ui.readInput(0, len(others)-1))
+	id, isPassive := others[ans].getId(), others[ans].isPassive()
 	return func(roll uint8) {
-		id, isPassive := items[ans].getId(), items[ans].isPassive()
-		p.stealItem(id, isPassive, owners[id])
+		if owner, ok := owners[id]; ok {
+			p.stealItem(id, isPassive, owner)
+		}
 	}, false, nil
 }
 
@@ -530,9 +505,9 @@ func curseOfTheTowerFunc(p *player, b *Board, tCard card, en *eventNode) (cardEf
 				}
 			} else {
 				monsters := b.monster.getActiveMonsters()
-				showMonsterCards(monsters, 0)
-				fmt.Println("Who to damage?")
-				ans := readInput(0, len(monsters)-1)
+				b.ui.showMonsterCards(monsters, 0)
+				b.ui.Println("Who to damage?")
+				ans := b.ui.readInput(0, len(monsters)-1)
 				b.damagePlayerToMonster(p, monsters[ans], 1, 0)
 			}
 		}
@@ -544,8 +519,10 @@ func curseOfTheTowerFunc(p *player, b *Board, tCard card, en *eventNode) (cardEf
 // Before a dice roll is rolled, say a number.
 // If the next dice result is the number said, loot 3.
 func crystalBallFunc(p *player, b *Board, tCard card) (cardEffect, bool, error) {
-	fmt.Println("Guess a dice roll:")
-	ans := uint8(readInput(1, 6))
+	done := b.ui.privately(p) // The guess stays secret until the dice are rolled
+	b.ui.Println("Guess a dice roll:")
+	ans := uint8(b.ui.readInput(1, 6))
+	done()
 	return func(roll uint8) { b.treasure.crystalBallGuess[p] = ans }, false, nil
 }
 
@@ -556,7 +533,11 @@ func daddyHauntFunc(p *player, b *Board, tCard card, en *eventNode) (cardEffect,
 	var f cardEffect
 	var err error
 	if _, err = en.checkDamageToPlayer(p.Character.id); err == nil && !p.isDead() {
-		f = func(roll uint8) { p.decreaseHP(1) }
+		f = func(roll uint8) {
+			if p.decreaseHP(1); p.isDead() {
+				b.pushDeath(p)
+			}
+		}
 	}
 	return f, false, err
 }
@@ -567,8 +548,8 @@ func dadsLostCoinFunc(p *player, b *Board, tCard card, en *eventNode) (cardEffec
 	var f cardEffect
 	var err error
 	if err = en.checkDiceRoll(1); err == nil {
-		fmt.Println("1) Reroll the roll of 1\n2) Do nothing")
-		if uint8(readInput(1, 2)) == 1 {
+		b.ui.menu(1, "Reroll the roll of 1", "Do nothing")
+		if uint8(b.ui.readChoice(2, 1, 2)) == 1 {
 			f = func(roll uint8) { b.rollDiceAndPush() }
 		}
 	}
@@ -601,17 +582,17 @@ func deadBirdFunc(p *player, b *Board, tCard card, en *eventNode) (cardEffect, b
 	var f cardEffect
 	var err error
 	if err = en.checkDiceRoll(3); err == nil {
-		fmt.Println("1) Steal a value from their hand\n2) Do nothing.")
-		if readInput(1, 2) == 1 {
+		b.ui.menu(1, "Steal a value from their hand", "Do nothing.")
+		if b.ui.readChoice(2, 1, 2) == 1 {
 			f = func(roll uint8) {
 				target := en.event.p
 				hand := target.Hand
 				l := len(hand)
 				var i uint8
 				if l > 0 {
-					showLootCards(hand, target.Character.name, 0)
+					b.ui.showHand(target, 0)
 					if l > 1 {
-						i = uint8(readInput(0, l-1))
+						i = uint8(b.ui.readInput(0, l-1))
 					}
 					p.Hand = append(p.Hand, target.popHandCard(i))
 				}
@@ -628,34 +609,38 @@ func decoyFunc(p *player, b *Board, tCard card) (cardEffect, bool, error) {
 	others := b.getOtherPlayers(p, false)
 	l := len(others)
 	if l > 1 {
-		showPlayers(others, 0)
-		i = uint8(readInput(0, l-1))
+		b.ui.showPlayers(others, 0)
+		i = uint8(b.ui.readInput(0, l-1))
 	}
 	p2 := others[i]
 	al := len(p2.ActiveItems)
-	showTreasureCards(p2.ActiveItems, p2.Character.name, 0)
-	showTreasureCards(p2.PassiveItems, p2.Character.name, al)
-	ans := readInput(0, al+len(p2.PassiveItems)-1)
+	if al+len(p2.PassiveItems) == 0 {
+		return nil, false, errors.New("no items to swap with")
+	}
+	b.ui.showTreasureCards(p2.ActiveItems, p2.Character.name, 0)
+	b.ui.showTreasureCards(p2.PassiveItems, p2.Character.name, al)
+	ans := b.ui.readInput(0, al+len(p2.PassiveItems)-1)
 	var f cardEffect = func(roll uint8) {
 		idx, _ := p.getItemIndex(tCard.getId(), false)
-		dCard := p.popActiveItem(idx)
+		var c card
+		var err error
 		if ans < al {
-			p2.addCardToBoard(dCard)
-			p.addCardToBoard(p2.popActiveItem(uint8(ans)))
+			c, err = p2.popActiveItem(uint8(ans))
 		} else {
-			p2.addCardToBoard(dCard)
-			p.addCardToBoard(p2.popPassiveItem(uint8(ans - al)))
+			c, err = p2.popPassiveItem(uint8(ans - al))
+		}
+		if err == nil {
+			if dCard, err := p.popActiveItem(idx); err == nil {
+				p2.addCardToBoard(dCard)
+				p.addCardToBoard(c)
+			} else {
+				p2.addCardToBoard(c)
+			}
 		}
 	}
 	return f, false, nil
 }
 
-// Constant Passive Item
-// +1 HP
-func dinnerFunc(p *player, b *Board, c card, leavingField bool) {
-	modifyHealth(p, 1, leavingField)
-}
-
 // Active Item
 // This becomes a copy of any non-eternal passive *treasureCard in play
 // till the end of the turn.
@@ -666,17 +651,21 @@ func diplopiaFunc(p *player, b *Board, tCard card) (cardEffect, bool, error) {
 	if l == 0 {
 		return nil, false, errors.New("no passives to copy")
 	} else if l > 1 {
-		showTreasureCards(passives, "board", 0)
-		i = uint8(readInput(0, l-1))
+		b.ui.showTreasureCards(passives, "board", 0)
+		i = uint8(b.ui.readInput(0, l-1))
 	}
 	toCopy := passives[i]
 	var f cardEffect = func(roll uint8) {
 		j, err := p.getItemIndex(tCard.getId(), false)
 		if err == nil {
-			p.popPassiveItem(j)
-			p.addCardToBoard(toCopy)
+			_, _ = p.popActiveItem(j)
+			if tc, ok := toCopy.(*treasureCard); ok { // A copy, not the item itself
+				_ = p.addCardToBoard(*tc)
+			} else {
+				_ = p.addCardToBoard(toCopy)
+			}
 		}
-		p.activeEffects[diplopia] = struct{}{}
+		p.effects.add(effect{id: diplopia, owner: p.Character.id, source: tCard, target: toCopy, d: untilEndOfTurn})
 	}
 	return f, false, nil
 }
@@ -691,9 +680,9 @@ func donationMachineFunc(p *player, b *Board, tCard card) (cardEffect, bool, err
 	if l == 1 && items[0].getId() == tcId {
 		return nil, false, errors.New("no other item to give away")
 	}
-	showItems(items, 0)
-	fmt.Println("Choose an item to give away.")
-	ans := uint8(readInput(0, l-1))
+	b.ui.showItems(items, 0)
+	b.ui.Println("Choose an item to give away.")
+	ans := uint8(b.ui.readInput(0, l-1))
 	item := items[ans]
 	if item.getId() == tcId {
 		return nil, false, errors.New("cannot give away donation machine value")
@@ -702,10 +691,10 @@ func donationMachineFunc(p *player, b *Board, tCard card) (cardEffect, bool, err
 	var i uint8
 	l = len(others)
 	if l > 1 {
-		showPlayers(others, 0)
-		i = uint8(readInput(0, l-1))
+		b.ui.showPlayers(others, 0)
+		i = uint8(b.ui.readInput(0, l-1))
 	}
-	others[i].stealItem(tcId, false, p)
+	others[i].stealItem(item.getId(), item.isPassive(), p)
 	return func(roll uint8) { p.gainCents(8) }, false, nil
 }
 
@@ -734,24 +723,6 @@ func edensBlessingFunc(p *player, b *Board, tCard card, en *eventNode) (cardEffe
 	return f, false, err
 }
 
-// Constant with conditions passive item
-// If you have 0 loot cards in your hand, you gain +1 Attack
-// If you have 0 cents, you gain +1 to all attack rolls
-//
-// The first effect is resolved at the battle step.
-// The second effect is resolved at the rolling dice step
-func emptyVesselChecker(p *player, raiseAttack bool) bool {
-	var b bool
-	if _, err := p.getItemIndex(emptyVessel, true); err == nil {
-		if raiseAttack && len(p.Hand) == 0 { // Raise Character's attack
-			b = true
-		} else if !raiseAttack && p.Pennies == 0 { // Add 1 to the dice roll
-			b = true
-		}
-	}
-	return b
-}
-
 // Event based passive item
 // When anyone rolls a 5, gain 3 cents.
 func eyeOfGreedFunc(p *player, b *Board, tCard card, en *eventNode) (cardEffect, bool, error) {
@@ -783,27 +754,33 @@ func fingerFunc(p *player, b *Board, tCard card, en *eventNode) (cardEffect, boo
 	if err = en.checkDiceRoll(2); err == nil {
 		target := en.event.p
 		items := target.getAllItems(false)
-		showItems(items, 0)
-		fmt.Println("1) Swap an item with the player who rolled the dice.\n2) Do nothing.")
-		if readInput(1, 2) == 1 {
+		if len(items) == 0 {
+			return nil, false, wrapError(ErrInvalidTarget, "%s has no item to steal", target.Character.name)
+		}
+		b.ui.showItems(items, 0)
+		b.ui.menu(1, "Swap an item with the player who rolled the dice.", "Do nothing.")
+		if b.ui.readChoice(2, 1, 2) == 1 {
 			var i uint8
 			l := len(items)
 			if l > 1 {
-				fmt.Println("Choose which item to steal.")
-				i = uint8(readInput(0, len(items)-1))
+				b.ui.Println("Choose which item to steal.")
+				i = uint8(b.ui.readInput(0, len(items)-1))
 			}
 			item := items[i]
 			f = func(roll uint8) {
 				id, isPassive := item.getId(), item.isPassive()
 				if i, err := target.getItemIndex(id, isPassive); err == nil {
-					p.addCardToBoard(target.popItemByIndex(i, isPassive))
+					if c, err := target.popItemByIndex(i, isPassive); err == nil {
+						p.addCardToBoard(c)
+					}
 					items := p.getAllItems(false)
-					showItems(items, 0)
-					fmt.Println("Which item to give up?")
-					toGive := items[uint8(readInput(0, len(items)-1))]
+					b.ui.showItems(items, 0)
+					b.ui.Println("Which item to give up?")
+					toGive := items[uint8(b.ui.readInput(0, len(items)-1))]
 					j, _ := p.getItemIndex(toGive.getId(), toGive.isPassive())
-					target.addCardToBoard(p.popItemByIndex(j, toGive.isPassive()))
-
+					if c, err := p.popItemByIndex(j, toGive.isPassive()); err == nil {
+						target.addCardToBoard(c)
+					}
 				}
 			}
 		}
@@ -816,25 +793,26 @@ func fingerFunc(p *player, b *Board, tCard card, en *eventNode) (cardEffect, boo
 // 2) Put all shop items on the bottom of the treasure deck.
 func flushFunc(p *player, b *Board, tCard card) (cardEffect, bool, error) {
 	var f cardEffect = func(roll uint8) {
-		for _, zone := range b.monster.zones {
-			monster := zone.peek()
-			if !monster.inBattle {
-				mon := zone.pop()
-				b.monster.placeInDeck(mon, false)
+		for i := range b.monster.zones {
+			if zone := &b.monster.zones[i]; !zone.isEmpty() && !zone.peek().inBattle {
+				b.monster.placeInDeck(zone.pop(), false)
 			}
 		}
 	}
 	buyItemEvents := b.eventStack.getIntentionToPurchaseEvents()
 	if len(buyItemEvents) == 0 {
-		fmt.Println("1) Put all active monsters not being attacked at the bottom of the monster deck.\n" +
-			"2) Put all shop items on the bottom of the Treasure Deck.")
-		ans := readInput(1, 2)
+		b.ui.menu(1, "Put all active monsters not being attacked at the bottom of the monster deck.",
+			"Put all shop items on the bottom of the Treasure Deck.")
+		ans := b.ui.readInput(1, 2)
 		if ans == 2 {
 			f = func(roll uint8) {
 				for i, c := range b.treasure.zones {
-					b.treasure.placeInDeck(c, false)
-					b.treasure.zones[i] = b.treasure.draw()
+					if c.id != 0 {
+						b.treasure.placeInDeck(c, false)
+						b.treasure.zones[i] = treasureCard{}
+					}
 				}
+				b.treasure.refill()
 			}
 		}
 	}
@@ -848,18 +826,16 @@ func flushFunc(p *player, b *Board, tCard card) (cardEffect, bool, error) {
 // When you take damage, recharge this.
 func foreverAloneFunc(p *player, b *Board, tCard card) (cardEffect, bool, error) {
 	var f cardEffect
-	fmt.Println("Choose one:\n" +
-		"1) Steal 1 cent from a Player.\n" +
-		"2) Look at the top value of any deck.\n" +
-		"3) Discard a Loot Card, then draw a Loot Card.")
-	ans := readInput(1, 3)
+	b.ui.Println("Choose one:")
+	b.ui.menu(1, "Steal 1 cent from a Player.", "Look at the top value of any deck.", "Discard a Loot Card, then draw a Loot Card.")
+	ans := b.ui.readInput(1, 3)
 	switch ans {
 	case 1:
 		var i int
 		players := b.getOtherPlayers(p, false)
 		if len(players) > 1 {
-			showPlayers(players, 0)
-			i = readInput(0, len(players))
+			b.ui.showPlayers(players, 0)
+			i = b.ui.readInput(0, len(players)-1)
 		}
 		if players[i].Pennies == 0 {
 			return nil, false, errors.New("target player is dirt poor")
@@ -870,28 +846,19 @@ func foreverAloneFunc(p *player, b *Board, tCard card) (cardEffect, bool, error)
 		}
 	case 2:
 		f = func(roll uint8) {
-			fmt.Println("1) Loot Deck. 2) Monster Deck. 3) Treasure Deck.")
-			ans := readInput(1, 3)
-			var c card
-			switch ans {
-			case 1:
-				c = b.loot.draw()
-			case 2:
-				c = b.monster.draw()
-			case 3:
-				c = b.treasure.draw()
-			}
-			if c != nil {
-				fmt.Println(c.showCard(0))
+			b.ui.menu(1, "Loot Deck.", "Monster Deck.", "Treasure Deck.")
+			if c, err := b.drawTopCard(b.ui.readInput(1, 3)); err == nil {
+				b.ui.Println(c.showCard(0))
 				b.placeInDeck(c, true)
 			}
 		}
 	case 3:
 		f = func(roll uint8) {
-			showLootCards(p.Hand, p.Character.name, 0)
-			fmt.Println("Discard one.")
-			ans := readInput(0, len(p.Hand)-1)
-			p.popHandCard(uint8(ans))
+			if len(p.Hand) > 0 {
+				b.ui.showHand(p, 0)
+				b.ui.Println("Discard one.")
+				b.discard(p.popHandCard(uint8(b.ui.readDiscard(len(p.Hand)))))
+			}
 			p.loot(b.loot)
 		}
 	}
@@ -906,15 +873,19 @@ func glassCannonFunc(p *player, b *Board, tCard card) (cardEffect, bool, error)
 	c := tCard.(*treasureCard)
 	return func(roll uint8) {
 		items, owner := b.getAllItems(false, nil)
-		showItems(items, 0)
-		ans := uint8(readInput(0, len(items)-1))
+		b.ui.showItems(items, 0)
+		ans := uint8(b.ui.readInput(0, len(items)-1))
 		id, isPassive := items[ans].getId(), items[ans].isPassive()
 		i, _ := owner[id].getItemIndex(id, isPassive)
-		b.discard(owner[id].popItemByIndex(i, isPassive))
+		if item, err := owner[id].popItemByIndex(i, isPassive); err == nil {
+			b.discard(item)
+		}
 		idx, e := p.getItemIndex(c.id, false)
 		if e == nil {
 			if roll != 6 {
-				b.discard(p.popActiveItem(idx))
+				if item, err := p.popActiveItem(idx); err == nil {
+					b.discard(item)
+				}
 				p.loot(b.loot)
 				p.loot(b.loot)
 			} else {
@@ -935,9 +906,9 @@ func goatHeadFunc(p *player, b *Board, tCard card, en *eventNode) (cardEffect, b
 			var numDiscarded uint8
 			for len(p.Hand) > 0 {
 				l := len(p.Hand)
-				showLootCards(p.Hand, "self", 0)
-				fmt.Println(fmt.Sprintf("%d) Stop discarding.", l))
-				ans := uint8(readInput(0, l))
+				b.ui.showHand(p, 0)
+				b.ui.menu(l, "Stop discarding.")
+				ans := uint8(b.ui.readChoice(l, 0, l))
 				if ans < uint8(l) {
 					b.discard(p.popHandCard(ans))
 					numDiscarded += 1
@@ -963,12 +934,12 @@ func godheadFunc(p *player, b *Board, tCard card) (cardEffect, bool, error) {
 	if l == 0 {
 		return nil, false, errors.New("no dice roll events on stack")
 	} else if l > 1 {
-		showEvents(rollEvents)
-		ans = uint8(readInput(0, l-1))
+		b.ui.showEvents(rollEvents)
+		ans = uint8(b.ui.readInput(0, l-1))
 	}
-	fmt.Println("1) Change the roll to 1.\n2) Change the roll to 6.")
+	b.ui.menu(1, "Change the roll to 1.", "Change the roll to 6.")
 	var n uint8 = 1
-	if readInput(1, 2) == 2 {
+	if b.ui.readInput(1, 2) == 2 {
 		n = 6
 	}
 	var f cardEffect = func(roll uint8) {
@@ -987,9 +958,9 @@ func goldenRazorBladeFunc(p *player, b *Board, tCard card) (cardEffect, bool, er
 	p.loseCents(5)
 	monsters, players := b.monster.getActiveMonsters(), b.getPlayers(true)
 	l := len(monsters)
-	showMonsterCards(monsters, 0)
-	showPlayers(players, l)
-	ans := readInput(0, l-1)
+	b.ui.showMonsterCards(monsters, 0)
+	b.ui.showPlayers(players, l)
+	ans := b.ui.readInput(0, l-1)
 	var f cardEffect
 	if ans < l {
 		f = func(roll uint8) { b.damagePlayerToMonster(p, monsters[ans], 1, 0) }
@@ -1035,16 +1006,17 @@ func guppysHeadFunc(p *player, b *Board, tCard card) (cardEffect, bool, error) {
 	l := len(others)
 	var ans uint8
 	if l > 1 {
-		showPlayers(others, 0)
-		ans = uint8(readInput(0, l-1))
+		b.ui.showPlayers(others, 0)
+		ans = uint8(b.ui.readInput(0, l-1))
 	}
 	p2 := others[ans]
 	var f cardEffect = func(roll uint8) {
-		showLootCards(p2.Hand, p2.Character.name, 0)
-		fmt.Println("Pick which value to give away.")
-		ans := uint8(readInput(0, len(p2.Hand)))
-		c := p2.popHandCard(ans)
-		p.Hand = append(p.Hand, c)
+		if len(p2.Hand) > 0 {
+			b.ui.showHand(p2, 0)
+			b.ui.Println("Pick which value to give away.")
+			ans := uint8(b.ui.readInput(0, len(p2.Hand)-1))
+			p.Hand = append(p.Hand, p2.popHandCard(ans))
+		}
 	}
 	return f, false, nil
 }
@@ -1053,17 +1025,18 @@ func guppysHeadFunc(p *player, b *Board, tCard card) (cardEffect, bool, error) {
 // Take 1 damage. Prevent up to two damage to a player.
 func guppysPawFunc(p *player, b *Board, tCard card) (cardEffect, bool, error) {
 	return func(roll uint8) {
-		if checkActiveEffects(p.activeEffects, guppysPaw, true) {
+		if p.useEffect(guppysPaw) {
 			dEvents := b.eventStack.getDamageOfCharacterEvents()
 			l := len(dEvents)
 			var ans uint8
 			if l != 0 {
 				if l > 1 {
-					showEvents(dEvents)
-					ans = uint8(readInput(0, l-1))
+					b.ui.showEvents(dEvents)
+					ans = uint8(b.ui.readInput(0, l-1))
 				}
-				fmt.Println("Prevent how much damage?\n1) 1.\n2) 2.")
-				n := uint8(readInput(1, 2))
+				b.ui.Println("Prevent how much damage?")
+				b.ui.menu(1, "1.", "2.")
+				n := uint8(b.ui.readInput(1, 2))
 				b.eventStack.preventDamage(n, dEvents[ans])
 			}
 		}
@@ -1089,8 +1062,8 @@ func hostHatFunc(p *player, b *Board, tCard card) (cardEffect, bool, error) {
 	if l == 0 {
 		return nil, false, errors.New("no damage events targeting self")
 	} else if l > 1 {
-		showEvents(valid)
-		ans = uint8(readInput(0, l-1))
+		b.ui.showEvents(valid)
+		ans = uint8(b.ui.readInput(0, l-1))
 	}
 	damageNode := valid[ans]
 	var f cardEffect = func(roll uint8) {
@@ -1101,10 +1074,10 @@ func hostHatFunc(p *player, b *Board, tCard card) (cardEffect, bool, error) {
 			l := len(players)
 			if l > 0 {
 				if l > 1 {
-					showPlayers(players, 0)
-					ans = uint8(readInput(0, l-1))
+					b.ui.showPlayers(players, 0)
+					ans = uint8(b.ui.readInput(0, l-1))
 				}
-				b.eventStack.push(event{p: players[ans], e: damageEvent{n: 1}})
+				b.damagePlayerToPlayer(p, players[ans], 1)
 			}
 		}
 
@@ -1116,10 +1089,10 @@ func hostHatFunc(p *player, b *Board, tCard card) (cardEffect, bool, error) {
 // Active Item
 // Look at a player's hand. You may switch a card from your hand with one of theirs.
 func incubusFunc(p *player, b *Board, tCard card) (cardEffect, bool, error) {
-	fmt.Println("Choose One:\n" +
-		"1) Look at a Player's Hand, you may switch a value from your hand with one of theirs.\n" +
-		"2) Loot 1, then place a value from your hand on top of the loot deck.")
-	ans := readInput(1, 2)
+	b.ui.Println("Choose One:")
+	b.ui.menu(1, "Look at a Player's Hand, you may switch a value from your hand with one of theirs.",
+		"Loot 1, then place a value from your hand on top of the loot deck.")
+	ans := b.ui.readInput(1, 2)
 	var f cardEffect
 	switch ans {
 	case 1:
@@ -1127,8 +1100,8 @@ func incubusFunc(p *player, b *Board, tCard card) (cardEffect, bool, error) {
 		l := len(players)
 		var playerIdx uint8
 		if l > 1 {
-			showPlayers(players, 0)
-			playerIdx = uint8(readInput(0, l-1))
+			b.ui.showPlayers(players, 0)
+			playerIdx = uint8(b.ui.readInput(0, l-1))
 		}
 		p2 := players[playerIdx]
 		f = b.incubus(p, p2)
@@ -1141,14 +1114,13 @@ func incubusFunc(p *player, b *Board, tCard card) (cardEffect, bool, error) {
 // Hybrid passive item
 // +1 Attack
 // Each time you roll a 6 while attacking, deal 1 damage to all other players
-func ipecacFuncConstant(p *player, b *Board, tCard card, isLeaving bool) {
-	modifyAttack(p, 1, isLeaving)
-}
-
 func ipecacFuncEvent(p *player, b *Board, tCard card, en *eventNode) (cardEffect, bool, error) {
 	var f cardEffect
 	var err error
-	_, isAttack := b.eventStack.peek().event.e.(declareAttackEvent)
+	var isAttack bool
+	if !b.eventStack.isEmpty() {
+		_, isAttack = b.eventStack.peek().event.e.(declareAttackEvent)
+	}
 	if err = en.checkDiceRoll(6); err == nil && isAttack {
 		f = func(roll uint8) {
 			for _, o := range b.getOtherPlayers(p, true) {
@@ -1166,8 +1138,8 @@ func jawboneFunc(p *player, b *Board, tCard card) (cardEffect, bool, error) {
 	l := len(others)
 	var ans uint8
 	if l > 1 {
-		showPlayers(others, 0)
-		ans = uint8(readInput(0, l-1))
+		b.ui.showPlayers(others, 0)
+		ans = uint8(b.ui.readInput(0, l-1))
 	}
 	p2 := others[ans]
 	if p2.Pennies == 0 {
@@ -1184,6 +1156,15 @@ func jawboneFunc(p *player, b *Board, tCard card) (cardEffect, bool, error) {
 	return f, false, nil
 }
 
+// Starting Item
+// Cancel an attack on a monster. That player may attack again this turn.
+func lordOfThePitFunc(p *player, b *Board, tCard card) (cardEffect, bool, error) {
+	if b.combat == nil && len(b.eventStack.getIntentionToAttackEvents()) == 0 {
+		return nil, false, wrapError(ErrNoEvent, "there is no attack to cancel")
+	}
+	return func(roll uint8) { _ = b.cancelAttack(true) }, false, nil
+}
+
 // Active Item
 // Add up to two to any non-attack roll.
 func luckyFootFunc(p *player, b *Board, tCard card) (cardEffect, bool, error) {
@@ -1194,12 +1175,12 @@ func luckyFootFunc(p *player, b *Board, tCard card) (cardEffect, bool, error) {
 	}
 	var i uint8
 	if l > 1 {
-		showEvents(rolls)
-		i = uint8(readInput(0, l-1))
+		b.ui.showEvents(rolls)
+		i = uint8(b.ui.readInput(0, l-1))
 	}
-	fmt.Println("1) Add 1.\n2) Add 2.")
-	n := int8(readInput(1, 2))
-	return func(roll uint8) { b.eventStack.addToDiceRoll(n, rolls[i]) }, false, nil
+	b.ui.menu(1, "Add 1.", "Add 2.")
+	n := int8(b.ui.readInput(1, 2))
+	return func(roll uint8) { _ = b.eventStack.addToDiceRoll(n, rolls[i]) }, false, nil
 }
 
 // Constant passive item
@@ -1222,12 +1203,12 @@ func miniMushFunc(p *player, b *Board, tCard card) (cardEffect, bool, error) {
 	}
 	var ans uint8
 	if l > 1 {
-		showEvents(rolls)
-		ans = uint8(readInput(0, l-1))
+		b.ui.showEvents(rolls)
+		ans = uint8(b.ui.readInput(0, l-1))
 	}
-	fmt.Println("1) Subtract 1.\n2) Subtract 2.")
-	n := int8(readInput(1, 2)) * -1
-	return func(roll uint8) { b.eventStack.addToDiceRoll(n, rolls[ans]) }, false, nil
+	b.ui.menu(1, "Subtract 1.", "Subtract 2.")
+	n := int8(b.ui.readInput(1, 2)) * -1
+	return func(roll uint8) { _ = b.eventStack.addToDiceRoll(n, rolls[ans]) }, false, nil
 }
 
 // Active Item
@@ -1235,9 +1216,12 @@ func miniMushFunc(p *player, b *Board, tCard card) (cardEffect, bool, error) {
 // This change is permanent.
 func modelingClayFunc(p *player, b *Board, tCard card) (cardEffect, bool, error) {
 	items, owners := b.getAllItems(false, nil)
-	showItems(items, 0)
-	fmt.Println("Which value to copy?")
-	ans := uint8(readInput(0, len(items)-1))
+	if len(items) == 0 {
+		return nil, false, wrapError(ErrIllegalAction, "no item to copy")
+	}
+	b.ui.showItems(items, 0)
+	b.ui.Println("Which value to copy?")
+	ans := uint8(b.ui.readInput(0, len(items)-1))
 	id, isPassive := items[ans].getId(), items[ans].isPassive()
 	var owner *player = owners[id]
 	return func(roll uint8) {
@@ -1246,13 +1230,18 @@ func modelingClayFunc(p *player, b *Board, tCard card) (cardEffect, bool, error)
 			var i uint8
 			i, err := owner.getItemIndex(id, isPassive)
 			if err == nil {
-				clayCard := p.popItemByIndex(clayIdx, false)
+				var c treasureCard
+				var pc passiveItem
+				if !isPassive { // copy before the clay leaves, the owner may be the same player
+					c = owner.ActiveItems[i]
+				} else {
+					pc = owner.PassiveItems[i]
+				}
+				clayCard, _ := p.popItemByIndex(clayIdx, false)
 				if !isPassive {
-					c := owner.ActiveItems[i]
 					c.id = clayCard.getId()
 					p.addCardToBoard(&c)
 				} else {
-					pc := owner.PassiveItems[i]
 					switch pc.(type) {
 					case *treasureCard:
 						card := pc.(*treasureCard)
@@ -1264,7 +1253,7 @@ func modelingClayFunc(p *player, b *Board, tCard card) (cardEffect, bool, error)
 						card.id = clayCard.getId()
 						p.addCardToBoard(card)
 					default:
-						panic("need treasure value pointer or loot value")
+						p.addCardToBoard(clayCard)
 					}
 				}
 			}
@@ -1282,11 +1271,16 @@ func momsBraFunc(p *player, b *Board, tCard card) (cardEffect, bool, error) {
 	}
 	var ans uint8
 	if l > 1 {
-		showEvents(dNodes)
-		ans = uint8(readInput(0, l-1))
+		b.ui.showEvents(dNodes)
+		ans = uint8(b.ui.readInput(0, l-1))
 	}
 	d := dNodes[ans]
-	return func(roll uint8) { d.event = event{p: d.event.p, e: damageEvent{n: 1}} }, false, nil
+	return func(roll uint8) {
+		if de, ok := d.event.e.(damageEvent); ok { // keep who is being hit
+			de.n = 1
+			d.event.e = de
+		}
+	}, false, nil
 }
 
 // Event based passive
@@ -1295,13 +1289,11 @@ func momsBoxFunc(p *player, b *Board, tCard card, en *eventNode) (cardEffect, bo
 	var f cardEffect
 	var err error
 	if err = en.checkDiceRoll(4); err == nil {
-		fmt.Println("1) Loot 1 then discard 1.\n2) Do Nothing")
-		if readInput(1, 2) == 1 {
+		b.ui.menu(1, "Loot 1 then discard 1.", "Do Nothing")
+		if b.ui.readChoice(2, 1, 2) == 1 {
 			f = func(roll uint8) {
 				p.loot(b.loot)
-				showLootCards(p.Hand, "self", 0)
-				fmt.Println("Discard which value?")
-				b.discard(p.popHandCard(uint8(readInput(0, len(p.Hand)-1))))
+				p.discardHandChoiceHelper(b.loot, 1)
 			}
 		}
 	}
@@ -1325,8 +1317,8 @@ func momsRazorFunc(p *player, b *Board, tCard card, en *eventNode) (cardEffect,
 	var f cardEffect
 	var err error
 	if err = en.checkDiceRoll(6); err == nil {
-		fmt.Println("1) Deal one damage\n2) Do Nothing")
-		if readInput(1, 2) == 1 {
+		b.ui.menu(1, "Deal one damage", "Do Nothing")
+		if b.ui.readChoice(2, 1, 2) == 1 {
 			f = func(roll uint8) { b.damagePlayerToPlayer(p, en.event.p, 1) }
 		}
 	}
@@ -1341,24 +1333,26 @@ func momsShovelFunc(p *player, b *Board, tCard card) (cardEffect, bool, error) {
 	if err != nil {
 		return nil, false, err
 	}
-	b.discard(p.popActiveItem(idx))
+	if c, err := p.popActiveItem(idx); err == nil {
+		b.discard(c)
+	}
 	var f cardEffect = func(roll uint8) {
 		others := b.getOtherPlayers(p, false)
 		var i uint8
 		l := len(others)
 		if l > 1 {
-			showPlayers(others, 0)
-			i = uint8(readInput(0, l-1))
+			b.ui.showPlayers(others, 0)
+			i = uint8(b.ui.readInput(0, l-1))
 		}
 		p2 := others[i]
 		l = len(p2.Souls)
 		if l > 0 {
 			var j uint8
 			if l > 1 {
-				showSouls(p2.Souls, p2.Character.name, 0)
-				j = uint8(readInput(0, l-1))
+				b.ui.showSouls(p2.Souls, p2.Character.name, 0)
+				j = uint8(b.ui.readInput(0, l-1))
 			}
-			p.addSoulToBoard(p2.popSoul(j))
+			_, _ = p.stealSoul(p2, j)
 		}
 	}
 	return f, false, nil
@@ -1368,14 +1362,18 @@ func momsShovelFunc(p *player, b *Board, tCard card) (cardEffect, bool, error) {
 // Force the active player to attack. You choose what they attack.
 func monsterManualFunc(p *player, b *Board, tCard card) (cardEffect, bool, error) {
 	ap := &b.players[b.api]
-	if ap.inBattle {
+	if ap.inBattle || b.combat != nil {
 		return nil, false, errors.New("active player already in battle")
 	}
 	monsters := b.monster.getActiveMonsters()
-	showMonsterCards(monsters, 0)
-	ans := readInput(0, len(monsters)-1)
+	b.ui.showMonsterCards(monsters, 0)
+	ans := b.ui.readInput(0, len(monsters)-1)
 	m := monsters[ans]
-	return func(roll uint8) { m.inBattle, ap.inBattle = true, true }, false, nil
+	return func(roll uint8) {
+		if b.combat == nil && !ap.isDead() {
+			b.startCombat(ap, m)
+		}
+	}, false, nil
 }
 
 // Event based passive item
@@ -1385,21 +1383,23 @@ func monstrosToothFunc(p *player, b *Board, tCard card, en *eventNode) (cardEffe
 	var f cardEffect
 	var err error
 	if err = en.checkStartOfTurn(p); err == nil {
-		randIdx := uint8(rand.Intn(len(b.players)))
+		randIdx := uint8(b.rng.Intn(len(b.players)))
 		target := &b.players[randIdx]
 		f = func(roll uint8) {
 			items := target.getAllItems(false)
 			l := len(items)
 			var i uint8
-			if l > 0 {
-				if l > 1 {
-					showItems(items, 0)
-					i = uint8(readInput(0, l-1))
-				}
+			if l == 0 {
+				return
+			} else if l > 1 {
+				b.ui.showItems(items, 0)
+				i = uint8(b.ui.readInput(0, l-1))
 			}
 			item := items[i]
-			i, _ = p.getItemIndex(item.getId(), item.isPassive())
-			b.discard(p.popItemByIndex(i, item.isPassive()))
+			i, _ = target.getItemIndex(item.getId(), item.isPassive())
+			if c, err := target.popItemByIndex(i, item.isPassive()); err == nil {
+				b.discard(c)
+			}
 		}
 	}
 	return f, false, err
@@ -1409,8 +1409,8 @@ func monstrosToothFunc(p *player, b *Board, tCard card, en *eventNode) (cardEffe
 // Deal 1 damage to a monster.
 func mrBoomFunc(p *player, b *Board, tCard card) (cardEffect, bool, error) {
 	monsters := b.monster.getActiveMonsters()
-	showMonsterCards(monsters, 0)
-	ans := readInput(0, len(monsters)-1)
+	b.ui.showMonsterCards(monsters, 0)
+	ans := b.ui.readInput(0, len(monsters)-1)
 	m := monsters[ans]
 	return func(roll uint8) { b.damagePlayerToMonster(p, m, 1, 0) }, false, nil
 }
@@ -1438,8 +1438,8 @@ func noFunc(p *player, b *Board, tCard card) (cardEffect, bool, error) {
 	}
 	var i uint8
 	if l > 1 {
-		showEvents(activeItemEvents)
-		i = uint8(readInput(0, l-1))
+		b.ui.showEvents(activeItemEvents)
+		i = uint8(b.ui.readInput(0, l-1))
 	}
 	node := activeItemEvents[i]
 	return func(roll uint8) { _ = b.eventStack.fizzle(node) }, false, nil
@@ -1451,7 +1451,9 @@ func noFunc(p *player, b *Board, tCard card) (cardEffect, bool, error) {
 // 4: Loot 3. 5: Gain 9 cents. 6: This becomes a Soul. Gain it.
 func pandorasBoxFunc(p *player, b *Board, tCard card) (cardEffect, bool, error) {
 	idx, _ := p.getItemIndex(tCard.getId(), false)
-	b.discard(p.popActiveItem(idx))
+	if c, err := p.popActiveItem(idx); err == nil {
+		b.discard(c)
+	}
 	var f cardEffect = func(roll uint8) {
 		switch roll {
 		case 1:
@@ -1460,9 +1462,9 @@ func pandorasBoxFunc(p *player, b *Board, tCard card) (cardEffect, bool, error)
 			p.gainCents(6)
 		case 3:
 			monsters := b.monster.getActiveMonsters()
-			showMonsterCards(monsters, 0)
-			fmt.Println("Kill which monster?")
-			ans := uint8(readInput(0, len(monsters)-1))
+			b.ui.showMonsterCards(monsters, 0)
+			b.ui.Println("Kill which monster?")
+			ans := uint8(b.ui.readInput(0, len(monsters)-1))
 			b.killMonster(p, monsters[ans].id)
 		case 4:
 			for i := 0; i < 3; i++ {
@@ -1472,7 +1474,7 @@ func pandorasBoxFunc(p *player, b *Board, tCard card) (cardEffect, bool, error)
 			p.gainCents(9)
 		case 6:
 			if card, err := b.treasure.discardPile.popById(tCard.getId()); err == nil {
-				p.addSoulToBoard(card)
+				p.gainSoul(card)
 			}
 		}
 	}
@@ -1486,11 +1488,14 @@ func payToPlayFunc(p *player, b *Board, tCard card) (cardEffect, bool, error) {
 	if p.Pennies < 10 {
 		return nil, false, errors.New("not enough pennies to pay cost")
 	}
-	p.loseCents(10)
 	items, owners := b.getAllItems(false, p)
-	showItems(items, 0)
-	fmt.Println("Choose an item to steal.")
-	ans := uint8(readInput(0, len(items)-1))
+	if len(items) == 0 {
+		return nil, false, wrapError(ErrIllegalAction, "no item to steal")
+	}
+	p.loseCents(10)
+	b.ui.showItems(items, 0)
+	b.ui.Println("Choose an item to steal.")
+	ans := uint8(b.ui.readInput(0, len(items)-1))
 	id, isPassive := items[ans].getId(), items[ans].isPassive()
 	owner := owners[id]
 	return func(roll uint8) { p.stealItem(id, isPassive, owner) }, false, nil
@@ -1500,17 +1505,18 @@ func payToPlayFunc(p *player, b *Board, tCard card) (cardEffect, bool, error) {
 // Copy the activated effect of any non-eternal item in play.
 func placeboFunc(p *player, b *Board, tCard card) (cardEffect, bool, error) {
 	active := make([]*treasureCard, 0)
-	others := b.getOtherPlayers(p, false)
-	for _, o := range others {
-		active = append(active, o.getActiveItems(false)...)
+	for _, o := range append(b.getOtherPlayers(p, false), p) {
+		for _, tc := range o.getActiveItems(false) {
+			if tc.id != placebo && !tc.eternal { // copying itself would copy again forever
+				active = append(active, tc)
+			}
+		}
 	}
-	active = append(active, p.getActiveItems(false)...)
-	l := len(active)
-	if l == 1 && active[0].id == placebo {
+	if len(active) == 0 {
 		return nil, false, errors.New("no new effects to copy")
 	}
-	showTreasureCards(active, "board", 0)
-	ans := readInput(0, len(active)-1)
+	b.ui.showTreasureCards(active, "board", 0)
+	ans := b.ui.readInput(0, len(active)-1)
 	var f cardEffect = func(roll uint8) {
 		tempTc := treasureCard{baseCard: active[ans].baseCard, paid: active[ans].paid, active: true, f: active[ans].f}
 		tempTc.id = placebo
@@ -1520,19 +1526,6 @@ func placeboFunc(p *player, b *Board, tCard card) (cardEffect, bool, error) {
 	return f, false, nil
 }
 
-// Constant passive item
-// You may play an additional loot card on your turn.
-// Gain +1 Attack for the first attack roll of your turn
-func polydactylyFunc(p *player, b *Board, tCard card, isLeaving bool) {
-	if !isLeaving {
-		p.numLootPlayed += 1
-		p.activeEffects[polydactyly] = struct{}{}
-	} else {
-		p.numLootPlayed -= 1
-		delete(p.activeEffects, polydactyly)
-	}
-}
-
 // Paid item
 // Pay 3 cents:
 // Roll:
@@ -1541,6 +1534,7 @@ func portableSlotMachineFunc(p *player, b *Board, tCard card) (cardEffect, bool,
 	if p.Pennies < 3 {
 		return nil, false, errors.New("not enough cents to pay cost")
 	}
+	p.loseCents(3)
 	return func(roll uint8) {
 		if roll == 1 || roll == 2 {
 			p.loot(b.loot)
@@ -1553,7 +1547,13 @@ func portableSlotMachineFunc(p *player, b *Board, tCard card) (cardEffect, bool,
 // Active Item
 // Put the top cards of all decks into their discard piles.
 func potatoPeelerFunc(p *player, b *Board, tCard card) (cardEffect, bool, error) {
-	return func(roll uint8) { b.discard(b.loot.draw()); b.discard(b.monster.draw()); b.discard(b.treasure.draw()) }, false, nil
+	return func(roll uint8) {
+		for deckChoice := 1; deckChoice <= 3; deckChoice++ {
+			if c, err := b.drawTopCard(deckChoice); err == nil {
+				b.discard(c)
+			}
+		}
+	}, false, nil
 }
 
 // Active Item
@@ -1561,10 +1561,13 @@ func potatoPeelerFunc(p *player, b *Board, tCard card) (cardEffect, bool, error)
 func razorBladeFunc(p *player, b *Board, tCard card) (cardEffect, bool, error) {
 	players := b.getOtherPlayers(p, true)
 	l := len(players)
+	if l == 0 {
+		return nil, false, wrapError(ErrInvalidTarget, "no other player to damage")
+	}
 	var i uint8
 	if l > 1 {
-		showPlayers(players, 0)
-		i = uint8(readInput(0, l-1))
+		b.ui.showPlayers(players, 0)
+		i = uint8(b.ui.readInput(0, l-1))
 	}
 	target := players[i]
 	var f cardEffect = func(roll uint8) {
@@ -1591,7 +1594,7 @@ func remoteDetonatorFunc(p *player, b *Board, tCard card) (cardEffect, bool, err
 		sort.Slice(sorted, func(i, j int) bool {
 			return sorted[i].numVotes > sorted[j].numVotes
 		})
-		if sorted[0].numVotes > sorted[1].numVotes {
+		if len(sorted) == 1 || (len(sorted) > 1 && sorted[0].numVotes > sorted[1].numVotes) { // a tie destroys nothing
 			id, isPassive := sorted[0].cardId, cardTypes[sorted[0].cardId]
 			owner := owners[id]
 			j, err := owner.getItemIndex(id, isPassive)
@@ -1610,26 +1613,19 @@ func restockFunc(p *player, b *Board, tCard card, en *eventNode) (cardEffect, bo
 	var err error
 	if err = en.checkStartOfTurn(p); err == nil {
 		f = func(roll uint8) {
-			fmt.Println("1) Change the shop\n2) Don't")
-			if readInput(1, 2) == 1 {
-				numZones := len(b.treasure.zones)
-				for len(b.treasure.zones) > 0 {
-					l := len(b.treasure.zones)
-					showTreasureCards(b.treasure.zones, "shop", 0)
-					fmt.Println(fmt.Scanf("%d) Stop discarding."))
-					i := readInput(0, l)
-					if i < l {
-						b.discard(b.treasure.zones[i])
-						copy(b.treasure.zones[i:], b.treasure.zones[i+1:])
-						b.treasure.zones[l-1] = treasureCard{}
-						b.treasure.zones = b.treasure.zones[:l-1]
-					} else {
+			b.ui.menu(1, "Change the shop", "Don't")
+			if b.ui.readChoice(2, 1, 2) == 1 {
+				l := len(b.treasure.zones)
+				for {
+					b.ui.showTreasureCards(b.treasure.zones, "shop", 0)
+					b.ui.menu(l, "Stop discarding.")
+					i := b.ui.readChoice(l, 0, l)
+					if i == l {
 						break
 					}
+					b.treasure.discardZone(uint8(i))
 				}
-				for len(b.treasure.zones) < numZones {
-					b.treasure.zones = append(b.treasure.zones, b.treasure.draw())
-				}
+				b.treasure.refill()
 			}
 		}
 	}
@@ -1640,23 +1636,16 @@ func restockFunc(p *player, b *Board, tCard card, en *eventNode) (cardEffect, bo
 // Look at the top card of any deck.
 // You may put that card on the bottom of that deck.
 func sackHeadFunc(p *player, b *Board, tCard card) (cardEffect, bool, error) {
-	fmt.Println("1) Loot Deck\n2) Monster Deck\n3) Treasure Deck")
-	ans := readInput(1, 3)
+	b.ui.menu(1, "Loot Deck", "Monster Deck", "Treasure Deck")
+	ans := b.ui.readInput(1, 3)
 	var f cardEffect = func(roll uint8) {
-		var c card
-		switch ans {
-		case 1:
-			c = b.loot.draw()
-		case 2:
-			c = b.monster.draw()
-		case 3:
-			c = b.treasure.draw()
-		default:
-			panic("input error")
+		c, err := b.drawTopCard(ans)
+		if err != nil {
+			return
 		}
-		c.showCard(0)
-		fmt.Println("1) Place on Bottom. 2) Do nothing.")
-		ans = readInput(1, 2)
+		b.ui.Print(c.showCard(0))
+		b.ui.menu(1, "Place on Bottom.", "Do nothing.")
+		ans = b.ui.readChoice(2, 1, 2)
 		if ans == 1 {
 			b.placeInDeck(c, false)
 		} else {
@@ -1679,8 +1668,8 @@ func sacredHeartFunc(p *player, b *Board, tCard card, en *eventNode) (cardEffect
 	var f cardEffect
 	var err error
 	if err = en.checkDiceRoll(1); err == nil && en.event.p.Character.id == p.Character.id {
-		fmt.Println("1) Change 1 to 6\n2) Do not.")
-		if readInput(1, 2) == 1 {
+		b.ui.menu(1, "Change 1 to 6", "Do not.")
+		if b.ui.readChoice(2, 1, 2) == 1 {
 			f = func(roll uint8) { en.event.e = diceRollEvent{n: 6} }
 		}
 	}
@@ -1700,16 +1689,18 @@ func shadowFunc(p *player, b *Board) bool {
 		pItems := p.getAllItems(false)
 		l := len(pItems)
 		if l > 0 {
-			fmt.Println("Choose which item ", p.Character.name, " destroys")
-			i := uint8(readInput(0, l-1))
+			b.ui.Println("Choose which item ", p.Character.name, " destroys")
+			i := uint8(b.ui.readInput(0, l-1))
 			card, _ := p.popItem(pItems[i])
 			b.discard(card)
 		}
 		p.loseCents(1)
 		p2.gainCents(1)
-		showLootCards(p.Hand, p.Character.name, 0)
-		fmt.Println("Choose which value to discard and add to your hand.")
-		p2.Hand = append(p2.Hand, p.popHandCard(uint8(readInput(0, len(p.Hand)-1))))
+		if len(p.Hand) > 0 {
+			b.ui.showHand(p, 0)
+			b.ui.Println("Choose which value to discard and add to your hand.")
+			p2.Hand = append(p2.Hand, p.popHandCard(uint8(b.ui.readInput(0, len(p.Hand)-1))))
+		}
 	}
 	return activated
 }
@@ -1730,41 +1721,28 @@ func shinyRockFunc(p *player, b *Board, tCard card, en *eventNode) (cardEffect,
 // Look at the top 3 cards of a deck, put them back in any order.
 func sleightOfHandFunc(p *player, b *Board, tCard card) (cardEffect, bool, error) {
 	var f cardEffect = func(roll uint8) {
-		cards := make([]card, 3)
-		fmt.Println("Choose a deck:\n1) Loot Deck.\n2) Monster Deck.\n3) Treasure Deck.")
-		deckType := readInput(1, 3)
-		switch deckType {
-		case 1:
-			for i := 0; i < 3; i++ {
-				cards[i] = b.loot.draw()
+		b.ui.Println("Choose a deck:")
+		b.ui.menu(1, "Loot Deck.", "Monster Deck.", "Treasure Deck.")
+		deckType := b.ui.readInput(1, 3)
+		defer b.ui.privately(p)() // Only they see the cards
+		cards := make(deck, 0, 3)
+		for i := 0; i < 3; i++ {
+			if c, err := b.drawTopCard(deckType); err == nil {
+				cards = append(cards, c)
 			}
-			showLootCards(cards, "deck", 0)
-		case 2:
-			for i := 0; i < 3; i++ {
-				cards[i] = b.monster.draw()
-			}
-			showMonsterCards(cards, 0)
-		case 3:
-			for i := 0; i < 3; i++ {
-				cards[i] = b.treasure.draw()
-			}
-			showTreasureCards(cards, "deck", 0)
 		}
+		n := len(cards)
 		for len(cards) > 1 {
-			fmt.Println("Pick a value to go back on the top of the deck.")
-			switch deckType {
-			case 1:
-				showLootCards(cards, "deck", 0)
-			case 2:
-				showMonsterCards(cards, 0)
-			case 3:
-				showTreasureCards(cards, "deck", 0)
-			}
-			ans := readInput(0, len(cards))
+			b.ui.showDeck(cards, false)
+			b.ui.Println("Pick a card to go back on the top of the deck.")
+			ans := b.ui.readInput(0, len(cards)-1)
 			b.placeInDeck(cards[ans], true)
 			cards = append(cards[:ans], cards[ans+1:]...)
 		}
-		b.placeInDeck(cards[0], true)
+		if len(cards) == 1 {
+			b.placeInDeck(cards[0], true)
+		}
+		b.revealTop(deckZone(deckType), n, p)
 	}
 	return f, false, nil
 }
@@ -1773,27 +1751,21 @@ func sleightOfHandFunc(p *player, b *Board, tCard card) (cardEffect, bool, error
 // Look at the top card of any deck.
 // You may discard it or place it back on top.
 func smartFlyFunc(p *player, b *Board, tCard card) (cardEffect, bool, error) {
-	fmt.Println("1) Loot Deck\n2) Monster Deck\n3) Treasure Deck")
-	ans := uint8(readInput(1, 3))
+	b.ui.menu(1, "Loot Deck", "Monster Deck", "Treasure Deck")
+	ans := uint8(b.ui.readInput(1, 3))
 	return func(roll uint8) {
-		var c card
-		switch ans {
-		case 1:
-			c = b.loot.draw()
-		case 2:
-			c = b.monster.draw()
-		case 3:
-			c = b.treasure.draw()
-		default:
-			panic("input error")
-		}
-		c.showCard(0)
-		fmt.Println("1) Discard it. 2) Place back on top.")
-		ans = uint8(readInput(1, 2))
-		if ans == 1 {
+		defer b.ui.privately(p)() // Only they see the card
+		c, err := b.drawTopCard(int(ans))
+		if err != nil {
+			return
+		}
+		b.ui.showDeck(deck{c}, false)
+		b.ui.menu(1, "Discard it.", "Place back on top.")
+		if b.ui.readChoice(2, 1, 2) == 1 {
 			b.discard(c)
 		} else {
 			b.placeInDeck(c, true)
+			b.revealTop(deckZone(int(ans)), 1, p)
 		}
 	}, false, nil
 }
@@ -1802,9 +1774,12 @@ func smartFlyFunc(p *player, b *Board, tCard card) (cardEffect, bool, error) {
 // Discard a Loot Card:
 // Gain 3 cents
 func smelterFunc(p *player, b *Board, tCard card) (cardEffect, bool, error) {
-	showLootCards(p.Hand, "self", 0)
-	fmt.Println("Discard which value?")
-	b.loot.discard(p.popHandCard(uint8(readInput(0, len(p.Hand)-1))))
+	if len(p.Hand) == 0 {
+		return nil, false, wrapError(ErrIllegalAction, "no loot card to discard")
+	}
+	b.ui.showHand(p, 0)
+	b.ui.Println("Discard which value?")
+	b.loot.discard(p.popHandCard(uint8(b.ui.readInput(0, len(p.Hand)-1))))
 	return func(roll uint8) { p.gainCents(3) }, false, nil
 }
 
@@ -1816,22 +1791,25 @@ func spiderModFunc(p *player, b *Board, tCard card, en *eventNode) (cardEffect,
 	var err error
 	if err = en.checkDiceRoll(5); err == nil {
 		monsters := make([]*monsterCard, 0, len(b.monster.zones))
-		for _, z := range b.monster.zones {
-			if m := z.peek(); err == nil && m.inBattle == false {
-				monsters = append(monsters, m)
+		zones := make([]uint8, 0, len(b.monster.zones))
+		for i, z := range b.monster.zones {
+			if m := z.peek(); !z.isEmpty() && !m.inBattle {
+				monsters, zones = append(monsters, m), append(zones, uint8(i))
 			}
 		}
 		l := len(monsters)
+		if l == 0 {
+			return f, false, wrapError(ErrInvalidTarget, "no monster to replace")
+		}
 		var i uint8
 		if l > 1 {
-			showMonsterCards(monsters, 0)
-			fmt.Println("Choose which value to get rid of and replace")
-			i = uint8(readInput(0, l-1))
+			b.ui.showMonsterCards(monsters, 0)
+			b.ui.Println("Choose which value to get rid of and replace")
+			i = uint8(b.ui.readInput(0, l-1))
 		}
-		f = func(roll uint8) {
-			card := b.monster.zones[i].pop()
+		f = func(roll uint8) { // The zone is refilled once the stack resolves
+			card := b.monster.zones[zones[i]].pop()
 			b.discard(card)
-			b.monster.fillMonsterZone(&b.players[b.api], b, i)
 		}
 	}
 	return f, false, err
@@ -1847,11 +1825,11 @@ func spoonBenderFunc(p *player, b *Board, tCard card) (cardEffect, bool, error)
 	}
 	var ans uint8
 	if l > 1 {
-		showEvents(diceRolls)
-		ans = uint8(readInput(0, l-1))
+		b.ui.showEvents(diceRolls)
+		ans = uint8(b.ui.readInput(0, l-1))
 	}
 	node := diceRolls[ans]
-	return func(roll uint8) { b.eventStack.addToDiceRoll(1, node) }, false, nil
+	return func(roll uint8) { _ = b.eventStack.addToDiceRoll(1, node) }, false, nil
 }
 
 // Event based passive item
@@ -1869,16 +1847,6 @@ func starterDeckFunc(p *player, b *Board, tCard card, en *eventNode) (cardEffect
 	return f, false, err
 }
 
-// Constant with conditions passive item
-// You may buy shop items for 5 cents.
-func steamySaleFunc(p *player) int8 {
-	var cost int8 = 10
-	if _, err := p.getItemIndex(steamySale, true); err == nil {
-		cost = 5
-	}
-	return cost
-}
-
 // Event based passive
 // Each time you die, loot 3.
 func suicideKingFunc(p *player, b *Board, tCard card, en *eventNode) (cardEffect, bool, error) {
@@ -1914,9 +1882,9 @@ func tarotClothFunc(p *player, b *Board, tCard card, en *eventNode) (cardEffect,
 			target := en.event.p
 			l := len(target.Hand)
 			if l > 0 {
-				showLootCards(target.Hand, target.Character.name, 0)
-				fmt.Println("Choose which value to give to", p.Character.name)
-				ans := uint8(readInput(0, l-1))
+				b.ui.showHand(target, 0)
+				b.ui.Println("Choose which value to give to", p.Character.name)
+				ans := uint8(b.ui.readInput(0, l-1))
 				p.Hand = append(p.Hand, target.popHandCard(ans))
 			}
 		}
@@ -1934,15 +1902,15 @@ func techXFunc(p *player, b *Board, tCard card) (cardEffect, bool, error) {
 	}
 	var usedPaidEff bool
 	if c.counters >= 3 {
-		fmt.Println("1) Add a counter.\n2) Kill a Player or Monster.")
-		ans := uint8(readInput(1, 2))
+		b.ui.menu(1, "Add a counter.", "Kill a Player or Monster.")
+		ans := uint8(b.ui.readInput(1, 2))
 		if ans == 2 {
 			usedPaidEff = true
 			monsters, players := b.monster.getActiveMonsters(), b.getPlayers(true)
 			l := len(monsters)
-			showMonsterCards(monsters, 0)
-			showPlayers(players, l)
-			i := uint8(readInput(0, l-1))
+			b.ui.showMonsterCards(monsters, 0)
+			b.ui.showPlayers(players, l)
+			i := uint8(b.ui.readInput(0, l-1))
 			f = func(roll uint8) {
 				if i < uint8(l) {
 					b.killMonster(p, monsters[i].id)
@@ -1959,8 +1927,11 @@ func techXFunc(p *player, b *Board, tCard card) (cardEffect, bool, error) {
 // Recharge another Item
 func theBatteryFunc(p *player, b *Board, tCard card) (cardEffect, bool, error) {
 	items := p.getTappedActiveItems()
-	showTreasureCards(items, p.Character.name, 0)
-	ans := readInput(0, len(items)-1)
+	if len(items) == 0 {
+		return nil, false, wrapError(ErrIllegalAction, "no item to recharge")
+	}
+	b.ui.showTreasureCards(items, p.Character.name, 0)
+	ans := b.ui.readInput(0, len(items)-1)
 	var f cardEffect = func(roll uint8) { p.rechargeActiveItemById(items[ans].id) }
 	return f, false, nil
 }
@@ -1973,14 +1944,19 @@ func theBlueMapFunc(p *player, b *Board, tCard card, en *eventNode) (cardEffect,
 	var err error
 	if err = en.checkEndOfTurn(p); err == nil {
 		err, f = nil, func(roll uint8) {
-			cards := make([]treasureCard, 4)
+			cards := make([]treasureCard, 0, 4)
 			for i := 0; i < 4; i++ {
-				cards[i] = b.treasure.draw()
+				if c, err := b.treasure.draw(); err == nil {
+					cards = append(cards, c)
+				}
 			}
-			fmt.Println("Choose order to go back from bottom to top")
-			for len(cards) > 1 {
-				showTreasureCards(cards, "deck", 0)
-				ans := readInput(0, len(cards))
+			b.ui.Println("Choose order to go back from bottom to top")
+			for len(cards) > 0 {
+				var ans int
+				if len(cards) > 1 {
+					b.ui.showTreasureCards(cards, "deck", 0)
+					ans = b.ui.readInput(0, len(cards)-1)
+				}
 				b.treasure.placeInDeck(cards[ans], true)
 				cards = append(cards[:ans], cards[ans+1:]...)
 			}
@@ -1992,9 +1968,9 @@ func theBlueMapFunc(p *player, b *Board, tCard card, en *eventNode) (cardEffect,
 func theBoneFunc(p *player, b *Board, tCard card) (cardEffect, bool, error) {
 	tc := tCard.(*treasureCard)
 	msgs := [3]string{
-		"1) Remove 1 counter: Add +1 to a dice roll.",
-		"2) Remove 2 counters: Deal 1 damage to a Monster or Player.",
-		"3) Remove 3 counters: This loses all abilities and becomes a Soul.",
+		"Remove 1 counter: Add +1 to a dice roll.",
+		"Remove 2 counters: Deal 1 damage to a Monster or Player.",
+		"Remove 3 counters: This loses all abilities and becomes a Soul.",
 	}
 	var f cardEffect = func(roll uint8) {
 		tc.counters += 1
@@ -2003,15 +1979,13 @@ func theBoneFunc(p *player, b *Board, tCard card) (cardEffect, bool, error) {
 	if tc.counters == 0 {
 		return f, false, nil
 	}
-	var i, n int8 = 0, tc.counters
+	n := tc.counters
 	if n > 3 {
 		n = 3
 	}
-	fmt.Println("0) Put a counter on this.")
-	for i = 0; i < n; i++ {
-		fmt.Println(msgs[i])
-	}
-	ans := uint8(readInput(0, int(n)))
+	b.ui.menu(0, "Put a counter on this.")
+	b.ui.menu(1, msgs[:n]...)
+	ans := uint8(b.ui.readInput(0, int(n)))
 	if ans > 0 {
 		usePaidEff = true
 	}
@@ -2021,7 +1995,7 @@ func theBoneFunc(p *player, b *Board, tCard card) (cardEffect, bool, error) {
 		return f, false, nil
 	case 1:
 		tc.loseCounters(1)
-		f, err = b.eventStack.theBoneFirstPaidHelper(tc)
+		f, err = b.theBoneFirstPaidHelper(tc)
 	case 2:
 		tc.loseCounters(2)
 		f = b.theBoneSecondPaidHelper(p, b.getPlayers(true), b.monster.getActiveMonsters())
@@ -2032,7 +2006,9 @@ func theBoneFunc(p *player, b *Board, tCard card) (cardEffect, bool, error) {
 				return func(roll uint8) {}, false, errors.New("bone lost all abilities")
 			}
 			i, _ := p.getItemIndex(theBone, false)
-			p.addSoulToBoard(p.popActiveItem(i))
+			if c, err := p.popActiveItem(i); err == nil {
+				p.gainSoul(c)
+			}
 		}
 	}
 	return f, usePaidEff, err
@@ -2042,7 +2018,7 @@ func theBoneFunc(p *player, b *Board, tCard card) (cardEffect, bool, error) {
 // If this item is destroyed, it becomes a soul for the player who owned it.
 func theChestFunc(p *player, b *Board, tCard card, isLeaving bool) {
 	if isLeaving {
-		p.addSoulToBoard(tCard)
+		p.gainSoul(tCard)
 	}
 }
 
@@ -2054,17 +2030,25 @@ func theCompassFunc(p *player, b *Board, tCard card, en *eventNode) (cardEffect,
 	var err error
 	if err = en.checkEndOfTurn(p); err == nil {
 		err, f = nil, func(roll uint8) {
-			cards := make([]lootCard, 4)
+			defer b.ui.privately(p)() // Only they see the cards
+			cards := make([]lootCard, 0, 4)
 			for i := 0; i < 4; i++ {
-				cards[i] = b.loot.draw()
+				if c, err := b.loot.draw(); err == nil {
+					cards = append(cards, c)
+				}
 			}
-			fmt.Println("Choose order to go back from bottom to top")
+			n := len(cards)
+			b.ui.Println("Choose order to go back from bottom to top")
 			for len(cards) > 1 {
-				showTreasureCards(cards, "deck", 0)
-				ans := readInput(0, len(cards))
-				b.placeInDeck(cards[ans], true)
+				b.ui.showLootCards(cards, "deck", 0)
+				ans := b.ui.readInput(0, len(cards)-1)
+				b.loot.placeInDeck(cards[ans], true)
 				cards = append(cards[:ans], cards[ans+1:]...)
 			}
+			if len(cards) == 1 {
+				b.loot.placeInDeck(cards[0], true)
+			}
+			b.revealTop(zone{kind: lootDeckZone}, n, p)
 		}
 	}
 	return f, false, err
@@ -2098,8 +2082,8 @@ func theCurseFunc(p *player, b *Board, tCard card) (cardEffect, bool, error) {
 			choices[i] = tc
 			i += 1
 		}
-		fmt.Println("Which value?")
-		ans := readInput(1, 3)
+		b.ui.Println("Which value?")
+		ans := b.ui.readInput(1, 3)
 		b.placeInDeck(choices[ans], true)
 	}
 	return f, false, nil
@@ -2111,26 +2095,27 @@ func theCurseFunc(p *player, b *Board, tCard card) (cardEffect, bool, error) {
 // Then gains treasure equal the the number of items destroyed.
 func theD4Func(p *player, b *Board, tCard card) (cardEffect, bool, error) {
 	idx, _ := p.getItemIndex(tCard.getId(), false)
-	b.discard(p.popActiveItem(idx))
+	if c, err := p.popActiveItem(idx); err == nil {
+		b.discard(c)
+	}
 	players := b.getPlayers(false)
-	ans := uint8(readInput(0, len(players)-1))
+	ans := uint8(b.ui.readInput(0, len(players)-1))
 	target := players[ans]
 	return func(roll uint8) {
 		var numDiscarded uint8
 		for len(target.ActiveItems) > 0 {
-			c := target.popActiveItem(0)
+			c, _ := target.popActiveItem(0)
 			b.discard(c)
 			numDiscarded += 1
 		}
 		for len(target.PassiveItems) > 0 {
-			c := target.popPassiveItem(0)
+			c, _ := target.popPassiveItem(0)
 			b.discard(c)
 			numDiscarded += 1
 		}
 		var i uint8
 		for i = 0; i < numDiscarded; i++ {
-			c := b.treasure.draw()
-			target.addCardToBoard(&c)
+			target.gainTreasure(b.treasure)
 		}
 	}, false, nil
 }
@@ -2145,8 +2130,8 @@ func theD6Func(p *player, b *Board, tCard card) (cardEffect, bool, error) {
 	l := len(nodes)
 	if l > 0 {
 		if l > 1 {
-			showEvents(nodes)
-			ans = readInput(0, l-1)
+			b.ui.showEvents(nodes)
+			ans = b.ui.readInput(0, l-1)
 		}
 		node = nodes[ans]
 	}
@@ -2154,7 +2139,7 @@ func theD6Func(p *player, b *Board, tCard card) (cardEffect, bool, error) {
 		return nil, false, errors.New("no dice events to change")
 	}
 	var f cardEffect = func(roll uint8) {
-		node.event = event{p: node.event.p, e: diceRollEvent{n: uint8(rand.Intn(6) + 1)}}
+		node.event = event{p: node.event.p, e: diceRollEvent{n: uint8(b.rng.Intn(6) + 1)}}
 	}
 	return f, false, nil
 }
@@ -2166,11 +2151,9 @@ func theD10Func(p *player, b *Board, tCard card, en *eventNode) (cardEffect, boo
 	var f cardEffect
 	var err error
 	if err = en.checkDiceRoll(3); err == nil {
-		fmt.Println("1) Overlay a monster with the top value of the monster deck?\n2)Do nothing.")
-		if readInput(1, 2) == 1 {
-			showMonsterCards(b.monster.getActiveMonsters(), 0)
-			fmt.Println("Which zone to place in?")
-			i := uint8(readInput(0, len(b.monster.zones)-1))
+		b.ui.menu(1, "Overlay a monster with the top value of the monster deck?", "Do nothing.")
+		if b.ui.readChoice(2, 1, 2) == 1 {
+			i := uint8(b.monster.chooseZoneHelper(b, "Which zone to place in?"))
 			f = func(roll uint8) {
 				monsters := b.monster.getActiveMonsters()
 				if !monsters[i].inBattle {
@@ -2186,13 +2169,16 @@ func theD10Func(p *player, b *Board, tCard card, en *eventNode) (cardEffect, boo
 // Destroy any *treasureCard in play and replace it with the top card of the treasure deck.
 func theD20Func(p *player, b *Board, tCard card) (cardEffect, bool, error) {
 	players := b.getPlayers(false)
-	showPlayers(players, 0)
-	ans := readInput(0, len(players)-1)
+	b.ui.showPlayers(players, 0)
+	ans := b.ui.readInput(0, len(players)-1)
 	player := players[ans]
 	al := len(player.ActiveItems)
-	showTreasureCards(player.ActiveItems, player.Character.name, 0)
-	showTreasureCards(player.PassiveItems, player.Character.name, al)
-	i := readInput(0, al+len(player.PassiveItems)-1)
+	if al+len(player.PassiveItems) == 0 {
+		return nil, false, wrapError(ErrInvalidTarget, "%s has no item to destroy", player.Character.name)
+	}
+	b.ui.showTreasureCards(player.ActiveItems, player.Character.name, 0)
+	b.ui.showTreasureCards(player.PassiveItems, player.Character.name, al)
+	i := b.ui.readInput(0, al+len(player.PassiveItems)-1)
 	var id uint16
 	var isPassive bool
 	if i < al {
@@ -2203,9 +2189,10 @@ func theD20Func(p *player, b *Board, tCard card) (cardEffect, bool, error) {
 	return func(roll uint8) {
 		i, err := player.getItemIndex(id, isPassive)
 		if err == nil {
-			b.discard(player.popItemByIndex(i, isPassive))
-			item := b.treasure.draw()
-			player.addCardToBoard(&item)
+			if c, err := player.popItemByIndex(i, isPassive); err == nil {
+				b.discard(c)
+				player.gainTreasure(b.treasure)
+			}
 		}
 	}, false, nil
 }
@@ -2249,7 +2236,9 @@ func theDeadCatChecker(deadCat *treasureCard, es *eventStack, damageNode *eventN
 	var f cardEffect
 	var err = errors.New("dead cat could not activate")
 	if d, ok := damageNode.event.e.(damageEvent); ok {
-		if deadCat.id == theDeadCat && deadCat.counters > 0 {
+		if deadCat.id != theDeadCat {
+			err = wrapError(ErrInvalidCard, "card with id %d is not the dead cat", deadCat.id)
+		} else if deadCat.counters > 0 {
 			err, f = nil, func(roll uint8) {
 				var x int8 = int8(d.n)
 				if deadCat.counters < x {
@@ -2258,8 +2247,6 @@ func theDeadCatChecker(deadCat *treasureCard, es *eventStack, damageNode *eventN
 				_ = es.preventDamage(uint8(x), damageNode)
 				deadCat.loseCounters(x)
 			}
-		} else {
-			panic("need a pointer to the dead cat card!")
 		}
 	}
 	return f, err
@@ -2269,25 +2256,25 @@ func theDeadCatChecker(deadCat *treasureCard, es *eventStack, damageNode *eventN
 // When you take damage for the first time each turn, you may recharge an item.
 func theHabitFuncConstant(p *player, b *Board, tCard card, isLeaving bool) {
 	if !isLeaving {
-		p.activeEffects[theHabit] = struct{}{}
+		p.addEffect(theHabit, tCard, untilEndOfTurn)
 	} else {
-		delete(p.activeEffects, theHabit)
+		p.useEffect(theHabit)
 	}
 }
 
 func theHabitFuncEvent(p *player, b *Board, tCard card, en *eventNode) (cardEffect, bool, error) {
 	var f cardEffect
 	var err error
-	if _, err = en.checkDamageToPlayer(p.Character.id); err == nil && checkActiveEffects(p.activeEffects, theHabit, true) {
-		fmt.Println("1) Recharge an item\n2) Do nothing.")
-		if readInput(1, 2) == 1 {
+	if _, err = en.checkDamageToPlayer(p.Character.id); err == nil && p.useEffect(theHabit) {
+		b.ui.menu(1, "Recharge an item", "Do nothing.")
+		if b.ui.readChoice(2, 1, 2) == 1 {
 			a := p.getTappedActiveItems()
 			l := len(a)
 			var i uint8
 			if l > 0 {
 				if l > 1 {
-					showTreasureCards(a, "self", 0)
-					i = uint8(readInput(0, l-1))
+					b.ui.showTreasureCards(a, "self", 0)
+					i = uint8(b.ui.readInput(0, l-1))
 				}
 				f = func(roll uint8) { p.rechargeActiveItemById(a[i].id) }
 			}
@@ -2304,17 +2291,25 @@ func theMapFunc(p *player, b *Board, tCard card, en *eventNode) (cardEffect, boo
 	var err error
 	if err = en.checkEndOfTurn(p); err == nil {
 		err, f = nil, func(roll uint8) {
-			cards := make([]lootCard, 4)
+			defer b.ui.privately(p)() // Only they see the cards
+			cards := make([]monsterCard, 0, 4)
 			for i := 0; i < 4; i++ {
-				cards[i] = b.loot.draw()
+				if c, err := b.monster.draw(); err == nil {
+					cards = append(cards, c)
+				}
 			}
-			fmt.Println("Choose order to go back from bottom to top")
+			n := len(cards)
+			b.ui.Println("Choose order to go back from bottom to top")
 			for len(cards) > 1 {
-				showLootCards(cards, "deck", 0)
-				ans := readInput(0, len(cards))
-				b.loot.placeInDeck(cards[ans], true)
+				b.ui.showMonsterCards(cards, 0)
+				ans := b.ui.readInput(0, len(cards)-1)
+				b.monster.placeInDeck(cards[ans], true)
 				cards = append(cards[:ans], cards[ans+1:]...)
 			}
+			if len(cards) == 1 {
+				b.monster.placeInDeck(cards[0], true)
+			}
+			b.revealTop(zone{kind: monsterDeckZone}, n, p)
 		}
 	}
 	return f, false, err
@@ -2369,8 +2364,8 @@ func thePoopFunc(p *player, b *Board, tCard card) (cardEffect, bool, error) {
 	}
 	var i uint8
 	if l > 1 {
-		showEvents(valid)
-		i = uint8(readInput(0, l-1))
+		b.ui.showEvents(valid)
+		i = uint8(b.ui.readInput(0, l-1))
 	}
 	return func(roll uint8) { _ = b.eventStack.preventDamage(1, valid[i]) }, false, nil
 }
@@ -2386,28 +2381,17 @@ func theRelicFunc(p *player, b *Board, tCard card, en *eventNode) (cardEffect, b
 	return f, false, err
 }
 
-// Hybrid passive item
-// You may look at the top card of the treasure deck at any time during your turn
-// You may purchase an additional item
-func theresOptionsFunc(p *player, b *Board, tCard card, isLeaving bool) {
-	if !isLeaving {
-		p.numPurchases += 1
-		p.baseNumPurchases += 1
-	} else {
-		p.numPurchases -= 1
-		p.baseNumPurchases -= 1
-	}
-}
-
 // Active Item
 // Put any discarded monster card back on top of the monster deck.
 func theShovelFunc(p *player, b *Board, tCard card) (cardEffect, bool, error) {
 	return func(roll uint8) {
 		if l := len(b.monster.discardPile); l > 0 {
-			showDeck(b.monster.discardPile, true)
-			fmt.Println("Put which card on top of the monster deck?")
-			ans := l - readInput(0, l-1) - 1
-			b.monster.placeInDeck(b.monster.popCardFromDiscardPile(uint8(ans)), true)
+			b.ui.showDeck(b.monster.discardPile, true)
+			b.ui.Println("Put which card on top of the monster deck?")
+			ans := l - b.ui.readInput(0, l-1) - 1
+			if mc, err := b.monster.popCardFromDiscardPile(uint8(ans)); err == nil {
+				b.monster.placeInDeck(mc, true)
+			}
 		}
 	}, false, nil
 }
@@ -2427,10 +2411,10 @@ func trinityShieldFunc(p *player) bool {
 // Double the number of loot cards a player would draw, till the end of the turn.
 func twoOfClubsFunc(p *player, b *Board, tCard card) (cardEffect, bool, error) {
 	players := b.getPlayers(false)
-	showPlayers(players, 0)
-	ans := readInput(0, len(players)-1)
+	b.ui.showPlayers(players, 0)
+	ans := b.ui.readInput(0, len(players)-1)
 	player := players[ans]
-	return func(roll uint8) { player.activeEffects[twoOfClubs] = struct{}{} }, false, nil
+	return func(roll uint8) { player.addEffect(twoOfClubs, tCard, untilEndOfTurn) }, false, nil
 }
 
 // Starting Item (Maggy)
@@ -2443,8 +2427,8 @@ func yumHeartFunc(p *player, b *Board, tCard card) (cardEffect, bool, error) {
 	if max == 0 {
 		return nil, false, errors.New("no damage to prevent")
 	} else if max > 1 {
-		showEvents(damage)
-		ans = uint8(readInput(0, len(damage)-1))
+		b.ui.showEvents(damage)
+		ans = uint8(b.ui.readInput(0, len(damage)-1))
 	}
 	var f cardEffect = func(roll uint8) {
 		_ = b.eventStack.preventDamage(1, damage[ans])
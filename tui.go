@@ -0,0 +1,521 @@
+package four_souls
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Escape sequences the full-screen client draws with.
+const (
+	ansiAltScreen  = "\x1b[?1049h"
+	ansiMainScreen = "\x1b[?1049l"
+	ansiHideCursor = "\x1b[?25l"
+	ansiShowCursor = "\x1b[?25h"
+	ansiClear      = "\x1b[2J"
+	ansiHome       = "\x1b[H"
+	ansiReverse    = "\x1b[7m"
+	ansiBold       = "\x1b[1m"
+	ansiReset      = "\x1b[0m"
+	tuiLogSize     = 200 // The number of log lines kept
+)
+
+// A player quit the full-screen client.
+var errQuit = errors.New("player quit")
+
+// A full-screen terminal client. It draws panes for the board (monster zones, shop
+// and decks), each player's area, the event stack and a log of what happened,
+// then asks for each decision at the bottom of the screen.
+// Answers are picked with the keyboard: the arrow keys (or j and k) move between them,
+// Enter picks one, and typing a number picks that answer directly.
+// Players sharing the client pass it around: when another of them has to decide,
+// the screen goes blank until they take it, so no one sees someone else's hand.
+type TUI struct {
+	in            *bufio.Reader
+	out           io.Writer
+	width, height int
+	log           []tuiLogLine
+	current       string // The player holding the terminal
+}
+
+// A line of the log, and the player it is for. Empty if everyone may see it.
+type tuiLogLine struct {
+	to   string
+	text string
+}
+
+// Make a full-screen client that reads keys from in and draws on out, a terminal of the given size.
+func NewTUI(in io.Reader, out io.Writer, width, height int) *TUI {
+	return &TUI{in: bufio.NewReader(in), out: out, width: width, height: height, log: make([]tuiLogLine, 0, tuiLogSize)}
+}
+
+// Make a full-screen client on the terminal of the process.
+func NewTerminalTUI() *TUI {
+	width, height := terminalSize()
+	return NewTUI(os.Stdin, os.Stdout, width, height)
+}
+
+// Play the game on the terminal of the process, full-screen, until someone wins or a player quits.
+// return: the character names of the winners.
+func RunTUI(b *Board) ([]string, error) {
+	t := NewTerminalTUI()
+	return t.Run(b, t)
+}
+
+// Play the game full-screen with the decider, which the client is part of: the client
+// may only play some seats, or have its answers recorded.
+// return: the character names of the winners.
+func (t *TUI) Run(b *Board, d Decider) ([]string, error) {
+	restore := rawTerminal()
+	_, _ = fmt.Fprint(t.out, ansiAltScreen+ansiHideCursor+ansiClear)
+	defer func() {
+		_, _ = fmt.Fprint(t.out, ansiShowCursor+ansiMainScreen)
+		restore()
+	}()
+	unsubscribe := b.Subscribe(t.Notify)
+	defer unsubscribe()
+	b.SetDecider(d)
+	return b.Play()
+}
+
+// Add the notification to the log.
+func (t *TUI) Notify(n Notification) {
+	t.addToLog("", n.String())
+}
+
+// A line listing an answer, "3 )  Attack!", or a table row starting with its index.
+var optionLine = regexp.MustCompile(`^\s*(\d+)\s*(?:\)|\s)\s*(.*?)\s*$`)
+
+// Log the messages the engine printed. Tables of cards are left out:
+// they are listed with the decision they come with.
+func (t *TUI) Show(b *Board, s string) {
+	t.ShowTo(b, "", s)
+}
+
+// Log what is for one player only. It is drawn while they hold the terminal.
+func (t *TUI) ShowTo(b *Board, player string, s string) {
+	for _, line := range strings.Split(s, "\n") {
+		if line = strings.TrimSpace(line); line != "" && !optionLine.MatchString(line) && !strings.Contains(line, "  ") {
+			t.addToLog(player, line)
+		}
+	}
+}
+
+func (t *TUI) addToLog(player string, line string) {
+	if len(t.log) == tuiLogSize {
+		t.log = append(t.log[:0], t.log[1:]...)
+	}
+	t.log = append(t.log, tuiLogLine{to: player, text: line})
+}
+
+// The lines of the log the player may see.
+func (t *TUI) logLines(player string) []string {
+	lines := make([]string, 0, len(t.log))
+	for _, l := range t.log {
+		if l.to == "" || l.to == player {
+			lines = append(lines, l.text)
+		}
+	}
+	return lines
+}
+
+// Draw the game and read keys until the player picks an answer.
+func (t *TUI) Decide(b *Board, d Decision) (int, error) {
+	if d.Player != "" && d.Player != t.current {
+		if err := t.handOff(d.Player); err != nil {
+			return 0, err
+		}
+	}
+	cursor, typed := 0, ""
+	for {
+		t.draw(b, d, cursor, typed)
+		key, err := t.readKey()
+		if err != nil {
+			return 0, err
+		}
+		switch key {
+		case "up", "k":
+			if cursor > 0 {
+				cursor -= 1
+			}
+		case "down", "j":
+			if cursor < len(d.Options)-1 {
+				cursor += 1
+			}
+		case "backspace":
+			if typed != "" {
+				typed = typed[:len(typed)-1]
+			}
+		case "enter":
+			if typed != "" {
+				n, _ := strconv.Atoi(typed)
+				return n, nil
+			} else if len(d.Options) > 0 {
+				return d.Options[cursor].Value, nil
+			}
+		case "quit":
+			return 0, errQuit
+		case "a": // Pass priority without being asked while holding no response
+			if b != nil && d.Player != "" {
+				_ = b.SetAutoPass(d.Player, !b.AutoPass(d.Player))
+			}
+		default:
+			if len(key) == 1 && key[0] >= '0' && key[0] <= '9' {
+				typed += key
+			}
+		}
+	}
+}
+
+// Blank the screen until the player takes the terminal.
+// The first player to decide takes it without a handoff: no one has seen anything yet.
+func (t *TUI) handOff(player string) error {
+	if t.current != "" {
+		lines := []string{"Pass the terminal to " + player + ".", "", player + ", press Enter once no one else can see the screen."}
+		var sb strings.Builder
+		sb.WriteString(ansiClear)
+		for i, line := range lines {
+			sb.WriteString(fmt.Sprintf("\x1b[%d;%dH%s", t.height/2+i, 1+(t.width-utf8.RuneCountInString(line))/2, line))
+		}
+		_, _ = fmt.Fprint(t.out, sb.String())
+		for {
+			key, err := t.readKey()
+			if err != nil {
+				return err
+			} else if key == "quit" {
+				return errQuit
+			} else if key == "enter" {
+				break
+			}
+		}
+		_, _ = fmt.Fprint(t.out, ansiClear)
+	}
+	t.current = player
+	return nil
+}
+
+// Read one key press: a printable character, or the name of a special key.
+func (t *TUI) readKey() (string, error) {
+	r, _, err := t.in.ReadRune()
+	if err != nil {
+		return "", err
+	}
+	switch r {
+	case '\r', '\n', ' ':
+		return "enter", nil
+	case 127, '\b':
+		return "backspace", nil
+	case 3, 4, 'q': // Ctrl-C, Ctrl-D
+		return "quit", nil
+	case 27: // An escape sequence: ESC [ A is up, ESC [ B is down
+		if next, _ := t.in.Peek(2); len(next) == 2 && next[0] == '[' {
+			_, _ = t.in.Discard(2)
+			switch next[1] {
+			case 'A':
+				return "up", nil
+			case 'B':
+				return "down", nil
+			}
+		}
+		return "", nil
+	}
+	return string(r), nil
+}
+
+// Draw every pane of the screen.
+func (t *TUI) draw(b *Board, d Decision, cursor int, typed string) {
+	w, h := t.width, t.height
+	leftW := w / 2
+	rightW := w - leftW
+	promptH := h / 3
+	if promptH < 8 {
+		promptH = 8
+	}
+	topH := (h - 1 - promptH) * 3 / 5
+	midH := h - 1 - promptH - topH
+	var view BoardView // What the player holding the terminal may see
+	if b != nil {
+		view = b.ViewFor(t.current)
+	}
+	screen := make([]string, 0, h)
+	screen = append(screen, ansiReverse+fit(t.title(b, d), w)+ansiReset)
+	screen = append(screen, joinPanes(
+		pane("Board", t.boardLines(b, view), leftW, topH),
+		pane("Players", t.playerLines(view), rightW, topH))...)
+	screen = append(screen, joinPanes(
+		pane("Event Stack", t.stackLines(b), leftW, midH),
+		pane("Log", t.logLines(t.current), rightW, midH))...)
+	screen = append(screen, pane("Decision", t.decisionLines(d, cursor, typed, b != nil && b.AutoPass(d.Player), promptH-2), w, promptH)...)
+	var sb strings.Builder
+	for i, line := range screen {
+		sb.WriteString(fmt.Sprintf("\x1b[%d;1H%s\x1b[K", i+1, line))
+	}
+	_, _ = fmt.Fprint(t.out, sb.String())
+}
+
+func (t *TUI) title(b *Board, d Decision) string {
+	s := " Four Souls"
+	if b != nil && len(b.players) > 0 {
+		s += " | Turn: " + b.players[b.api].Character.name
+	}
+	if d.Player != "" {
+		s += " | Deciding: " + d.Player
+	}
+	return s
+}
+
+// The monster zones, the shop and the decks, with the cards of the decks the viewer of the view was shown.
+func (t *TUI) boardLines(b *Board, view BoardView) []string {
+	if b == nil {
+		return nil
+	}
+	lines := []string{ansiBold + "Monsters" + ansiReset}
+	for i, zone := range b.monster.zones {
+		if zone.isEmpty() {
+			lines = append(lines, fmt.Sprintf(" %d) (empty)", i))
+			continue
+		}
+		m := zone.peek()
+		s := fmt.Sprintf(" %d) %s  HP %d/%d  Roll %d+  ATK %d", i, m.name, m.hp, m.baseHealth, m.roll, m.ap)
+		if len(zone) > 1 {
+			s += fmt.Sprintf("  (%d beneath)", len(zone)-1)
+		}
+		lines = append(lines, s)
+	}
+	cost := shopCost
+	if len(b.players) > 0 {
+		cost = b.players[b.api].getShopCost()
+	}
+	lines = append(lines, ansiBold+fmt.Sprintf("Shop (%d¢)", cost)+ansiReset)
+	for i, tc := range b.treasure.zones {
+		name := tc.name
+		if tc.id == 0 {
+			name = "(empty)"
+		}
+		lines = append(lines, fmt.Sprintf(" %d) %s", i, name))
+	}
+	lines = append(lines, ansiBold+"Decks"+ansiReset)
+	for _, dv := range view.Decks {
+		lines = append(lines, fmt.Sprintf(" %s %d (%d discarded)", dv.Name, dv.Size, len(dv.Discard)))
+		if len(dv.Known) > 0 {
+			lines = append(lines, "    On top: "+cardNames(dv.Known))
+		}
+	}
+	if len(view.Effects) > 0 {
+		lines = append(lines, ansiBold+"Effects"+ansiReset)
+		for _, e := range view.Effects {
+			lines = append(lines, fmt.Sprintf(" %s (%s)", e.Source, e.Duration))
+		}
+	}
+	return lines
+}
+
+// Each player's area, as the viewer of the view sees it: only the hands they may see are shown.
+func (t *TUI) playerLines(view BoardView) []string {
+	lines := make([]string, 0, 4*len(view.Players))
+	for _, pv := range view.Players {
+		marker := "  "
+		if pv.Active {
+			marker = "> "
+		}
+		lines = append(lines, ansiBold+fmt.Sprintf("%s%s  HP %d/%d  ATK %d  %d¢  Souls %d/%d  Hand %d",
+			marker, pv.Name, pv.Health, pv.MaxHealth, pv.Attack, pv.Pennies, pv.SoulValue, pv.SoulsToWin,
+			pv.HandSize)+ansiReset)
+		items := make([]string, len(pv.Items))
+		for j, cv := range pv.Items {
+			items[j] = cv.Name
+			if cv.Tapped {
+				items[j] += "*"
+			}
+		}
+		lines = append(lines, "    Items: "+strings.Join(items, ", "))
+		if len(pv.Curses) > 0 {
+			lines = append(lines, "    Curses: "+cardNames(pv.Curses))
+		}
+		if len(pv.Hand) > 0 {
+			lines = append(lines, "    Hand: "+cardNames(pv.Hand))
+		}
+	}
+	return lines
+}
+
+// The event stack, with the next event to resolve first.
+func (t *TUI) stackLines(b *Board) []string {
+	if b == nil {
+		return nil
+	}
+	view := b.StackView()
+	lines := make([]string, 0, len(view))
+	for _, n := range view {
+		s := fmt.Sprintf("#%d %s", n.Id, n.Kind)
+		if n.Source != "" {
+			s += ": " + n.Source
+		}
+		if n.Roll > 0 {
+			s += fmt.Sprintf(" (rolled %d)", n.Roll)
+		}
+		if n.Controller != "" {
+			s += " by " + n.Controller
+		}
+		if len(n.Targets) > 0 {
+			s += " -> " + strings.Join(n.Targets, ", ")
+		}
+		if n.Fizzled {
+			s += " [fizzled]"
+		}
+		lines = append(lines, s)
+	}
+	if len(lines) == 0 {
+		lines = append(lines, "(empty)")
+	}
+	return lines
+}
+
+// The question, then the answers around the cursor.
+func (t *TUI) decisionLines(d Decision, cursor int, typed string, autoPass bool, height int) []string {
+	question := make([]string, 0, 2)
+	for _, line := range strings.Split(d.Text, "\n") {
+		if line = strings.TrimSpace(line); line != "" && !optionLine.MatchString(line) && !strings.Contains(line, "  ") {
+			question = append(question, line)
+		}
+	}
+	if len(question) > 2 {
+		question = question[len(question)-2:]
+	}
+	hint := "Up/Down move  Enter choose  0-9 type a number  a auto-pass (" + onOff(autoPass) + ")  q quit"
+	if typed != "" {
+		hint = "Typed: " + typed + "  (Enter to choose, Backspace to erase)"
+	}
+	room := height - len(question) - 1
+	if room < 1 {
+		room = 1
+	}
+	first := cursor - room/2
+	if first > len(d.Options)-room {
+		first = len(d.Options) - room
+	}
+	if first < 0 {
+		first = 0
+	}
+	lines := append([]string{}, question...)
+	for i := first; i < len(d.Options) && i < first+room; i++ {
+		o := d.Options[i]
+		label := o.Label
+		if label != strconv.Itoa(o.Value) {
+			label = fmt.Sprintf("%d) %s", o.Value, label)
+		}
+		if i == cursor {
+			lines = append(lines, ansiReverse+"> "+label+ansiReset)
+		} else {
+			lines = append(lines, "  "+label)
+		}
+	}
+	return append(lines, hint)
+}
+
+// Draw a box with the title and as many of the last lines as fit.
+func pane(title string, lines []string, width, height int) []string {
+	if width < 4 || height < 2 {
+		return make([]string, height)
+	}
+	inner := width - 2
+	box := make([]string, 0, height)
+	box = append(box, "┌"+fit("─ "+title+" "+strings.Repeat("─", inner), inner)+"┐")
+	if len(lines) > height-2 {
+		lines = lines[len(lines)-(height-2):]
+	}
+	for i := 0; i < height-2; i++ {
+		var line string
+		if i < len(lines) {
+			line = lines[i]
+		}
+		box = append(box, "│"+fit(line, inner)+ansiReset+"│")
+	}
+	return append(box, "└"+strings.Repeat("─", inner)+"┘")
+}
+
+// Put two panes of the same height side by side.
+func joinPanes(left, right []string) []string {
+	joined := make([]string, len(left))
+	for i := range left {
+		joined[i] = left[i]
+		if i < len(right) {
+			joined[i] += right[i]
+		}
+	}
+	return joined
+}
+
+// Cut or pad the text to exactly width columns. Escape sequences take no room.
+func fit(s string, width int) string {
+	var sb strings.Builder
+	n := 0
+	for i := 0; i < len(s) && n < width; {
+		if s[i] == 0x1b { // Copy the whole escape sequence, up to its final letter
+			j := i + 1
+			for j < len(s) && !(s[j] >= 'A' && s[j] <= 'Z' || s[j] >= 'a' && s[j] <= 'z') {
+				j++
+			}
+			if j < len(s) {
+				j++
+			}
+			sb.WriteString(s[i:j])
+			i = j
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if r == '\t' {
+			r = ' '
+		}
+		sb.WriteRune(r)
+		i += size
+		n++
+	}
+	if n < width {
+		sb.WriteString(strings.Repeat(" ", width-n))
+	}
+	return sb.String()
+}
+
+// The size of the process' terminal, or 120 by 40 if it can't be read.
+func terminalSize() (width, height int) {
+	width, height = 120, 40
+	cmd := exec.Command("stty", "size")
+	cmd.Stdin = os.Stdin
+	if out, err := cmd.Output(); err == nil {
+		var rows, cols int
+		if _, err = fmt.Sscan(string(out), &rows, &cols); err == nil && rows > 0 && cols > 0 {
+			width, height = cols, rows
+		}
+	}
+	return width, height
+}
+
+// Switch the process' terminal to raw mode, so keys are read as they are pressed.
+// return: a function that switches the terminal back. If the terminal can't switch,
+// keys are read a line at a time instead.
+func rawTerminal() (restore func()) {
+	save := exec.Command("stty", "-g")
+	save.Stdin = os.Stdin
+	state, err := save.Output()
+	if err != nil {
+		return func() {}
+	}
+	raw := exec.Command("stty", "raw", "-echo")
+	raw.Stdin = os.Stdin
+	if raw.Run() != nil {
+		return func() {}
+	}
+	return func() {
+		cmd := exec.Command("stty", strings.TrimSpace(string(state)))
+		cmd.Stdin = os.Stdin
+		_ = cmd.Run()
+	}
+}
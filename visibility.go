@@ -0,0 +1,248 @@
+package four_souls
+
+// Who may see a zone of the board, or a card in it.
+type Visibility uint8
+
+const (
+	Public      Visibility = iota // Every player
+	OwnerOnly                     // Only the player the zone belongs to, like their hand
+	SomePlayers                   // Only the players who were shown it, like the top of a deck after a peek
+	Nobody                        // No one, like the cards in a deck
+)
+
+func (v Visibility) String() string {
+	switch v {
+	case Public:
+		return "Public"
+	case OwnerOnly:
+		return "Owner Only"
+	case SomePlayers:
+		return "Some Players"
+	default:
+		return "Nobody"
+	}
+}
+
+// The kinds of zones cards can be in.
+type zoneKind uint8
+
+const (
+	lootDeckZone zoneKind = iota
+	lootDiscardZone
+	monsterDeckZone
+	monsterDiscardZone
+	monsterZone
+	treasureDeckZone
+	treasureDiscardZone
+	shopZone
+	handZone
+	itemZone
+	soulZone
+	curseZone
+)
+
+// Who may see the cards in each kind of zone, unless some of them were shown to more players.
+var zoneVisibility = [...]Visibility{
+	lootDeckZone: Nobody, lootDiscardZone: Public,
+	monsterDeckZone: Nobody, monsterDiscardZone: Public, monsterZone: Public,
+	treasureDeckZone: Nobody, treasureDiscardZone: Public, shopZone: Public,
+	handZone: OwnerOnly, itemZone: Public, soulZone: Public, curseZone: Public,
+}
+
+// A zone of the board.
+type zone struct {
+	kind  zoneKind
+	owner *player // The player a hand, items, souls or curses belong to
+	idx   uint8   // Which monster zone
+}
+
+// Decks and discard piles are piled up: their top card is their last.
+func (z zone) isPile() bool {
+	switch z.kind {
+	case lootDeckZone, lootDiscardZone, monsterDeckZone, monsterDiscardZone, treasureDeckZone, treasureDiscardZone:
+		return true
+	}
+	return false
+}
+
+// The cards in the zone. Piles go from the bottom up.
+func (b *Board) zoneCards(z zone) []card {
+	var cards []card
+	switch z.kind {
+	case lootDeckZone:
+		cards = b.loot.deck
+	case lootDiscardZone:
+		cards = b.loot.discardPile
+	case monsterDeckZone:
+		cards = b.monster.deck
+	case monsterDiscardZone:
+		cards = b.monster.discardPile
+	case treasureDeckZone:
+		cards = b.treasure.deck
+	case treasureDiscardZone:
+		cards = b.treasure.discardPile
+	case monsterZone:
+		if int(z.idx) < len(b.monster.zones) {
+			for _, m := range b.monster.zones[z.idx] {
+				cards = append(cards, m)
+			}
+		}
+	case shopZone:
+		for _, tc := range b.treasure.zones {
+			cards = append(cards, tc)
+		}
+	case handZone:
+		for _, lc := range z.owner.Hand {
+			cards = append(cards, lc)
+		}
+	case itemZone:
+		for _, tc := range z.owner.ActiveItems {
+			cards = append(cards, tc)
+		}
+		for _, pi := range z.owner.PassiveItems {
+			cards = append(cards, pi)
+		}
+	case soulZone:
+		for _, s := range z.owner.Souls {
+			cards = append(cards, s)
+		}
+	case curseZone:
+		for _, mc := range z.owner.Curses {
+			cards = append(cards, mc)
+		}
+	}
+	return cards
+}
+
+// The players who may see a zone or a card.
+type observers struct {
+	v       Visibility
+	owner   *player
+	players []*player // Who may see it, with SomePlayers
+}
+
+func (o observers) includes(p *player) bool {
+	switch o.v {
+	case Public:
+		return true
+	case OwnerOnly:
+		return p != nil && p == o.owner
+	case SomePlayers:
+		return p != nil && containsPlayer(o.players, p)
+	}
+	return false
+}
+
+func containsPlayer(players []*player, p *player) bool {
+	for _, other := range players {
+		if other == p {
+			return true
+		}
+	}
+	return false
+}
+
+// A card of a hidden zone that some players were shown, like the top of a deck after a peek.
+// The card is found by its index in the zone. For a pile, the index counts from the bottom,
+// so it stays put as cards are drawn from or put on top. Once another card is found there,
+// the card moved, or its deck was shuffled: the sighting no longer holds.
+type sighting struct {
+	z  zone
+	i  int
+	id uint16
+	by []*player
+}
+
+func (s sighting) holds(cards []card) bool {
+	return s.i < len(cards) && cards[s.i] != nil && cards[s.i].getId() == s.id
+}
+
+// Who may see the zone's cards, before any sightings.
+func observersOf(z zone) observers {
+	return observers{v: zoneVisibility[z.kind], owner: z.owner}
+}
+
+// Who may see the i-th of the zone's cards.
+func (b *Board) cardObservers(z zone, cards []card, i int) observers {
+	o := observersOf(z)
+	if o.v == Public {
+		return o
+	}
+	for _, s := range b.sightings {
+		if s.z == z && s.i == i && s.holds(cards) {
+			seen := observers{v: SomePlayers, players: append([]*player{}, s.by...)}
+			if o.v == OwnerOnly {
+				seen.players = append(seen.players, o.owner)
+			}
+			return seen
+		}
+	}
+	return o
+}
+
+// Show the player some of the zone's cards, by index. They see them until the cards move.
+func (b *Board) reveal(z zone, p *player, indices ...int) {
+	b.pruneSightings()
+	cards := b.zoneCards(z)
+	for _, i := range indices {
+		if i < 0 || i >= len(cards) || cards[i] == nil {
+			continue
+		}
+		var found bool
+		for j := range b.sightings {
+			if s := &b.sightings[j]; s.z == z && s.i == i && s.holds(cards) {
+				found = true
+				if !containsPlayer(s.by, p) {
+					s.by = append(s.by, p)
+				}
+			}
+		}
+		if !found {
+			b.sightings = append(b.sightings, sighting{z: z, i: i, id: cards[i].getId(), by: []*player{p}})
+		}
+	}
+}
+
+// Show the player the top n cards of a pile.
+func (b *Board) revealTop(z zone, n int, p *player) {
+	l := len(b.zoneCards(z))
+	indices := make([]int, 0, n)
+	for i := l - 1; i >= 0 && i >= l-n; i-- {
+		indices = append(indices, i)
+	}
+	b.reveal(z, p, indices...)
+}
+
+// Show the player another player's hand.
+func (b *Board) revealHand(owner *player, p *player) {
+	if owner != p {
+		indices := make([]int, len(owner.Hand))
+		for i := range indices {
+			indices[i] = i
+		}
+		b.reveal(zone{kind: handZone, owner: owner}, p, indices...)
+	}
+}
+
+// Forget the sightings of the cards that moved.
+func (b *Board) pruneSightings() {
+	kept := b.sightings[:0]
+	for _, s := range b.sightings {
+		if s.holds(b.zoneCards(s.z)) {
+			kept = append(kept, s)
+		}
+	}
+	b.sightings = kept
+}
+
+// The deck a deck choice refers to: 1) Loot 2) Monster 3) Treasure, as asked by the peek effects.
+func deckZone(deckChoice int) zone {
+	switch deckChoice {
+	case 2:
+		return zone{kind: monsterDeckZone}
+	case 3:
+		return zone{kind: treasureDeckZone}
+	default:
+		return zone{kind: lootDeckZone}
+	}
+}